@@ -0,0 +1,77 @@
+package authMiddleware
+
+import (
+	"context"
+	"errors"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"net/http"
+	"strings"
+)
+
+// APITokenCtxKey — ключ контекста, под которым лежит токен API, с которым пришёл запрос.
+const APITokenCtxKey contextKey = "apiToken"
+
+// APITokenChecker проверяет токен API из заголовка Authorization.
+type APITokenChecker interface {
+	APITokenCheck(secret string) (*domain.APIToken, error)
+}
+
+// APITokenRejecter отвечает на запрос, который не прошёл проверку токена: 401 без токена или
+// с неверным токеном, 500 если токен не удалось проверить.
+type APITokenRejecter func(w http.ResponseWriter, r *http.Request, status int)
+
+type MiddlewareAPIToken struct {
+	logger  *zap.Logger
+	checker APITokenChecker
+	reject  APITokenRejecter
+}
+
+func NewMiddlewareAPIToken(logger *zap.Logger, checker APITokenChecker, reject APITokenRejecter) *MiddlewareAPIToken {
+	return &MiddlewareAPIToken{
+		logger:  logger,
+		checker: checker,
+		reject:  reject,
+	}
+}
+
+// APITokenAuth пропускает запросы с действующим токеном API в заголовке "Authorization: Bearer <токен>".
+// Cookie jwt здесь не учитывается: API админки предназначен для скриптов.
+func (m *MiddlewareAPIToken) APITokenAuth(next http.Handler) http.Handler {
+	return http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			secret, ok := bearerToken(r)
+			if !ok {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				m.reject(w, r, http.StatusUnauthorized)
+				return
+			}
+
+			token, err := m.checker.APITokenCheck(secret)
+			if errors.Is(err, domain.ErrAPITokenInvalid) {
+				m.logger.Info("Invalid API token", zap.String("ip", GetIP(r)))
+				w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+				m.reject(w, r, http.StatusUnauthorized)
+				return
+			}
+			if err != nil {
+				m.logger.Error("Failed to check API token", zap.Error(err))
+				m.reject(w, r, http.StatusInternalServerError)
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), APITokenCtxKey, token)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		},
+	)
+}
+
+// bearerToken достаёт токен из заголовка Authorization.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
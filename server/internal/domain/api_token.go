@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// APITokenPrefix — начало каждого токена API. По нему токен легко узнать в скриптах и логах.
+const APITokenPrefix = "ebt_"
+
+// ErrAPITokenInvalid — токен API не выпускался или отозван.
+var ErrAPITokenInvalid = errors.New("invalid API token")
+
+// APIToken — долгоживущий токен доступа к API админки, например для скриптов массовой загрузки щенков.
+// Сам токен показывается один раз при выпуске, в базе хранится только его хэш.
+type APIToken struct {
+	ID   int
+	Name string
+	// Prefix — первые символы токена, чтобы отличать токены в списке.
+	Prefix     string
+	Hash       string
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+	RevokedAt  time.Time
+}
+
+// Revoked сообщает, отозван ли токен.
+func (t APIToken) Revoked() bool {
+	return !t.RevokedAt.IsZero()
+}
+
+// APITokenHash возвращает хэш токена, по которому токен ищется в базе.
+func APITokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Article — статья базы знаний. Body хранится в Markdown и выводится на сайте после очистки.
+// Черновик (Published = false) виден только в админке. PublishedAt — дата первой публикации,
+// нулевая у статьи, которая ещё не публиковалась.
+type Article struct {
+	ID          int
+	Title       string
+	Slug        string
+	Body        string
+	CoverURL    string
+	Tags        []string
+	Published   bool
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+}
+
+// URL возвращает адрес страницы статьи.
+func (a Article) URL() string {
+	return "/article/" + a.Slug
+}
@@ -0,0 +1,13 @@
+package domain
+
+// Buyer — покупатель щенков. Один покупатель может взять несколько щенков;
+// Puppies заполняется только при просмотре карточки покупателя.
+type Buyer struct {
+	ID      int
+	Name    string
+	Phones  []string
+	Email   string
+	City    string
+	Notes   string
+	Puppies []Puppy
+}
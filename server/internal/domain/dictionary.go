@@ -0,0 +1,96 @@
+package domain
+
+import "errors"
+
+// Справочники сайта. Значение записи (Value) хранится у щенков и собак, поэтому после
+// создания записи оно не меняется; админ правит только подписи и порядок.
+const (
+	DictionaryBreeds = "breeds"
+	DictionaryColors = "colors"
+	DictionarySexes  = "sexes"
+)
+
+// DictionaryFixed — справочники, в которые нельзя добавлять записи и из которых нельзя их удалять.
+// Сайт различает пол по значениям SexMale и SexFemale (пара для вязки, карточки щенков и собак),
+// поэтому у пола админ правит только подписи и порядок.
+var DictionaryFixed = map[string]bool{
+	DictionarySexes: true,
+}
+
+// ErrDictionaryFixed — попытка добавить или удалить запись справочника из DictionaryFixed.
+var ErrDictionaryFixed = errors.New("dictionary entries cannot be added or deleted")
+
+// DictionaryTitles — названия справочников для админки.
+var DictionaryTitles = map[string]string{
+	DictionaryBreeds: "Породы",
+	DictionaryColors: "Окрасы",
+	DictionarySexes:  "Пол",
+}
+
+// DictionaryEntry — запись справочника с подписями на русском и английском.
+// Breed заполнено только у окраса: окрасы заводятся для каждой породы отдельно.
+type DictionaryEntry struct {
+	ID        int
+	Kind      string
+	Breed     string
+	Value     string
+	LabelRu   string
+	LabelEn   string
+	SortOrder int
+}
+
+// Label возвращает подпись записи на языке посетителя. Без английской подписи
+// показывается русская, без подписей — само значение.
+func (e DictionaryEntry) Label(locale string) string {
+	if locale == "en" && e.LabelEn != "" {
+		return e.LabelEn
+	}
+	if e.LabelRu != "" {
+		return e.LabelRu
+	}
+	return e.Value
+}
+
+// Dictionaries — все справочники сайта, каждый в порядке сортировки.
+type Dictionaries struct {
+	Breeds []DictionaryEntry
+	Colors []DictionaryEntry
+	Sexes  []DictionaryEntry
+}
+
+// BreedGet возвращает породу по её значению, которое используется и как адрес страницы породы.
+func (d *Dictionaries) BreedGet(value string) (DictionaryEntry, bool) {
+	for _, breed := range d.Breeds {
+		if breed.Value == value {
+			return breed, true
+		}
+	}
+	return DictionaryEntry{}, false
+}
+
+// ColorsOf возвращает окрасы породы. Для пустой породы возвращаются окрасы всех пород
+// без повторов значений.
+func (d *Dictionaries) ColorsOf(breed string) []DictionaryEntry {
+	colors := make([]DictionaryEntry, 0, len(d.Colors))
+	seen := map[string]bool{}
+	for _, color := range d.Colors {
+		if breed != "" && color.Breed != breed {
+			continue
+		}
+		if seen[color.Value] {
+			continue
+		}
+		seen[color.Value] = true
+		colors = append(colors, color)
+	}
+	return colors
+}
+
+// DictionaryValues возвращает значения записей справочника.
+func DictionaryValues(entries []DictionaryEntry) []string {
+	values := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		values = append(values, entry.Value)
+	}
+	return values
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// WeightMeasurement — взвешивание щенка в определённый день.
+type WeightMeasurement struct {
+	ID      int
+	PuppyID int
+	Date    time.Time
+	Grams   int
+}
+
+// GrowthSeries — кривая роста одного щенка из помёта.
+type GrowthSeries struct {
+	PuppyID      int
+	Name         string
+	Measurements []WeightMeasurement
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// Типы событий здоровья.
+const (
+	HealthEventVaccination = "vaccination"
+	HealthEventDeworming   = "deworming"
+	HealthEventVetCheck    = "vet_check"
+	HealthEventChip        = "chip"
+	HealthEventGeneticTest = "genetic_test"
+)
+
+// HealthEventTypeTitles — названия типов событий здоровья для вывода на сайте.
+var HealthEventTypeTitles = map[string]string{
+	HealthEventVaccination: "Прививка",
+	HealthEventDeworming:   "Обработка от паразитов",
+	HealthEventVetCheck:    "Осмотр ветеринара",
+	HealthEventChip:        "Чипирование",
+	HealthEventGeneticTest: "Генетический тест",
+}
+
+// HealthEvent — событие из ветеринарной истории щенка или взрослой собаки.
+// Заполнен ровно один из PuppyID и DogID. Title — препарат, клиника или название теста,
+// Result — номер чипа или результат теста.
+type HealthEvent struct {
+	ID          int
+	PuppyID     int
+	DogID       int
+	Type        string
+	Date        time.Time
+	Title       string
+	Result      string
+	DocumentURL string
+}
+
+// TypeTitle возвращает название типа события здоровья.
+func (e HealthEvent) TypeTitle() string {
+	return HealthEventTypeTitles[e.Type]
+}
+
+// HealthSummary — краткая сводка о здоровье для публичной страницы.
+type HealthSummary struct {
+	Chip          *HealthEvent
+	Vaccinations  []HealthEvent
+	LastDeworming *HealthEvent
+	LastVetCheck  *HealthEvent
+	GeneticTests  []HealthEvent
+}
+
+// Empty сообщает, что в сводке нечего показывать.
+func (s HealthSummary) Empty() bool {
+	return s.Chip == nil && len(s.Vaccinations) == 0 && s.LastDeworming == nil && s.LastVetCheck == nil &&
+		len(s.GeneticTests) == 0
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// Пол собаки — значения справочника пола, с которыми сравниваются родители пары.
+// Справочник пола закрыт (DictionaryFixed), поэтому других значений у собак и щенков не бывает.
+const (
+	SexMale   = "Кобель"
+	SexFemale = "Сука"
+)
+
+// ErrMatingSexes — в паре отец не кобель или мать не сука.
+var ErrMatingSexes = errors.New("sire must be male and dam must be female")
+
+// CommonAncestor — общий предок отца и матери и его вклад в коэффициент инбридинга.
+type CommonAncestor struct {
+	Dog          Dog
+	Contribution float64
+}
+
+// COIResult — коэффициент инбридинга по Райту для пары собак.
+type COIResult struct {
+	SireID          int
+	DamID           int
+	Coefficient     float64
+	CommonAncestors []CommonAncestor
+}
+
+// ColorOutcome — ожидаемый окрас щенков и его вероятность.
+type ColorOutcome struct {
+	Color       string
+	Probability float64
+}
+
+// ColorPrediction — ожидаемое распределение окрасов для пары.
+type ColorPrediction struct {
+	Outcomes []ColorOutcome
+	// DoubleMerle выставляется для вязки мерле × мерле: часть щенков будут двойными мерле.
+	DoubleMerle bool
+	Warnings    []string
+}
+
+// Статусы запланированной вязки.
+const (
+	MatingStatusPlanned   = "planned"
+	MatingStatusConfirmed = "confirmed"
+	MatingStatusPregnant  = "pregnant"
+	MatingStatusWhelped   = "whelped"
+	MatingStatusCancelled = "cancelled"
+)
+
+// MatingStatusTitles — названия статусов вязки для вывода на сайте.
+var MatingStatusTitles = map[string]string{
+	MatingStatusPlanned:   "Планируется",
+	MatingStatusConfirmed: "Вязка состоялась",
+	MatingStatusPregnant:  "Беременность подтверждена",
+	MatingStatusWhelped:   "Щенки родились",
+	MatingStatusCancelled: "Отменена",
+}
+
+// PlannedMating — запланированная вязка двух собак с ожидаемой датой помёта.
+type PlannedMating struct {
+	ID           int
+	MotherID     int
+	FatherID     int
+	ExpectedDate time.Time
+	Status       string
+	Description  string
+	// LitterID заполняется, когда вязка завершилась помётом.
+	LitterID int
+}
+
+// UpcomingLitter — анонс ожидаемого помёта для покупателей.
+type UpcomingLitter struct {
+	Mating PlannedMating
+	Mother Dog
+	Father Dog
+}
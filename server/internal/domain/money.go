@@ -0,0 +1,147 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Валюты, в которых можно указать цену щенка.
+const (
+	CurrencyRUB = "RUB"
+	CurrencyEUR = "EUR"
+	CurrencyUSD = "USD"
+)
+
+// CurrencySymbols — символы валют для вывода цены.
+var CurrencySymbols = map[string]string{
+	CurrencyRUB: "₽",
+	CurrencyEUR: "€",
+	CurrencyUSD: "$",
+}
+
+// Money — денежная сумма в минимальных единицах валюты: копейках или центах.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// moneyGroups — сумма, записанная только разрядами по три цифры: "1.500", "1,500" или "120.000".
+// Такая запись читается как целое число, а не как дробь.
+var moneyGroups = regexp.MustCompile(`^[0-9]{1,3}(?:[.,][0-9]{3})+$`)
+
+// moneyNoise — всё, что не относится к записи суммы: символы валют, "руб.", неразрывные пробелы.
+var moneyNoise = regexp.MustCompile(`[^0-9 .,]`)
+
+// ParseMoney разбирает сумму в основных единицах валюты. Символ валюты и "руб." игнорируются,
+// последний разделитель перед дробной частью может быть точкой или запятой. Дробная часть длиннее
+// двух цифр округляется до копеек или центов. Отрицательные суммы не допускаются.
+func ParseMoney(value, currency string) (Money, error) {
+	if _, ok := CurrencySymbols[currency]; !ok {
+		return Money{}, fmt.Errorf("unknown currency: %s", currency)
+	}
+	if strings.Contains(value, "-") {
+		return Money{}, fmt.Errorf("negative amount: %s", value)
+	}
+
+	cleaned := strings.ReplaceAll(strings.Trim(moneyNoise.ReplaceAllString(value, ""), " .,"), " ", "")
+	whole, fraction := cleaned, ""
+	if i := strings.LastIndexAny(cleaned, ".,"); i >= 0 && !moneyGroups.MatchString(cleaned) {
+		whole, fraction = cleaned[:i], cleaned[i+1:]
+	}
+	whole = strings.NewReplacer(".", "", ",", "").Replace(whole)
+
+	major, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || major > math.MaxInt64/100-1 {
+		return Money{}, fmt.Errorf("invalid amount: %s", value)
+	}
+	var minor int64
+	if fraction != "" {
+		// Третья цифра дробной части решает округление
+		digits := (fraction + "00")[:3]
+		cents, _ := strconv.ParseInt(digits, 10, 64)
+		minor = (cents + 5) / 10
+	}
+
+	return Money{Amount: major*100 + minor, Currency: currency}, nil
+}
+
+// IsZero сообщает, что цена не указана.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Decimal возвращает сумму в основных единицах без разделителей разрядов, например "120000" или "120000.50".
+// Используется в полях форм.
+func (m Money) Decimal() string {
+	if m.Amount%100 == 0 {
+		return strconv.FormatInt(m.Amount/100, 10)
+	}
+	return fmt.Sprintf("%d.%02d", m.Amount/100, m.Amount%100)
+}
+
+// moneyLocale описывает, как записывают суммы в языке посетителя.
+type moneyLocale struct {
+	group        string
+	decimal      string
+	symbolBefore bool
+}
+
+// moneyLocales — правила записи сумм по языкам. Остальные языки используют английские правила.
+var moneyLocales = map[string]moneyLocale{
+	"ru": {group: "\u00a0", decimal: ",", symbolBefore: false},
+	"uk": {group: "\u00a0", decimal: ",", symbolBefore: false},
+	"be": {group: "\u00a0", decimal: ",", symbolBefore: false},
+	"kk": {group: "\u00a0", decimal: ",", symbolBefore: false},
+	"fr": {group: "\u00a0", decimal: ",", symbolBefore: false},
+	"de": {group: ".", decimal: ",", symbolBefore: false},
+	"es": {group: ".", decimal: ",", symbolBefore: false},
+	"it": {group: ".", decimal: ",", symbolBefore: false},
+	"en": {group: ",", decimal: ".", symbolBefore: true},
+}
+
+// Format записывает сумму по правилам языка посетителя, например "120 000 ₽" для "ru" или "₽120,000" для "en".
+func (m Money) Format(locale string) string {
+	rules, ok := moneyLocales[locale]
+	if !ok {
+		rules = moneyLocales["en"]
+	}
+
+	digits := strconv.FormatInt(m.Amount/100, 10)
+	var grouped strings.Builder
+	for i, digit := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			grouped.WriteString(rules.group)
+		}
+		grouped.WriteRune(digit)
+	}
+	if m.Amount%100 != 0 {
+		grouped.WriteString(fmt.Sprintf("%s%02d", rules.decimal, m.Amount%100))
+	}
+
+	symbol := CurrencySymbols[m.Currency]
+	if symbol == "" {
+		symbol = m.Currency
+	}
+	if rules.symbolBefore {
+		return symbol + grouped.String()
+	}
+	return grouped.String() + "\u00a0" + symbol
+}
+
+// String записывает сумму по правилам русского языка.
+func (m Money) String() string {
+	return m.Format("ru")
+}
+
+// PriceChange — изменение цены щенка.
+type PriceChange struct {
+	ID        int
+	PuppyID   int
+	OldPrice  Money
+	NewPrice  Money
+	ChangedAt time.Time
+}
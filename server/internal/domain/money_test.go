@@ -0,0 +1,92 @@
+package domain_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		currency       string
+		expectedAmount int64
+		expectError    bool
+	}{
+		{name: "Plain", value: "1500", currency: domain.CurrencyRUB, expectedAmount: 150000},
+		{name: "Space groups", value: "1 500", currency: domain.CurrencyRUB, expectedAmount: 150000},
+		{name: "Dot groups", value: "1.500", currency: domain.CurrencyRUB, expectedAmount: 150000},
+		{name: "Comma groups", value: "1,500,000", currency: domain.CurrencyUSD, expectedAmount: 150000000},
+		{name: "Comma decimal", value: "1,50", currency: domain.CurrencyRUB, expectedAmount: 150},
+		{name: "One decimal digit", value: "1500.5", currency: domain.CurrencyRUB, expectedAmount: 150050},
+		{name: "Groups and decimal", value: "120.000,50", currency: domain.CurrencyEUR, expectedAmount: 12000050},
+		{name: "Symbol and rubles", value: "120 000 руб.", currency: domain.CurrencyRUB, expectedAmount: 12000000},
+		{name: "Rounded down", value: "1500.554", currency: domain.CurrencyRUB, expectedAmount: 150055},
+		{name: "Rounded up", value: "1500.555", currency: domain.CurrencyRUB, expectedAmount: 150056},
+		{name: "Rounded to next unit", value: "9.9999", currency: domain.CurrencyRUB, expectedAmount: 1000},
+		{name: "Negative", value: "-1500", currency: domain.CurrencyRUB, expectError: true},
+		{name: "Negative with symbol", value: "₽-1,50", currency: domain.CurrencyRUB, expectError: true},
+		{name: "Unknown currency", value: "1500", currency: "GBP", expectError: true},
+		{name: "No digits", value: "по запросу", currency: domain.CurrencyRUB, expectError: true},
+		{name: "Overflow", value: "99999999999999999999", currency: domain.CurrencyRUB, expectError: true},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				money, err := domain.ParseMoney(test.value, test.currency)
+				if test.expectError {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, domain.Money{Amount: test.expectedAmount, Currency: test.currency}, money)
+			},
+		)
+	}
+}
+
+func TestMoney_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		money    domain.Money
+		locale   string
+		expected string
+	}{
+		{name: "Russian", money: domain.Money{Amount: 12000000, Currency: domain.CurrencyRUB}, locale: "ru", expected: "120 000 ₽"},
+		{name: "Russian kopecks", money: domain.Money{Amount: 12000050, Currency: domain.CurrencyRUB}, locale: "ru", expected: "120 000,50 ₽"},
+		{name: "English", money: domain.Money{Amount: 150000000, Currency: domain.CurrencyUSD}, locale: "en", expected: "$1,500,000"},
+		{name: "English cents", money: domain.Money{Amount: 150005, Currency: domain.CurrencyUSD}, locale: "en", expected: "$1,500.05"},
+		{name: "German", money: domain.Money{Amount: 150000, Currency: domain.CurrencyEUR}, locale: "de", expected: "1.500 €"},
+		{name: "Unknown locale", money: domain.Money{Amount: 150000, Currency: domain.CurrencyEUR}, locale: "pt", expected: "€1,500"},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				assert.Equal(t, test.expected, test.money.Format(test.locale))
+			},
+		)
+	}
+}
+
+// Записанная на любом языке сумма должна читаться обратно без потерь: так цена попадает в формы.
+func TestMoney_FormatParseMoney(t *testing.T) {
+	amounts := []int64{0, 5, 50, 100, 99999, 150000, 12000050, 150000000, 123456789}
+	for _, locale := range []string{"ru", "en", "de", "fr"} {
+		for _, currency := range []string{domain.CurrencyRUB, domain.CurrencyEUR, domain.CurrencyUSD} {
+			for _, amount := range amounts {
+				money := domain.Money{Amount: amount, Currency: currency}
+
+				parsed, err := domain.ParseMoney(money.Format(locale), currency)
+				if assert.NoError(t, err, money.Format(locale)) {
+					assert.Equal(t, money, parsed, money.Format(locale))
+				}
+
+				parsed, err = domain.ParseMoney(money.Decimal(), currency)
+				if assert.NoError(t, err, money.Decimal()) {
+					assert.Equal(t, money, parsed, money.Decimal())
+				}
+			}
+		}
+	}
+}
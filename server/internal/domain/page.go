@@ -0,0 +1,38 @@
+package domain
+
+// Page — запрос одной страницы списка.
+type Page struct {
+	// Number — номер страницы, начиная с 1.
+	Number int
+	// Size — количество записей на странице. Нулевой размер означает весь список одной страницей.
+	Size int
+	// After — ID последней записи предыдущей страницы. Если он указан, страница выбирается
+	// по ключу (keyset), а не смещением: так следующая страница не съезжает, когда в начало
+	// списка добавляются новые записи.
+	After int
+}
+
+// Offset возвращает количество записей, которые пропускаются до начала страницы.
+// При выборке по ключу пропускать ничего не нужно.
+func (p Page) Offset() int {
+	if p.After > 0 || p.Number <= 1 {
+		return 0
+	}
+	return (p.Number - 1) * p.Size
+}
+
+// TotalPages возвращает количество страниц для total записей, но не меньше одной.
+func (p Page) TotalPages(total int) int {
+	if p.Size <= 0 || total <= p.Size {
+		return 1
+	}
+	return (total + p.Size - 1) / p.Size
+}
+
+// PageSizes — количество записей на странице в списках сайта.
+type PageSizes struct {
+	Puppies  int
+	Reviews  int
+	Dogs     int
+	Articles int
+}
@@ -1,19 +1,132 @@
 package domain
 
+import (
+	"strconv"
+	"time"
+)
+
+// Puppy представляет щенка. ReservedUntil заполнено только для забронированного щенка,
+// BuyerID — только для забронированного или проданного.
+// ReadyOut вычисляется при чтении: ручная отметка ReadyOutOverride, если админ её поставил,
+// иначе наступила ли ReadyOutDate. Нулевые DateBirth и ReadyOutDate означают, что дата не известна.
 type Puppy struct {
-	ID        int
-	Name      string
-	Title     string
-	Sex       string
-	Price     string
-	ReadyOut  bool
-	Archived  bool
-	City      string
-	MotherID  int
-	FatherID  int
-	DateBirth string
-	Color     string
-	Urls      []string
+	ID               int
+	Name             string
+	Title            string
+	Sex              string
+	Breed            string
+	Price            Money
+	ReadyOut         bool
+	ReadyOutOverride *bool
+	ReadyOutDate     time.Time
+	Status           string
+	ReservedUntil    time.Time
+	StatusChangedAt  time.Time
+	City             string
+	MotherID         int
+	FatherID         int
+	DateBirth        time.Time
+	Color            string
+	LitterID         int
+	BuyerID          int
+	Urls             []string
+}
+
+// AgeWeeks возвращает возраст щенка в полных неделях, 0 — если дата рождения не известна.
+func (p Puppy) AgeWeeks() int {
+	if p.DateBirth.IsZero() {
+		return 0
+	}
+	return int(time.Since(p.DateBirth).Hours() / 24 / 7)
+}
+
+// ReadyOutSetting возвращает ручную отметку готовности к переезду для формы админки:
+// "true", "false" или "", если готовность считается автоматически.
+func (p Puppy) ReadyOutSetting() string {
+	if p.ReadyOutOverride == nil {
+		return ""
+	}
+	return strconv.FormatBool(*p.ReadyOutOverride)
+}
+
+// Статусы щенка: свободен → забронирован → продан → возвращён.
+const (
+	PuppyStatusAvailable = "available"
+	PuppyStatusReserved  = "reserved"
+	PuppyStatusSold      = "sold"
+	PuppyStatusReturned  = "returned"
+)
+
+// PuppyStatusTitles — названия статусов щенка для вывода на сайте.
+var PuppyStatusTitles = map[string]string{
+	PuppyStatusAvailable: "Свободен",
+	PuppyStatusReserved:  "Забронирован",
+	PuppyStatusSold:      "Продан",
+	PuppyStatusReturned:  "Возвращён",
+}
+
+// StatusTitle возвращает название статуса щенка.
+func (p Puppy) StatusTitle() string {
+	return PuppyStatusTitles[p.Status]
+}
+
+// Порядок вывода щенков в каталоге.
+const (
+	PuppySortNewest    = "newest"
+	PuppySortPriceAsc  = "price_asc"
+	PuppySortPriceDesc = "price_desc"
+	PuppySortAgeAsc    = "age_asc"
+	PuppySortAgeDesc   = "age_desc"
+)
+
+// PuppySorts — порядки вывода щенков в том порядке, в каком они предлагаются на сайте.
+var PuppySorts = []string{PuppySortNewest, PuppySortPriceAsc, PuppySortPriceDesc, PuppySortAgeAsc, PuppySortAgeDesc}
+
+// PuppySortTitles — названия порядков вывода щенков для сайта.
+var PuppySortTitles = map[string]string{
+	PuppySortNewest:    "Сначала новые",
+	PuppySortPriceAsc:  "Сначала дешевле",
+	PuppySortPriceDesc: "Сначала дороже",
+	PuppySortAgeAsc:    "Сначала младше",
+	PuppySortAgeDesc:   "Сначала старше",
+}
+
+// PuppyFilter — порядок вывода и диапазонные фильтры каталога щенков.
+// Нулевая граница диапазона не ограничивает выборку.
+type PuppyFilter struct {
+	Sort string
+	// PriceFrom и PriceTo — границы цены в рублях. Цены в других валютах не пересчитываются,
+	// поэтому при заданной цене в выборку попадают только щенки с ценой в рублях.
+	PriceFrom int
+	PriceTo   int
+	// AgeFrom и AgeTo — границы возраста в полных неделях, как в AgeWeeks.
+	AgeFrom int
+	AgeTo   int
+	City    string
+}
+
+// Keyset сообщает, что щенки выводятся по убыванию ID и следующую страницу можно выбрать по ключу.
+func (f PuppyFilter) Keyset() bool {
+	return f.Sort == "" || f.Sort == PuppySortNewest
+}
+
+// PuppyFacets — количество щенков для каждого окраса и пола с учётом остальных выбранных фильтров:
+// для окраса учитываются все фильтры, кроме окраса, для пола — все, кроме пола.
+type PuppyFacets struct {
+	Colors map[string]int
+	Sexes  map[string]int
+}
+
+// PuppyStatusChange — запись о смене статуса щенка.
+type PuppyStatusChange struct {
+	PuppyID       int
+	From          string
+	To            string
+	ReservedUntil time.Time
+	BuyerID       int
+	City          string
+	Phone         string
+	ChangedAt     time.Time
 }
 
 type Dog struct {
@@ -21,9 +134,32 @@ type Dog struct {
 	Name     string
 	Title    string
 	Gender   string
+	Breed    string
 	Color    string
 	Archived bool
+	SireID   int
+	DamID    int
+	External bool
+	Kennel   string
+	Genotype Genotype
 	Urls     []string
+	// EarnedTitles — все титулы собаки из результатов выставок, с повторами.
+	EarnedTitles []string
+}
+
+// Genotype — генотип собаки по локусам окраса в виде пар аллелей, например "Bb".
+// Пустая строка означает, что генотип по локусу не известен.
+type Genotype struct {
+	B string
+	D string
+	M string
+}
+
+// PedigreeNode представляет собаку в родословной вместе с её предками.
+type PedigreeNode struct {
+	Dog  Dog
+	Sire *PedigreeNode
+	Dam  *PedigreeNode
 }
 
 type Feedback struct {
@@ -36,3 +172,16 @@ type Feedback struct {
 	Date     string
 	Urls     []string
 }
+
+// Litter представляет помёт — щенков, рожденных от одной пары в один день.
+// Порода помёта — порода матери.
+type Litter struct {
+	ID           int
+	Breed        string
+	MotherID     int
+	FatherID     int
+	DateBirth    time.Time
+	ReadyOutDate time.Time
+	Description  string
+	Puppies      []Puppy
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "strconv"
+
+// Разделы сайта, по которым идёт поиск.
+const (
+	SearchKindPuppy   = "puppy"
+	SearchKindDog     = "dog"
+	SearchKindReview  = "review"
+	SearchKindArticle = "article"
+)
+
+// SearchKindTitles — названия разделов поиска для вывода на сайте.
+var SearchKindTitles = map[string]string{
+	SearchKindPuppy:   "Щенок",
+	SearchKindDog:     "Собака",
+	SearchKindReview:  "Отзыв",
+	SearchKindArticle: "Статья",
+}
+
+// Маркеры совпадений во фрагменте результата поиска. Фрагмент — текст из базы, поэтому
+// перед выводом он экранируется, а маркеры заменяются на разметку.
+const (
+	SearchHighlightStart = "⟦"
+	SearchHighlightStop  = "⟧"
+)
+
+// SearchResult — найденный щенок, собака, отзыв или статья. Для отзыва ID — это щенок, к которому
+// оставлен отзыв: отзывы показываются на странице щенка.
+type SearchResult struct {
+	Kind    string
+	ID      int
+	Title   string
+	Snippet string
+	Rank    float64
+}
+
+// KindTitle возвращает название раздела, в котором найден результат.
+func (r SearchResult) KindTitle() string {
+	return SearchKindTitles[r.Kind]
+}
+
+// URL возвращает адрес страницы найденного результата.
+func (r SearchResult) URL() string {
+	switch r.Kind {
+	case SearchKindDog:
+		return "/dogs/" + strconv.Itoa(r.ID)
+	case SearchKindArticle:
+		return "/article/" + strconv.Itoa(r.ID)
+	default:
+		return "/puppies/" + strconv.Itoa(r.ID)
+	}
+}
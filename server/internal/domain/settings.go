@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// SiteSettings — настройки сайта, которые админ правит в панели: контакты, ссылки на соцсети,
+// точка на карте, текст футера и SEO по умолчанию. Настройки доступны во всех шаблонах сайта.
+// Пустое поле на сайте не выводится.
+type SiteSettings struct {
+	Phone        string
+	Email        string
+	Address      string
+	OpeningHours string
+
+	Telegram  string
+	WhatsApp  string
+	Instagram string
+	VK        string
+
+	// MapLat и MapLng — координаты точки на карте. Нулевые координаты означают, что карты нет.
+	MapLat float64
+	MapLng float64
+
+	FooterText string
+
+	SEOTitle       string
+	SEODescription string
+	SEOKeywords    string
+
+	UpdatedAt time.Time
+}
+
+// DefaultSiteSettings возвращает настройки, с которыми сайт выводится, пока их не удалось получить.
+func DefaultSiteSettings() *SiteSettings {
+	return &SiteSettings{
+		Phone:      "+7 961 367 85-83",
+		Address:    "Россия, пгт. Иглино (г.Уфа)",
+		Telegram:   "https://t.me/+79613678583",
+		WhatsApp:   "https://wa.me/+79613678583",
+		FooterText: "© 2024 Elza Breeder",
+		SEOTitle:   "Elza Breeder",
+	}
+}
+
+// PhoneDigits возвращает телефон для ссылки tel: — "+79613678583" для "+7 961 367 85-83".
+func (s SiteSettings) PhoneDigits() string {
+	var digits strings.Builder
+	for _, r := range s.Phone {
+		if (r >= '0' && r <= '9') || (r == '+' && digits.Len() == 0) {
+			digits.WriteRune(r)
+		}
+	}
+	return digits.String()
+}
+
+// Title возвращает заголовок страниц сайта. Без заданного заголовка выводится заголовок по умолчанию.
+func (s SiteSettings) Title() string {
+	if s.SEOTitle == "" {
+		return DefaultSiteSettings().SEOTitle
+	}
+	return s.SEOTitle
+}
+
+// HasMap сообщает, указана ли точка на карте.
+func (s SiteSettings) HasMap() bool {
+	return s.MapLat != 0 || s.MapLng != 0
+}
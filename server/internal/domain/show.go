@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ShowTitles — титулы и сертификаты от самых значимых к менее значимым.
+// В этом порядке титулы выводятся в строке TitlesLine.
+var ShowTitles = []string{
+	"ICh", "Ch", "JCh", "BIS", "BIG", "BOB", "BOS", "CACIB", "R.CACIB", "CACIT", "CACT", "CAC", "R.CAC", "JCAC",
+}
+
+// ShowGrades — оценки, которые ставит эксперт на выставке.
+var ShowGrades = []string{
+	"Отлично", "Очень хорошо", "Хорошо", "Удовлетворительно", "Очень перспективный", "Перспективный",
+	"Дисквалификация",
+}
+
+// ShowResult — результат собаки на выставке или рабочих испытаниях.
+// Titles — полученные титулы и сертификаты, CertificateURL — скан диплома или сертификата.
+type ShowResult struct {
+	ID             int
+	DogID          int
+	Event          string
+	Date           time.Time
+	Judge          string
+	Grade          string
+	Titles         []string
+	CertificateURL string
+}
+
+// TitlesLine собирает строку титулов собаки по всем её результатам, например "JCh, 2×CACIB, CAC".
+// Повторяющиеся титулы считаются, титулы упорядочены по значимости. Титулы не из ShowTitles
+// идут последними по алфавиту.
+func (d Dog) TitlesLine() string {
+	counts := make(map[string]int)
+	for _, title := range d.EarnedTitles {
+		counts[title]++
+	}
+
+	rank := make(map[string]int, len(ShowTitles))
+	for i, title := range ShowTitles {
+		rank[title] = i
+	}
+	rankOf := func(title string) int {
+		if r, ok := rank[title]; ok {
+			return r
+		}
+		return len(ShowTitles)
+	}
+
+	titles := make([]string, 0, len(counts))
+	for title := range counts {
+		titles = append(titles, title)
+	}
+	sort.Slice(
+		titles, func(i, j int) bool {
+			if rankOf(titles[i]) != rankOf(titles[j]) {
+				return rankOf(titles[i]) < rankOf(titles[j])
+			}
+			return titles[i] < titles[j]
+		},
+	)
+
+	parts := make([]string, 0, len(titles))
+	for _, title := range titles {
+		if counts[title] > 1 {
+			parts = append(parts, fmt.Sprintf("%d×%s", counts[title], title))
+		} else {
+			parts = append(parts, title)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
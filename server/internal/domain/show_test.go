@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDog_TitlesLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		titles   []string
+		expected string
+	}{
+		{name: "No titles", titles: nil, expected: ""},
+		{name: "Single title", titles: []string{"CAC"}, expected: "CAC"},
+		{
+			name:     "Counted and ranked",
+			titles:   []string{"CAC", "CACIB", "JCh", "CACIB"},
+			expected: "JCh, 2×CACIB, CAC",
+		}, {
+			name:     "Ranked by ShowTitles",
+			titles:   []string{"JCAC", "R.CAC", "BOB", "ICh", "BIS", "Ch"},
+			expected: "ICh, Ch, BIS, BOB, R.CAC, JCAC",
+		}, {
+			name:     "Reserve titles counted apart",
+			titles:   []string{"R.CACIB", "CACIB", "R.CACIB", "R.CACIB"},
+			expected: "CACIB, 3×R.CACIB",
+		}, {
+			name:     "Unknown titles last",
+			titles:   []string{"Veteran BOB", "CAC", "Club Winner", "Veteran BOB"},
+			expected: "CAC, Club Winner, 2×Veteran BOB",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				dog := domain.Dog{EarnedTitles: test.titles}
+				assert.Equal(t, test.expected, dog.TitlesLine())
+			},
+		)
+	}
+}
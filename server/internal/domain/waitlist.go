@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// WaitlistEntry — заявка в лист ожидания щенка породы Breed. Пустые Colors и Gender означают
+// любой окрас и пол, нулевой Budget — без ограничения цены, нулевые даты — открытый срок.
+type WaitlistEntry struct {
+	ID          int
+	Name        string
+	Phone       string
+	Email       string
+	Breed       string
+	Colors      []string
+	Gender      string
+	Budget      int
+	WantedFrom  time.Time
+	WantedUntil time.Time
+	Comment     string
+	Active      bool
+	CreatedAt   time.Time
+}
+
+// WaitlistNotification — поставленное в очередь уведомление о подходящем щенке.
+// SentAt заполняется, когда щенка предложили человеку из листа ожидания.
+type WaitlistNotification struct {
+	ID        int
+	EntryID   int
+	PuppyID   int
+	CreatedAt time.Time
+	SentAt    time.Time
+}
+
+// WaitlistCandidate — заявка из листа ожидания, подходящая щенку, с её местом в очереди.
+type WaitlistCandidate struct {
+	Rank         int
+	Entry        WaitlistEntry
+	Notification WaitlistNotification
+}
@@ -1,10 +1,14 @@
 package handlers
 
 import (
+	"errors"
 	"github.com/egosha7/site-go/internal/domain"
 	"go.uber.org/zap"
+	"mime/multipart"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 )
 
 func (h *Handler) AdminNewUser(w http.ResponseWriter, r *http.Request) {
@@ -44,12 +48,27 @@ func (h *Handler) UpdateDog(w http.ResponseWriter, r *http.Request) {
 
 	name := r.FormValue("name")
 
-	sex := r.FormValue("gender")
-
-	color := r.FormValue("color")
+	breed, sex, color, ok := h.parseBreedSexAndColor(w, r)
+	if !ok {
+		return
+	}
 
 	title := r.FormValue("title")
 
+	kennel := r.FormValue("kennel")
+
+	external := r.FormValue("external") == "true"
+
+	sireID, damID, ok := h.parseDogParents(w, r)
+	if !ok {
+		return
+	}
+
+	genotype, ok := h.parseDogGenotype(w, r)
+	if !ok {
+		return
+	}
+
 	dogID, err := strconv.Atoi(r.FormValue("id"))
 	if err != nil {
 		h.logger.Error("Invalid dog ID", zap.Error(err))
@@ -67,8 +86,14 @@ func (h *Handler) UpdateDog(w http.ResponseWriter, r *http.Request) {
 		zap.Int("dogID", dogID),
 		zap.String("Name", name),
 		zap.String("Gender", sex),
+		zap.String("Breed", breed),
 		zap.String("Color", color),
 		zap.String("Title", title),
+		zap.Int("SireID", sireID),
+		zap.Int("DamID", damID),
+		zap.Bool("External", external),
+		zap.String("Kennel", kennel),
+		zap.Any("Genotype", genotype),
 	)
 
 	// Create Puppy struct
@@ -77,8 +102,13 @@ func (h *Handler) UpdateDog(w http.ResponseWriter, r *http.Request) {
 		Name:     name,
 		Title:    title,
 		Gender:   sex,
+		Breed:    breed,
 		Color:    color,
-		Archived: false,          // Assuming this is not being set from form
+		SireID:   sireID,
+		DamID:    damID,
+		External: external,
+		Kennel:   kennel,
+		Genotype: genotype,
 		Urls:     existingPhotos, // Placeholder URLs
 	}
 
@@ -90,7 +120,91 @@ func (h *Handler) UpdateDog(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/dogs", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/dogs", http.StatusOK, newAPIDog(*dog))
+}
+
+// parseDogParents разбирает необязательные поля отца и матери собаки. При ошибке ответ уже записан.
+func (h *Handler) parseDogParents(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	sireID, damID := 0, 0
+	var err error
+
+	if sire := r.FormValue("sire"); sire != "" {
+		sireID, err = strconv.Atoi(sire)
+		if err != nil {
+			h.logger.Error("Invalid sire ID", zap.Error(err))
+			http.Error(w, "Invalid sire ID", http.StatusBadRequest)
+			return 0, 0, false
+		}
+	}
+
+	if dam := r.FormValue("dam"); dam != "" {
+		damID, err = strconv.Atoi(dam)
+		if err != nil {
+			h.logger.Error("Invalid dam ID", zap.Error(err))
+			http.Error(w, "Invalid dam ID", http.StatusBadRequest)
+			return 0, 0, false
+		}
+	}
+
+	return sireID, damID, true
+}
+
+// parseBreedSexAndColor проверяет породу, пол и окрас из формы по справочникам. Окрас должен
+// быть заведён для выбранной породы. При ошибке ответ уже записан.
+func (h *Handler) parseBreedSexAndColor(w http.ResponseWriter, r *http.Request) (string, string, string, bool) {
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Failed to get dictionaries", zap.Error(err))
+		http.Error(w, "Failed to get dictionaries", http.StatusInternalServerError)
+		return "", "", "", false
+	}
+
+	breed, ok := dictionaries.BreedGet(r.FormValue("breed"))
+	if !ok {
+		h.logger.Error("Invalid breed", zap.String("breed", r.FormValue("breed")))
+		http.Error(w, "Invalid breed", http.StatusBadRequest)
+		return "", "", "", false
+	}
+
+	genders, err := ValidateGender([]string{r.FormValue("gender")}, domain.DictionaryValues(dictionaries.Sexes))
+	if err != nil {
+		h.logger.Error("Invalid gender", zap.Error(err))
+		http.Error(w, "Invalid gender", http.StatusBadRequest)
+		return "", "", "", false
+	}
+
+	colors, err := ValidateChocolates(
+		[]string{r.FormValue("color")}, domain.DictionaryValues(dictionaries.ColorsOf(breed.Value)),
+	)
+	if err != nil {
+		h.logger.Error("Invalid color", zap.Error(err))
+		http.Error(w, "Invalid color", http.StatusBadRequest)
+		return "", "", "", false
+	}
+
+	return breed.Value, genders[0], colors[0], true
+}
+
+// parseDogGenotype разбирает генотип собаки по локусам окраса. При ошибке ответ уже записан.
+func (h *Handler) parseDogGenotype(w http.ResponseWriter, r *http.Request) (domain.Genotype, bool) {
+	var genotype domain.Genotype
+	for _, locus := range []struct {
+		name  string
+		field *string
+	}{
+		{"B", &genotype.B},
+		{"D", &genotype.D},
+		{"M", &genotype.M},
+	} {
+		value, err := ValidateGenotype(locus.name, r.FormValue("genotype"+locus.name))
+		if err != nil {
+			h.logger.Error("Invalid genotype", zap.Error(err))
+			http.Error(w, "Invalid genotype", http.StatusBadRequest)
+			return domain.Genotype{}, false
+		}
+		*locus.field = value
+	}
+	return genotype, true
 }
 
 func (h *Handler) ChangeArchivedDog(w http.ResponseWriter, r *http.Request) {
@@ -124,9 +238,9 @@ func (h *Handler) ChangeArchivedDog(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if archived == "true" {
-		http.Redirect(w, r, "/admin/dogs", http.StatusSeeOther)
+		h.respondAdmin(w, r, "/admin/dogs", http.StatusNoContent, nil)
 	} else {
-		http.Redirect(w, r, "/admin/archive/dogs", http.StatusSeeOther)
+		h.respondAdmin(w, r, "/admin/archive/dogs", http.StatusNoContent, nil)
 	}
 
 }
@@ -146,16 +260,24 @@ func (h *Handler) AddDog(w http.ResponseWriter, r *http.Request) {
 
 	name := r.FormValue("name")
 
-	sex := r.FormValue("gender")
-
-	color := r.FormValue("color")
+	breed, sex, color, ok := h.parseBreedSexAndColor(w, r)
+	if !ok {
+		return
+	}
 
 	title := r.FormValue("title")
 
-	dogID, err := strconv.Atoi(r.FormValue("id"))
-	if err != nil {
-		h.logger.Error("Invalid dog ID", zap.Error(err))
-		http.Error(w, "Invalid dog ID", http.StatusBadRequest)
+	kennel := r.FormValue("kennel")
+
+	external := r.FormValue("external") == "true"
+
+	sireID, damID, ok := h.parseDogParents(w, r)
+	if !ok {
+		return
+	}
+
+	genotype, ok := h.parseDogGenotype(w, r)
+	if !ok {
 		return
 	}
 
@@ -164,21 +286,32 @@ func (h *Handler) AddDog(w http.ResponseWriter, r *http.Request) {
 
 	h.logger.Info(
 		"Dog add",
-		zap.Int("dogID", dogID),
 		zap.String("Name", name),
 		zap.String("Gender", sex),
+		zap.String("Breed", breed),
 		zap.String("Color", color),
 		zap.String("Title", title),
+		zap.Int("SireID", sireID),
+		zap.Int("DamID", damID),
+		zap.Bool("External", external),
+		zap.String("Kennel", kennel),
+		zap.Any("Genotype", genotype),
 	)
 
 	// Create Puppy struct
+	// ID назначает база данных
 	dog := &domain.Dog{
-		ID:       dogID,
 		Name:     name,
 		Title:    title,
 		Gender:   sex,
+		Breed:    breed,
 		Color:    color,
-		Archived: false,      // Assuming this is not being set from form
+		Archived: false, // Assuming this is not being set from form
+		SireID:   sireID,
+		DamID:    damID,
+		External: external,
+		Kennel:   kennel,
+		Genotype: genotype,
 		Urls:     []string{}, // Placeholder URLs
 	}
 
@@ -190,10 +323,11 @@ func (h *Handler) AddDog(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/dogs", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/dogs", http.StatusCreated, newAPIDog(*dog))
 }
 
-func (h *Handler) ChangeArchivedPuppy(w http.ResponseWriter, r *http.Request) {
+// ChangeStatusPuppy меняет статус щенка: бронь, продажа, возврат.
+func (h *Handler) ChangeStatusPuppy(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
 		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
 		return
@@ -208,31 +342,58 @@ func (h *Handler) ChangeArchivedPuppy(w http.ResponseWriter, r *http.Request) {
 
 	puppyID := r.FormValue("id")
 
-	archived := r.FormValue("archived")
+	status := r.FormValue("status")
+	if _, ok := domain.PuppyStatusTitles[status]; !ok {
+		h.logger.Error("Invalid puppy status", zap.String("status", status))
+		http.Error(w, "Invalid puppy status", http.StatusBadRequest)
+		return
+	}
+
+	var reservedUntil time.Time
+	if status == domain.PuppyStatusReserved {
+		reservedUntil, err = time.Parse("2006-01-02", r.FormValue("reservedUntil"))
+		if err != nil {
+			h.logger.Error("Invalid reservation date", zap.Error(err))
+			http.Error(w, "Invalid reservation date", http.StatusBadRequest)
+			return
+		}
+	}
 
 	city := r.FormValue("city")
 
-	phone := r.FormValue("phone")
+	phones, err := ValidatePhones([]string{r.FormValue("phone")})
+	if err != nil {
+		h.logger.Error("Invalid buyer phone", zap.Error(err))
+		http.Error(w, "Invalid buyer phone", http.StatusBadRequest)
+		return
+	}
+
+	// Покупатель ищется по телефону, имя нужно только для нового покупателя
+	buyer := &domain.Buyer{
+		Name:   r.FormValue("buyerName"),
+		Phones: phones,
+	}
 
 	h.logger.Info(
-		"Puppy change archive condition",
+		"Puppy change status",
 		zap.String("puppyID", puppyID),
-		zap.String("Archived", archived),
+		zap.String("Status", status),
+		zap.Time("ReservedUntil", reservedUntil),
 		zap.String("City", city),
-		zap.String("Phone", phone),
+		zap.Strings("Phones", buyer.Phones),
 	)
 
-	err = h.Services.PuppyChangeArchived(puppyID, archived, city, phone)
+	err = h.Services.PuppyChangeStatus(puppyID, status, reservedUntil, city, buyer)
 	if err != nil {
-		h.logger.Error("Failed to change archive condition for puppy", zap.Error(err))
-		http.Error(w, "Failed to change archive condition for puppy", http.StatusInternalServerError)
+		h.logger.Error("Failed to change status for puppy", zap.Error(err))
+		http.Error(w, "Failed to change status for puppy", http.StatusInternalServerError)
 		return
 	}
 
-	if archived == "true" {
-		http.Redirect(w, r, "/admin/puppies", http.StatusSeeOther)
+	if status == domain.PuppyStatusReturned {
+		h.respondAdmin(w, r, "/admin/archive", http.StatusNoContent, nil)
 	} else {
-		http.Redirect(w, r, "/admin/archive", http.StatusSeeOther)
+		h.respondAdmin(w, r, "/admin/puppies", http.StatusNoContent, nil)
 	}
 }
 
@@ -262,7 +423,7 @@ func (h *Handler) DeletePuppy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/puppies", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/puppies", http.StatusNoContent, nil)
 
 }
 
@@ -281,9 +442,17 @@ func (h *Handler) UpdatePuppy(w http.ResponseWriter, r *http.Request) {
 
 	name := r.FormValue("name")
 
-	price := r.FormValue("price")
+	price, err := ValidatePrice(r.FormValue("price"), r.FormValue("currency"))
+	if err != nil {
+		h.logger.Error("Invalid price", zap.Error(err))
+		http.Error(w, "Invalid price", http.StatusBadRequest)
+		return
+	}
 
-	sex := r.FormValue("gender")
+	breed, sex, color, ok := h.parseBreedSexAndColor(w, r)
+	if !ok {
+		return
+	}
 
 	fatherID, err := strconv.Atoi(r.FormValue("father"))
 	if err != nil {
@@ -299,14 +468,39 @@ func (h *Handler) UpdatePuppy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	color := r.FormValue("color")
-
-	dateBirth := r.FormValue("date")
+	dateBirth, err := ValidateDateBirth(r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid birth date", zap.Error(err))
+		http.Error(w, "Invalid birth date", http.StatusBadRequest)
+		return
+	}
 
-	readyOut := r.FormValue("readyToMoveAdd") == "true"
+	readyOut, err := ValidateReadyOut(r.FormValue("readyOut"))
+	if err != nil {
+		h.logger.Error("Invalid ready out value", zap.Error(err))
+		http.Error(w, "Invalid ready out value", http.StatusBadRequest)
+		return
+	}
 
 	title := r.FormValue("title")
 
+	city, err := ValidateCity(r.FormValue("city"))
+	if err != nil {
+		h.logger.Error("Invalid city", zap.Error(err))
+		http.Error(w, "Invalid city", http.StatusBadRequest)
+		return
+	}
+
+	litterID := 0
+	if litter := r.FormValue("litter"); litter != "" {
+		litterID, err = strconv.Atoi(litter)
+		if err != nil {
+			h.logger.Error("Invalid litter ID", zap.Error(err))
+			http.Error(w, "Invalid litter ID", http.StatusBadRequest)
+			return
+		}
+	}
+
 	puppyID, err := strconv.Atoi(r.FormValue("id"))
 	if err != nil {
 		h.logger.Error("Invalid puppy ID", zap.Error(err))
@@ -323,31 +517,36 @@ func (h *Handler) UpdatePuppy(w http.ResponseWriter, r *http.Request) {
 		"Puppy update",
 		zap.Int("puppyID", puppyID),
 		zap.String("Name", name),
-		zap.String("Price", price),
+		zap.Int64("PriceAmount", price.Amount),
+		zap.String("PriceCurrency", price.Currency),
 		zap.String("Gender", sex),
 		zap.Int("FatherID", fatherID),
 		zap.Int("MotherID", motherID),
+		zap.String("Breed", breed),
 		zap.String("Color", color),
-		zap.String("DateBirth", dateBirth),
-		zap.Bool("ReadyOut", readyOut),
+		zap.Time("DateBirth", dateBirth),
+		zap.Boolp("ReadyOutOverride", readyOut),
 		zap.String("Title", title),
+		zap.String("City", city),
+		zap.Int("LitterID", litterID),
 	)
 
 	// Create Puppy struct
 	puppy := &domain.Puppy{
-		ID:        puppyID,
-		Name:      name,
-		Title:     title,
-		Sex:       sex,
-		Price:     price,
-		ReadyOut:  readyOut,
-		Archived:  false, // Assuming this is not being set from form
-		City:      "Уфа", // Assuming this is not being set from form
-		MotherID:  motherID,
-		FatherID:  fatherID,
-		DateBirth: dateBirth,
-		Color:     color,
-		Urls:      existingPhotos, // Placeholder URLs
+		ID:               puppyID,
+		Name:             name,
+		Title:            title,
+		Sex:              sex,
+		Price:            price,
+		ReadyOutOverride: readyOut,
+		City:             city,
+		MotherID:         motherID,
+		FatherID:         fatherID,
+		DateBirth:        dateBirth,
+		Breed:            breed,
+		Color:            color,
+		LitterID:         litterID,
+		Urls:             existingPhotos, // Placeholder URLs
 	}
 
 	//	Call the service layer to update the puppy
@@ -358,7 +557,7 @@ func (h *Handler) UpdatePuppy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/puppies", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/puppies", http.StatusOK, newAPIPuppy(*puppy))
 }
 
 func (h *Handler) AddPuppy(w http.ResponseWriter, r *http.Request) {
@@ -376,9 +575,17 @@ func (h *Handler) AddPuppy(w http.ResponseWriter, r *http.Request) {
 
 	name := r.FormValue("name")
 
-	price := r.FormValue("price")
+	price, err := ValidatePrice(r.FormValue("price"), r.FormValue("currency"))
+	if err != nil {
+		h.logger.Error("Invalid price", zap.Error(err))
+		http.Error(w, "Invalid price", http.StatusBadRequest)
+		return
+	}
 
-	sex := r.FormValue("gender")
+	breed, sex, color, ok := h.parseBreedSexAndColor(w, r)
+	if !ok {
+		return
+	}
 
 	fatherID, err := strconv.Atoi(r.FormValue("father"))
 	if err != nil {
@@ -394,53 +601,75 @@ func (h *Handler) AddPuppy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	color := r.FormValue("color")
-
-	dateBirth := r.FormValue("date")
+	dateBirth, err := ValidateDateBirth(r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid birth date", zap.Error(err))
+		http.Error(w, "Invalid birth date", http.StatusBadRequest)
+		return
+	}
 
-	readyOut := r.FormValue("readyToMoveAdd") == "true"
+	readyOut, err := ValidateReadyOut(r.FormValue("readyOut"))
+	if err != nil {
+		h.logger.Error("Invalid ready out value", zap.Error(err))
+		http.Error(w, "Invalid ready out value", http.StatusBadRequest)
+		return
+	}
 
 	title := r.FormValue("title")
 
-	puppyID, err := strconv.Atoi(r.FormValue("id"))
+	city, err := ValidateCity(r.FormValue("city"))
 	if err != nil {
-		h.logger.Error("Invalid puppy ID", zap.Error(err))
-		http.Error(w, "Invalid puppy ID", http.StatusBadRequest)
+		h.logger.Error("Invalid city", zap.Error(err))
+		http.Error(w, "Invalid city", http.StatusBadRequest)
 		return
 	}
 
+	litterID := 0
+	if litter := r.FormValue("litter"); litter != "" {
+		litterID, err = strconv.Atoi(litter)
+		if err != nil {
+			h.logger.Error("Invalid litter ID", zap.Error(err))
+			http.Error(w, "Invalid litter ID", http.StatusBadRequest)
+			return
+		}
+	}
+
 	// Upload files to S3
 	fileHeaders := r.MultipartForm.File["files"]
 
 	h.logger.Info(
 		"Puppy add",
-		zap.Int("puppyID", puppyID),
 		zap.String("Name", name),
-		zap.String("Price", price),
+		zap.Int64("PriceAmount", price.Amount),
+		zap.String("PriceCurrency", price.Currency),
 		zap.String("Gender", sex),
 		zap.Int("FatherID", fatherID),
 		zap.Int("MotherID", motherID),
+		zap.String("Breed", breed),
 		zap.String("Color", color),
-		zap.String("DateBirth", dateBirth),
-		zap.Bool("ReadyOut", readyOut),
+		zap.Time("DateBirth", dateBirth),
+		zap.Boolp("ReadyOutOverride", readyOut),
 		zap.String("Title", title),
+		zap.String("City", city),
+		zap.Int("LitterID", litterID),
 	)
 
 	// Create Puppy struct
+	// ID назначает база данных
 	puppy := &domain.Puppy{
-		ID:        puppyID,
-		Name:      name,
-		Title:     title,
-		Sex:       sex,
-		Price:     price,
-		ReadyOut:  readyOut,
-		Archived:  false, // Assuming this is not being set from form
-		City:      "Уфа", // Assuming this is not being set from form
-		MotherID:  motherID,
-		FatherID:  fatherID,
-		DateBirth: dateBirth,
-		Color:     color,
-		Urls:      []string{}, // Placeholder URLs
+		Name:             name,
+		Title:            title,
+		Sex:              sex,
+		Price:            price,
+		ReadyOutOverride: readyOut,
+		City:             city,
+		MotherID:         motherID,
+		FatherID:         fatherID,
+		DateBirth:        dateBirth,
+		Breed:            breed,
+		Color:            color,
+		LitterID:         litterID,
+		Urls:             []string{}, // Placeholder URLs
 	}
 
 	//	Call the service layer to update the puppy
@@ -451,7 +680,7 @@ func (h *Handler) AddPuppy(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/puppies", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/puppies", http.StatusCreated, newAPIPuppy(*puppy))
 }
 
 func (h *Handler) UpdateFeedback(w http.ResponseWriter, r *http.Request) {
@@ -524,7 +753,7 @@ func (h *Handler) UpdateFeedback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/reviews", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/reviews", http.StatusOK, newAPIReview(*feedback, ""))
 }
 
 func (h *Handler) DeleteFeedback(w http.ResponseWriter, r *http.Request) {
@@ -553,7 +782,7 @@ func (h *Handler) DeleteFeedback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.Redirect(w, r, "/admin/reviews", http.StatusSeeOther)
+	h.respondAdmin(w, r, "/admin/reviews", http.StatusNoContent, nil)
 }
 
 func (h *Handler) ChangeCheckedFeedback(w http.ResponseWriter, r *http.Request) {
@@ -587,9 +816,1455 @@ func (h *Handler) ChangeCheckedFeedback(w http.ResponseWriter, r *http.Request)
 	}
 
 	if checked == "true" {
-		http.Redirect(w, r, "/admin/reviews", http.StatusSeeOther)
+		h.respondAdmin(w, r, "/admin/reviews", http.StatusNoContent, nil)
 	} else {
-		http.Redirect(w, r, "/admin/reviews/archive", http.StatusSeeOther)
+		h.respondAdmin(w, r, "/admin/reviews/archive", http.StatusNoContent, nil)
+	}
+
+}
+
+func (h *Handler) AddLitter(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	litter, ok := h.parseLitterForm(w, r)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(
+		"Litter add",
+		zap.Int("MotherID", litter.MotherID),
+		zap.Int("FatherID", litter.FatherID),
+		zap.Time("DateBirth", litter.DateBirth),
+		zap.Time("ReadyOutDate", litter.ReadyOutDate),
+	)
+
+	err := h.Services.LitterAdd(litter)
+	if err != nil {
+		h.logger.Error("Failed to add litter", zap.Error(err))
+		http.Error(w, "Failed to add litter", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/litters", http.StatusCreated, newAPILitter(*litter))
+}
+
+func (h *Handler) UpdateLitter(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	litter, ok := h.parseLitterForm(w, r)
+	if !ok {
+		return
+	}
+
+	litterID, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid litter ID", zap.Error(err))
+		http.Error(w, "Invalid litter ID", http.StatusBadRequest)
+		return
+	}
+	litter.ID = litterID
+
+	h.logger.Info(
+		"Litter update",
+		zap.Int("litterID", litter.ID),
+		zap.Int("MotherID", litter.MotherID),
+		zap.Int("FatherID", litter.FatherID),
+		zap.Time("DateBirth", litter.DateBirth),
+		zap.Time("ReadyOutDate", litter.ReadyOutDate),
+	)
+
+	err = h.Services.LitterUpdate(litter)
+	if err != nil {
+		h.logger.Error("Failed to update litter", zap.Error(err))
+		http.Error(w, "Failed to update litter", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/litters", http.StatusOK, newAPILitter(*litter))
+}
+
+func (h *Handler) DeleteLitter(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	litterID := r.FormValue("id")
+
+	h.logger.Info(
+		"Litter delete",
+		zap.String("litterID", litterID),
+	)
+	err = h.Services.LitterDelete(litterID)
+	if err != nil {
+		h.logger.Error("Failed to delete litter", zap.Error(err))
+		http.Error(w, "Failed to delete litter", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/litters", http.StatusNoContent, nil)
+}
+
+// parseLitterForm разбирает общие поля формы помёта. При ошибке ответ уже записан.
+func (h *Handler) parseLitterForm(w http.ResponseWriter, r *http.Request) (*domain.Litter, bool) {
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, false
+	}
+
+	motherID, err := strconv.Atoi(r.FormValue("mother"))
+	if err != nil {
+		h.logger.Error("Invalid mother ID", zap.Error(err))
+		http.Error(w, "Invalid mother ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	fatherID, err := strconv.Atoi(r.FormValue("father"))
+	if err != nil {
+		h.logger.Error("Invalid father ID", zap.Error(err))
+		http.Error(w, "Invalid father ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	dateBirth, err := time.Parse("2006-01-02", r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid date of birth", zap.Error(err))
+		http.Error(w, "Invalid date of birth", http.StatusBadRequest)
+		return nil, false
+	}
+
+	readyOutDate, err := time.Parse("2006-01-02", r.FormValue("readyOutDate"))
+	if err != nil {
+		h.logger.Error("Invalid ready out date", zap.Error(err))
+		http.Error(w, "Invalid ready out date", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &domain.Litter{
+		MotherID:     motherID,
+		FatherID:     fatherID,
+		DateBirth:    dateBirth,
+		ReadyOutDate: readyOutDate,
+		Description:  r.FormValue("description"),
+	}, true
+}
+
+func (h *Handler) AddPlannedMating(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mating, ok := h.parsePlannedMatingForm(w, r)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(
+		"Planned mating add",
+		zap.Int("MotherID", mating.MotherID),
+		zap.Int("FatherID", mating.FatherID),
+		zap.Time("ExpectedDate", mating.ExpectedDate),
+		zap.String("Status", mating.Status),
+	)
+
+	err := h.Services.PlannedMatingAdd(mating)
+	if err != nil {
+		h.logger.Error("Failed to add planned mating", zap.Error(err))
+		http.Error(w, "Failed to add planned mating", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/matings", http.StatusCreated, newAPIPlannedMating(*mating))
+}
+
+func (h *Handler) UpdatePlannedMating(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mating, ok := h.parsePlannedMatingForm(w, r)
+	if !ok {
+		return
+	}
+
+	matingID, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid planned mating ID", zap.Error(err))
+		http.Error(w, "Invalid planned mating ID", http.StatusBadRequest)
+		return
+	}
+	mating.ID = matingID
+
+	h.logger.Info(
+		"Planned mating update",
+		zap.Int("matingID", mating.ID),
+		zap.Int("MotherID", mating.MotherID),
+		zap.Int("FatherID", mating.FatherID),
+		zap.Time("ExpectedDate", mating.ExpectedDate),
+		zap.String("Status", mating.Status),
+	)
+
+	err = h.Services.PlannedMatingUpdate(mating)
+	if err != nil {
+		h.logger.Error("Failed to update planned mating", zap.Error(err))
+		http.Error(w, "Failed to update planned mating", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/matings", http.StatusOK, newAPIPlannedMating(*mating))
+}
+
+func (h *Handler) DeletePlannedMating(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	matingID := r.FormValue("id")
+
+	h.logger.Info(
+		"Planned mating delete",
+		zap.String("matingID", matingID),
+	)
+	err = h.Services.PlannedMatingDelete(matingID)
+	if err != nil {
+		h.logger.Error("Failed to delete planned mating", zap.Error(err))
+		http.Error(w, "Failed to delete planned mating", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/matings", http.StatusNoContent, nil)
+}
+
+// WhelpPlannedMating создаёт помёт по запланированной вязке.
+func (h *Handler) WhelpPlannedMating(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	matingID := r.FormValue("id")
+	if !isValidID(matingID, 1000000) {
+		h.logger.Error("Invalid planned mating ID", zap.String("id", matingID))
+		http.Error(w, "Invalid planned mating ID", http.StatusBadRequest)
+		return
+	}
+
+	dateBirth, err := time.Parse("2006-01-02", r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid date of birth", zap.Error(err))
+		http.Error(w, "Invalid date of birth", http.StatusBadRequest)
+		return
+	}
+
+	readyOutDate, err := time.Parse("2006-01-02", r.FormValue("readyOutDate"))
+	if err != nil {
+		h.logger.Error("Invalid ready out date", zap.Error(err))
+		http.Error(w, "Invalid ready out date", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(
+		"Planned mating whelp",
+		zap.String("matingID", matingID),
+		zap.Time("DateBirth", dateBirth),
+		zap.Time("ReadyOutDate", readyOutDate),
+	)
+
+	litter, err := h.Services.PlannedMatingWhelp(matingID, dateBirth, readyOutDate)
+	if err != nil {
+		h.logger.Error("Failed to whelp planned mating", zap.Error(err))
+		http.Error(w, "Failed to whelp planned mating", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/litters", http.StatusCreated, newAPILitter(*litter))
+}
+
+// parsePlannedMatingForm разбирает общие поля формы запланированной вязки. При ошибке ответ уже записан.
+func (h *Handler) parsePlannedMatingForm(w http.ResponseWriter, r *http.Request) (*domain.PlannedMating, bool) {
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, false
+	}
+
+	motherID, err := strconv.Atoi(r.FormValue("mother"))
+	if err != nil {
+		h.logger.Error("Invalid mother ID", zap.Error(err))
+		http.Error(w, "Invalid mother ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	fatherID, err := strconv.Atoi(r.FormValue("father"))
+	if err != nil {
+		h.logger.Error("Invalid father ID", zap.Error(err))
+		http.Error(w, "Invalid father ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	expectedDate, err := time.Parse("2006-01-02", r.FormValue("expectedDate"))
+	if err != nil {
+		h.logger.Error("Invalid expected date", zap.Error(err))
+		http.Error(w, "Invalid expected date", http.StatusBadRequest)
+		return nil, false
+	}
+
+	status, err := ValidateMatingStatus(r.FormValue("status"))
+	if err != nil {
+		h.logger.Error("Invalid planned mating status", zap.Error(err))
+		http.Error(w, "Invalid planned mating status", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &domain.PlannedMating{
+		MotherID:     motherID,
+		FatherID:     fatherID,
+		ExpectedDate: expectedDate,
+		Status:       status,
+		Description:  r.FormValue("description"),
+	}, true
+}
+
+func (h *Handler) AddBuyer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	buyer, ok := h.parseBuyerForm(w, r)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(
+		"Buyer add",
+		zap.String("Name", buyer.Name),
+		zap.Strings("Phones", buyer.Phones),
+		zap.String("City", buyer.City),
+	)
+
+	err := h.Services.BuyerAdd(buyer)
+	if err != nil {
+		h.logger.Error("Failed to add buyer", zap.Error(err))
+		http.Error(w, "Failed to add buyer", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/buyers/"+strconv.Itoa(buyer.ID), http.StatusCreated, newAPIBuyer(*buyer))
+}
+
+func (h *Handler) UpdateBuyer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	buyer, ok := h.parseBuyerForm(w, r)
+	if !ok {
+		return
+	}
+
+	buyerID, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid buyer ID", zap.Error(err))
+		http.Error(w, "Invalid buyer ID", http.StatusBadRequest)
+		return
+	}
+	buyer.ID = buyerID
+
+	h.logger.Info(
+		"Buyer update",
+		zap.Int("buyerID", buyer.ID),
+		zap.String("Name", buyer.Name),
+		zap.Strings("Phones", buyer.Phones),
+		zap.String("City", buyer.City),
+	)
+
+	err = h.Services.BuyerUpdate(buyer)
+	if err != nil {
+		h.logger.Error("Failed to update buyer", zap.Error(err))
+		http.Error(w, "Failed to update buyer", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/buyers/"+strconv.Itoa(buyer.ID), http.StatusOK, newAPIBuyer(*buyer))
+}
+
+func (h *Handler) DeleteBuyer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	buyerID := r.FormValue("id")
+
+	h.logger.Info(
+		"Buyer delete",
+		zap.String("buyerID", buyerID),
+	)
+	err = h.Services.BuyerDelete(buyerID)
+	if err != nil {
+		h.logger.Error("Failed to delete buyer", zap.Error(err))
+		http.Error(w, "Failed to delete buyer", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/buyers", http.StatusNoContent, nil)
+}
+
+// parseBuyerForm разбирает общие поля формы покупателя. При ошибке ответ уже записан.
+func (h *Handler) parseBuyerForm(w http.ResponseWriter, r *http.Request) (*domain.Buyer, bool) {
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, false
+	}
+
+	phones, err := ValidatePhones(r.Form["phone"])
+	if err != nil {
+		h.logger.Error("Invalid buyer phone", zap.Error(err))
+		http.Error(w, "Invalid buyer phone", http.StatusBadRequest)
+		return nil, false
+	}
+
+	email, err := ValidateEmail(r.FormValue("email"))
+	if err != nil {
+		h.logger.Error("Invalid buyer email", zap.Error(err))
+		http.Error(w, "Invalid buyer email", http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &domain.Buyer{
+		Name:   r.FormValue("name"),
+		Phones: phones,
+		Email:  email,
+		City:   r.FormValue("city"),
+		Notes:  r.FormValue("notes"),
+	}, true
+}
+
+// ChangeActiveWaitlistEntry снимает заявку из листа ожидания или возвращает её в очередь.
+func (h *Handler) ChangeActiveWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	entryID := r.FormValue("id")
+
+	active, err := strconv.ParseBool(r.FormValue("active"))
+	if err != nil {
+		h.logger.Error("Invalid waitlist active flag", zap.Error(err))
+		http.Error(w, "Invalid waitlist active flag", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(
+		"Waitlist entry change active",
+		zap.String("entryID", entryID),
+		zap.Bool("Active", active),
+	)
+
+	err = h.Services.WaitlistEntryChangeActive(entryID, active)
+	if err != nil {
+		h.logger.Error("Failed to change waitlist entry", zap.Error(err))
+		http.Error(w, "Failed to change waitlist entry", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/waitlist", http.StatusNoContent, nil)
+}
+
+func (h *Handler) DeleteWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	entryID := r.FormValue("id")
+
+	h.logger.Info(
+		"Waitlist entry delete",
+		zap.String("entryID", entryID),
+	)
+	err = h.Services.WaitlistEntryDelete(entryID)
+	if err != nil {
+		h.logger.Error("Failed to delete waitlist entry", zap.Error(err))
+		http.Error(w, "Failed to delete waitlist entry", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/waitlist", http.StatusNoContent, nil)
+}
+
+// NotifiedWaitlistEntry отмечает, что щенка предложили человеку из очереди.
+func (h *Handler) NotifiedWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	notificationID := r.FormValue("id")
+
+	puppyID := r.FormValue("puppy")
+	if !isValidID(puppyID, 1000) {
+		h.logger.Error("Invalid puppy ID", zap.String("puppy", puppyID))
+		http.Error(w, "Invalid puppy ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(
+		"Waitlist notification sent",
+		zap.String("notificationID", notificationID),
+		zap.String("puppyID", puppyID),
+	)
+
+	err = h.Services.WaitlistNotificationSent(notificationID)
+	if err != nil {
+		h.logger.Error("Failed to mark waitlist notification", zap.Error(err))
+		http.Error(w, "Failed to mark waitlist notification", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/puppies/"+puppyID+"/waitlist", http.StatusNoContent, nil)
+}
+
+func (h *Handler) AddHealthEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	event, fileHeader, ok := h.parseHealthEventForm(w, r)
+	if !ok {
+		return
+	}
+
+	puppyID, err := strconv.Atoi(r.FormValue("puppy"))
+	if err == nil {
+		event.PuppyID = puppyID
+	}
+	dogID, err := strconv.Atoi(r.FormValue("dog"))
+	if err == nil {
+		event.DogID = dogID
+	}
+
+	h.logger.Info(
+		"Health event add",
+		zap.Int("PuppyID", event.PuppyID),
+		zap.Int("DogID", event.DogID),
+		zap.String("Type", event.Type),
+		zap.Time("Date", event.Date),
+	)
+
+	err = h.Services.HealthEventAdd(event, fileHeader)
+	if err != nil {
+		h.logger.Error("Failed to add health event", zap.Error(err))
+		http.Error(w, "Failed to add health event", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, healthOwnerURL(event), http.StatusCreated, newAPIHealthEvent(*event))
+}
+
+func (h *Handler) UpdateHealthEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	event, fileHeader, ok := h.parseHealthEventForm(w, r)
+	if !ok {
+		return
+	}
+
+	eventID, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid health event ID", zap.Error(err))
+		http.Error(w, "Invalid health event ID", http.StatusBadRequest)
+		return
+	}
+	event.ID = eventID
+
+	h.logger.Info(
+		"Health event update",
+		zap.Int("ID", event.ID),
+		zap.String("Type", event.Type),
+		zap.Time("Date", event.Date),
+	)
+
+	err = h.Services.HealthEventUpdate(event, fileHeader)
+	if err != nil {
+		h.logger.Error("Failed to update health event", zap.Error(err))
+		http.Error(w, "Failed to update health event", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, healthOwnerURL(event), http.StatusOK, newAPIHealthEvent(*event))
+}
+
+func (h *Handler) DeleteHealthEvent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	eventID := r.FormValue("id")
+
+	h.logger.Info(
+		"Health event delete",
+		zap.String("eventID", eventID),
+	)
+	event, err := h.Services.HealthEventDelete(eventID)
+	if err != nil {
+		h.logger.Error("Failed to delete health event", zap.Error(err))
+		http.Error(w, "Failed to delete health event", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, healthOwnerURL(event), http.StatusNoContent, nil)
+}
+
+// parseHealthEventForm разбирает общую часть форм добавления и редактирования события здоровья.
+// Документ необязателен, поэтому при его отсутствии возвращается nil.
+func (h *Handler) parseHealthEventForm(w http.ResponseWriter, r *http.Request) (
+	*domain.HealthEvent, *multipart.FileHeader, bool,
+) {
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	eventType, err := ValidateHealthEventType(r.FormValue("type"))
+	if err != nil {
+		h.logger.Error("Invalid health event type", zap.Error(err))
+		http.Error(w, "Invalid health event type", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	date, err := time.Parse("2006-01-02", r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid health event date", zap.Error(err))
+		http.Error(w, "Invalid health event date", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	var fileHeader *multipart.FileHeader
+	if fileHeaders := r.MultipartForm.File["document"]; len(fileHeaders) > 0 {
+		fileHeader = fileHeaders[0]
+	}
+
+	return &domain.HealthEvent{
+		Type:   eventType,
+		Date:   date,
+		Title:  r.FormValue("title"),
+		Result: r.FormValue("result"),
+	}, fileHeader, true
+}
+
+// healthOwnerURL возвращает адрес страницы здоровья щенка или собаки, к которым относится событие.
+func healthOwnerURL(event *domain.HealthEvent) string {
+	if event.PuppyID != 0 {
+		return "/admin/puppies/" + strconv.Itoa(event.PuppyID) + "/health"
+	}
+	return "/admin/dogs/" + strconv.Itoa(event.DogID) + "/health"
+}
+
+func (h *Handler) AddWeight(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	puppyID, err := strconv.Atoi(r.FormValue("puppy"))
+	if err != nil {
+		h.logger.Error("Invalid puppy ID", zap.Error(err))
+		http.Error(w, "Invalid puppy ID", http.StatusBadRequest)
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid weight date", zap.Error(err))
+		http.Error(w, "Invalid weight date", http.StatusBadRequest)
+		return
+	}
+
+	grams, err := ValidateWeight(r.FormValue("grams"))
+	if err != nil {
+		h.logger.Error("Invalid weight", zap.Error(err))
+		http.Error(w, "Invalid weight", http.StatusBadRequest)
+		return
+	}
+
+	measurement := &domain.WeightMeasurement{PuppyID: puppyID, Date: date, Grams: grams}
+
+	h.logger.Info(
+		"Weight add",
+		zap.Int("PuppyID", measurement.PuppyID),
+		zap.Time("Date", measurement.Date),
+		zap.Int("Grams", measurement.Grams),
+	)
+
+	err = h.Services.WeightAdd(measurement)
+	if err != nil {
+		h.logger.Error("Failed to add weight", zap.Error(err))
+		http.Error(w, "Failed to add weight", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(
+		w, r, "/admin/puppies/"+strconv.Itoa(puppyID)+"/weights", http.StatusCreated, newAPIWeight(*measurement),
+	)
+}
+
+func (h *Handler) DeleteWeight(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	measurementID := r.FormValue("id")
+
+	puppyID := r.FormValue("puppy")
+	if !isValidID(puppyID, 1000) {
+		h.logger.Error("Invalid puppy ID", zap.String("puppy", puppyID))
+		http.Error(w, "Invalid puppy ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(
+		"Weight delete",
+		zap.String("measurementID", measurementID),
+		zap.String("puppyID", puppyID),
+	)
+	err = h.Services.WeightDelete(measurementID)
+	if err != nil {
+		h.logger.Error("Failed to delete weight", zap.Error(err))
+		http.Error(w, "Failed to delete weight", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/puppies/"+puppyID+"/weights", http.StatusNoContent, nil)
+}
+
+func (h *Handler) AddShowResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	result, fileHeader, ok := h.parseShowResultForm(w, r)
+	if !ok {
+		return
+	}
+
+	dogID, err := strconv.Atoi(r.FormValue("dog"))
+	if err != nil {
+		h.logger.Error("Invalid dog ID", zap.Error(err))
+		http.Error(w, "Invalid dog ID", http.StatusBadRequest)
+		return
+	}
+	result.DogID = dogID
+
+	h.logger.Info(
+		"Show result add",
+		zap.Int("DogID", result.DogID),
+		zap.String("Event", result.Event),
+		zap.Time("Date", result.Date),
+		zap.Strings("Titles", result.Titles),
+	)
+
+	err = h.Services.ShowResultAdd(result, fileHeader)
+	if err != nil {
+		h.logger.Error("Failed to add show result", zap.Error(err))
+		http.Error(w, "Failed to add show result", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(
+		w, r, "/admin/dogs/"+strconv.Itoa(result.DogID)+"/shows", http.StatusCreated, newAPIShowResult(*result),
+	)
+}
+
+func (h *Handler) UpdateShowResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	result, fileHeader, ok := h.parseShowResultForm(w, r)
+	if !ok {
+		return
+	}
+
+	resultID, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid show result ID", zap.Error(err))
+		http.Error(w, "Invalid show result ID", http.StatusBadRequest)
+		return
+	}
+	result.ID = resultID
+
+	h.logger.Info(
+		"Show result update",
+		zap.Int("ID", result.ID),
+		zap.String("Event", result.Event),
+		zap.Time("Date", result.Date),
+		zap.Strings("Titles", result.Titles),
+	)
+
+	err = h.Services.ShowResultUpdate(result, fileHeader)
+	if err != nil {
+		h.logger.Error("Failed to update show result", zap.Error(err))
+		http.Error(w, "Failed to update show result", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/dogs/"+strconv.Itoa(result.DogID)+"/shows", http.StatusOK, newAPIShowResult(*result))
+}
+
+func (h *Handler) DeleteShowResult(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	resultID := r.FormValue("id")
+
+	h.logger.Info(
+		"Show result delete",
+		zap.String("resultID", resultID),
+	)
+	result, err := h.Services.ShowResultDelete(resultID)
+	if err != nil {
+		h.logger.Error("Failed to delete show result", zap.Error(err))
+		http.Error(w, "Failed to delete show result", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/dogs/"+strconv.Itoa(result.DogID)+"/shows", http.StatusNoContent, nil)
+}
+
+// parseShowResultForm разбирает общую часть форм добавления и редактирования результата выставки.
+// Скан сертификата необязателен, поэтому при его отсутствии возвращается nil.
+func (h *Handler) parseShowResultForm(w http.ResponseWriter, r *http.Request) (
+	*domain.ShowResult, *multipart.FileHeader, bool,
+) {
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	event := r.FormValue("event")
+	if event == "" {
+		h.logger.Error("Show event is required")
+		http.Error(w, "Show event is required", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	date, err := time.Parse("2006-01-02", r.FormValue("date"))
+	if err != nil {
+		h.logger.Error("Invalid show date", zap.Error(err))
+		http.Error(w, "Invalid show date", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	grade, err := ValidateShowGrade(r.FormValue("grade"))
+	if err != nil {
+		h.logger.Error("Invalid show grade", zap.Error(err))
+		http.Error(w, "Invalid show grade", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	titles, err := ValidateShowTitles(r.Form["titles"])
+	if err != nil {
+		h.logger.Error("Invalid show titles", zap.Error(err))
+		http.Error(w, "Invalid show titles", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	var fileHeader *multipart.FileHeader
+	if fileHeaders := r.MultipartForm.File["certificate"]; len(fileHeaders) > 0 {
+		fileHeader = fileHeaders[0]
+	}
+
+	return &domain.ShowResult{
+		Event:  event,
+		Date:   date,
+		Judge:  r.FormValue("judge"),
+		Grade:  grade,
+		Titles: titles,
+	}, fileHeader, true
+}
+
+func (h *Handler) AddDictionaryEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	entry, ok := h.parseDictionaryEntryForm(w, r)
+	if !ok {
+		return
+	}
+
+	entry.Value, err = ValidateDictionaryValue(r.FormValue("value"))
+	if err != nil {
+		h.logger.Error("Invalid dictionary value", zap.Error(err))
+		http.Error(w, "Invalid dictionary value", http.StatusBadRequest)
+		return
+	}
+
+	// Значение породы — адрес её страницы, а окрас заводится для существующей породы
+	switch entry.Kind {
+	case domain.DictionaryBreeds:
+		entry.Value, err = ValidateBreedSlug(entry.Value)
+		if err != nil {
+			h.logger.Error("Invalid breed slug", zap.Error(err))
+			http.Error(w, "Invalid breed slug", http.StatusBadRequest)
+			return
+		}
+	case domain.DictionaryColors:
+		dictionaries, err := h.Services.DictionariesGet()
+		if err != nil {
+			h.logger.Error("Failed to get dictionaries", zap.Error(err))
+			http.Error(w, "Failed to get dictionaries", http.StatusInternalServerError)
+			return
+		}
+		breed, ok := dictionaries.BreedGet(r.FormValue("breed"))
+		if !ok {
+			h.logger.Error("Invalid breed", zap.String("breed", r.FormValue("breed")))
+			http.Error(w, "Invalid breed", http.StatusBadRequest)
+			return
+		}
+		entry.Breed = breed.Value
+	}
+
+	h.logger.Info(
+		"Dictionary entry add",
+		zap.String("Kind", entry.Kind),
+		zap.String("Breed", entry.Breed),
+		zap.String("Value", entry.Value),
+		zap.String("LabelRu", entry.LabelRu),
+		zap.String("LabelEn", entry.LabelEn),
+		zap.Int("SortOrder", entry.SortOrder),
+	)
+
+	err = h.Services.DictionaryEntryAdd(entry)
+	if errors.Is(err, domain.ErrDictionaryFixed) {
+		h.logger.Error("Dictionary is fixed", zap.Error(err))
+		http.Error(w, "В справочник пола нельзя добавлять записи, меняются только подписи и порядок", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to add dictionary entry", zap.Error(err))
+		http.Error(w, "Failed to add dictionary entry", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/dictionaries", http.StatusCreated, newAPIDictionaryEntry(*entry))
+}
+
+func (h *Handler) UpdateDictionaryEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	entry, ok := h.parseDictionaryEntryForm(w, r)
+	if !ok {
+		return
+	}
+
+	entry.ID, err = strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid dictionary entry ID", zap.Error(err))
+		http.Error(w, "Invalid dictionary entry ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info(
+		"Dictionary entry update",
+		zap.Int("entryID", entry.ID),
+		zap.String("Kind", entry.Kind),
+		zap.String("LabelRu", entry.LabelRu),
+		zap.String("LabelEn", entry.LabelEn),
+		zap.Int("SortOrder", entry.SortOrder),
+	)
+
+	err = h.Services.DictionaryEntryUpdate(entry)
+	if err != nil {
+		h.logger.Error("Failed to update dictionary entry", zap.Error(err))
+		http.Error(w, "Failed to update dictionary entry", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/dictionaries", http.StatusOK, newAPIDictionaryEntry(*entry))
+}
+
+func (h *Handler) DeleteDictionaryEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	kind, err := ValidateDictionaryKind(r.FormValue("kind"))
+	if err != nil {
+		h.logger.Error("Invalid dictionary", zap.Error(err))
+		http.Error(w, "Invalid dictionary", http.StatusBadRequest)
+		return
+	}
+
+	entryID := r.FormValue("id")
+
+	h.logger.Info("Dictionary entry delete", zap.String("Kind", kind), zap.String("entryID", entryID))
+	err = h.Services.DictionaryEntryDelete(kind, entryID)
+	if errors.Is(err, domain.ErrDictionaryFixed) {
+		h.logger.Error("Dictionary is fixed", zap.Error(err))
+		http.Error(w, "Из справочника пола нельзя удалять записи, меняются только подписи и порядок", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to delete dictionary entry", zap.Error(err))
+		http.Error(w, "Failed to delete dictionary entry: it may still be used by puppies or dogs", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/dictionaries", http.StatusNoContent, nil)
+}
+
+// parseDictionaryEntryForm разбирает справочник, подписи и порядок записи справочника. При ошибке ответ уже записан.
+func (h *Handler) parseDictionaryEntryForm(w http.ResponseWriter, r *http.Request) (*domain.DictionaryEntry, bool) {
+	kind, err := ValidateDictionaryKind(r.FormValue("kind"))
+	if err != nil {
+		h.logger.Error("Invalid dictionary", zap.Error(err))
+		http.Error(w, "Invalid dictionary", http.StatusBadRequest)
+		return nil, false
+	}
+
+	sortOrder := 0
+	if order := r.FormValue("sortOrder"); order != "" {
+		sortOrder, err = strconv.Atoi(order)
+		if err != nil {
+			h.logger.Error("Invalid sort order", zap.Error(err))
+			http.Error(w, "Invalid sort order", http.StatusBadRequest)
+			return nil, false
+		}
+	}
+
+	return &domain.DictionaryEntry{
+		Kind:      kind,
+		LabelRu:   strings.TrimSpace(r.FormValue("labelRu")),
+		LabelEn:   strings.TrimSpace(r.FormValue("labelEn")),
+		SortOrder: sortOrder,
+	}, true
+}
+
+func (h *Handler) AddArticle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	article, fileHeader, ok := h.parseArticleForm(w, r, 0)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(
+		"Article add",
+		zap.String("Title", article.Title),
+		zap.String("Slug", article.Slug),
+		zap.Strings("Tags", article.Tags),
+		zap.Bool("Published", article.Published),
+	)
+
+	err := h.Services.ArticleAdd(article, fileHeader)
+	if err != nil {
+		h.logger.Error("Failed to add article", zap.Error(err))
+		http.Error(w, "Failed to add article", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/articles/"+strconv.Itoa(article.ID), http.StatusCreated, newAPIArticle(*article))
+}
+
+func (h *Handler) UpdateArticle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	articleID, err := strconv.Atoi(r.FormValue("id"))
+	if err != nil {
+		h.logger.Error("Invalid article ID", zap.Error(err))
+		http.Error(w, "Invalid article ID", http.StatusBadRequest)
+		return
+	}
+
+	article, fileHeader, ok := h.parseArticleForm(w, r, articleID)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(
+		"Article update",
+		zap.Int("articleID", article.ID),
+		zap.String("Title", article.Title),
+		zap.String("Slug", article.Slug),
+		zap.Strings("Tags", article.Tags),
+		zap.Bool("Published", article.Published),
+	)
+
+	err = h.Services.ArticleUpdate(article, fileHeader)
+	if err != nil {
+		h.logger.Error("Failed to update article", zap.Error(err))
+		http.Error(w, "Failed to update article", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/articles/"+strconv.Itoa(article.ID), http.StatusOK, newAPIArticle(*article))
+}
+
+// parseArticleForm разбирает общую часть форм добавления и редактирования статьи.
+// Пустой адрес собирается из заголовка и не должен совпадать с адресом другой статьи, для новой статьи
+// articleID равен 0. Обложка необязательна, поэтому при её отсутствии возвращается nil.
+func (h *Handler) parseArticleForm(w http.ResponseWriter, r *http.Request, articleID int) (
+	*domain.Article, *multipart.FileHeader, bool,
+) {
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	title, err := ValidateArticleTitle(r.FormValue("title"))
+	if err != nil {
+		h.logger.Error("Invalid article title", zap.Error(err))
+		http.Error(w, "Invalid article title", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	slug := strings.TrimSpace(r.FormValue("slug"))
+	if slug == "" {
+		slug, err = h.freeArticleSlug(articleSlug(title), articleID)
+		if err != nil {
+			h.logger.Error("Failed to check article slug", zap.Error(err))
+			http.Error(w, "Failed to check article slug", http.StatusInternalServerError)
+			return nil, nil, false
+		}
+	}
+	slug, err = ValidateArticleSlug(slug)
+	if err != nil {
+		h.logger.Error("Invalid article slug", zap.Error(err))
+		http.Error(w, "Invalid article slug", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	tags, err := ValidateArticleTags(r.FormValue("tags"))
+	if err != nil {
+		h.logger.Error("Invalid article tags", zap.Error(err))
+		http.Error(w, "Invalid article tags", http.StatusBadRequest)
+		return nil, nil, false
+	}
+
+	var fileHeader *multipart.FileHeader
+	if fileHeaders := r.MultipartForm.File["cover"]; len(fileHeaders) > 0 {
+		fileHeader = fileHeaders[0]
+	}
+
+	return &domain.Article{
+		ID:        articleID,
+		Title:     title,
+		Slug:      slug,
+		Body:      r.FormValue("body"),
+		Tags:      tags,
+		Published: r.FormValue("published") == "true",
+	}, fileHeader, true
+}
+
+func (h *Handler) UpdateSiteSettings(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	settings, ok := h.parseSiteSettingsForm(w, r)
+	if !ok {
+		return
+	}
+
+	h.logger.Info(
+		"Site settings update",
+		zap.String("Phone", settings.Phone),
+		zap.String("Email", settings.Email),
+		zap.String("Address", settings.Address),
+	)
+
+	err := h.Services.SiteSettingsUpdate(settings)
+	if err != nil {
+		h.logger.Error("Failed to update site settings", zap.Error(err))
+		http.Error(w, "Failed to update site settings", http.StatusInternalServerError)
+		return
+	}
+
+	h.respondAdmin(w, r, "/admin/settings", http.StatusOK, newAPISiteSettings(*settings))
+}
+
+// parseSiteSettingsForm разбирает форму настроек сайта. При ошибке ответ уже записан.
+func (h *Handler) parseSiteSettingsForm(w http.ResponseWriter, r *http.Request) (*domain.SiteSettings, bool) {
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return nil, false
+	}
+
+	settings := &domain.SiteSettings{}
+
+	settings.Phone, err = ValidateSitePhone(r.FormValue("phone"))
+	if err != nil {
+		h.logger.Error("Invalid site phone", zap.Error(err))
+		http.Error(w, "Invalid site phone", http.StatusBadRequest)
+		return nil, false
+	}
+
+	settings.Email, err = ValidateEmail(r.FormValue("email"))
+	if err != nil {
+		h.logger.Error("Invalid site email", zap.Error(err))
+		http.Error(w, "Invalid site email", http.StatusBadRequest)
+		return nil, false
+	}
+
+	links := []struct {
+		field string
+		value *string
+	}{
+		{"telegram", &settings.Telegram},
+		{"whatsapp", &settings.WhatsApp},
+		{"instagram", &settings.Instagram},
+		{"vk", &settings.VK},
+	}
+	for _, link := range links {
+		*link.value, err = ValidateSiteLink(r.FormValue(link.field))
+		if err != nil {
+			h.logger.Error("Invalid site link", zap.String("field", link.field), zap.Error(err))
+			http.Error(w, "Invalid site link: "+link.field, http.StatusBadRequest)
+			return nil, false
+		}
+	}
+
+	settings.MapLat, settings.MapLng, err = ValidateMapCoordinates(r.FormValue("mapLat"), r.FormValue("mapLng"))
+	if err != nil {
+		h.logger.Error("Invalid map coordinates", zap.Error(err))
+		http.Error(w, "Invalid map coordinates", http.StatusBadRequest)
+		return nil, false
+	}
+
+	texts := []struct {
+		field     string
+		maxLength int
+		value     *string
+	}{
+		{"address", 255, &settings.Address},
+		{"openingHours", 255, &settings.OpeningHours},
+		{"footerText", 500, &settings.FooterText},
+		{"seoTitle", 120, &settings.SEOTitle},
+		{"seoDescription", 300, &settings.SEODescription},
+		{"seoKeywords", 255, &settings.SEOKeywords},
+	}
+	for _, text := range texts {
+		*text.value, err = ValidateSiteText(r.FormValue(text.field), text.maxLength)
+		if err != nil {
+			h.logger.Error("Invalid site setting", zap.String("field", text.field), zap.Error(err))
+			http.Error(w, "Invalid site setting: "+text.field, http.StatusBadRequest)
+			return nil, false
+		}
+	}
+
+	return settings, true
+}
+
+// AddAPIToken выпускает токен API и показывает его на странице токенов.
+func (h *Handler) AddAPIToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	name, err := ValidateAPITokenName(r.FormValue("name"))
+	if err != nil {
+		h.logger.Error("Invalid API token name", zap.Error(err))
+		http.Error(w, "Invalid API token name", http.StatusBadRequest)
+		return
+	}
+
+	secret, token, err := h.Services.AuthorizationServices.APITokenCreate(name)
+	if err != nil {
+		h.logger.Error("Failed to create API token", zap.Error(err))
+		http.Error(w, "Failed to create API token", http.StatusInternalServerError)
+		return
+	}
+
+	// Сам токен в лог не пишется
+	h.logger.Info("API token created", zap.Int("tokenID", token.ID), zap.String("Name", token.Name))
+
+	h.renderAdminAPITokens(w, token.Name, secret)
+}
+
+// RevokeAPIToken отзывает токен API.
+func (h *Handler) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	tokenID := r.FormValue("id")
+	if !isValidID(tokenID, 1000000) {
+		h.logger.Error("Invalid API token ID", zap.String("id", tokenID))
+		http.Error(w, "Invalid API token ID", http.StatusBadRequest)
+		return
+	}
+
+	h.logger.Info("API token revoke", zap.String("tokenID", tokenID))
+	err = h.Services.AuthorizationServices.APITokenRevoke(tokenID)
+	if err != nil {
+		h.logger.Error("Failed to revoke API token", zap.Error(err))
+		http.Error(w, "Failed to revoke API token", http.StatusInternalServerError)
+		return
 	}
 
+	http.Redirect(w, r, "/admin/tokens", http.StatusSeeOther)
 }
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// apiContextKey — тип ключей контекста запросов API.
+type apiContextKey string
+
+// apiRequestKey помечает запросы, которые пришли в действие админки через API, а не из формы.
+const apiRequestKey apiContextKey = "apiRequest"
+
+// apiFormMaxMemory — сколько тела multipart-запроса держится в памяти, как у форм админки.
+const apiFormMaxMemory = 10 << 20
+
+// apiJSONMaxBytes — наибольший размер тела запроса в JSON. Файлы в JSON не передаются.
+const apiJSONMaxBytes = 1 << 20
+
+// APIAdminAction открывает действие админки как метод API. Тело запроса в JSON, multipart/form-data
+// или application/x-www-form-urlencoded разбирается в поля формы, поэтому данные проверяются и сохраняются
+// так же, как при отправке формы из админки. Файлы можно передать только в multipart/form-data.
+// Ошибки действия отдаются в едином для API виде.
+func (h *Handler) APIAdminAction(action http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mediaType := ""
+		if contentType := r.Header.Get("Content-Type"); contentType != "" {
+			var err error
+			mediaType, _, err = mime.ParseMediaType(contentType)
+			if err != nil {
+				h.writeAPIError(w, http.StatusUnsupportedMediaType, "invalid content type: "+contentType)
+				return
+			}
+		}
+
+		switch mediaType {
+		case "application/json":
+			values, err := apiJSONForm(http.MaxBytesReader(w, r.Body, apiJSONMaxBytes))
+			if err != nil {
+				h.writeAPIError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			// Параметры строки запроса остаются в форме, как при разборе обычной формы
+			r.Form = r.URL.Query()
+			for name, value := range values {
+				r.Form[name] = append(append([]string{}, value...), r.Form[name]...)
+			}
+			r.PostForm = values
+			r.MultipartForm = &multipart.Form{Value: values, File: map[string][]*multipart.FileHeader{}}
+		case "multipart/form-data":
+			if err := r.ParseMultipartForm(apiFormMaxMemory); err != nil {
+				h.writeAPIError(w, http.StatusBadRequest, "invalid multipart body: "+err.Error())
+				return
+			}
+		case "application/x-www-form-urlencoded", "":
+			if err := r.ParseForm(); err != nil {
+				h.writeAPIError(w, http.StatusBadRequest, "invalid form body: "+err.Error())
+				return
+			}
+			r.MultipartForm = &multipart.Form{Value: r.PostForm, File: map[string][]*multipart.FileHeader{}}
+		default:
+			h.writeAPIError(
+				w, http.StatusUnsupportedMediaType,
+				"unsupported content type "+mediaType+": use application/json or multipart/form-data",
+			)
+			return
+		}
+
+		writer := &apiErrorWriter{ResponseWriter: w}
+		action(writer, r.WithContext(context.WithValue(r.Context(), apiRequestKey, true)))
+		if writer.status != 0 {
+			h.writeAPIError(w, writer.status, strings.TrimSpace(writer.message.String()))
+		}
+	}
+}
+
+// apiJSONForm переводит объект JSON в поля формы. Массив становится несколькими значениями поля,
+// как повторяющееся поле формы, null — отсутствующим полем. Вложенные объекты не принимаются.
+func apiJSONForm(body io.Reader) (url.Values, error) {
+	decoder := json.NewDecoder(body)
+	decoder.UseNumber()
+
+	var fields map[string]interface{}
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, fmt.Errorf("invalid JSON body: %w", err)
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("invalid JSON body: unexpected data after object")
+	}
+
+	values := url.Values{}
+	for name, field := range fields {
+		items, ok := field.([]interface{})
+		if !ok {
+			items = []interface{}{field}
+		}
+		for _, item := range items {
+			switch item := item.(type) {
+			case nil:
+			case string:
+				values.Add(name, item)
+			case json.Number:
+				values.Add(name, item.String())
+			case bool:
+				values.Add(name, fmt.Sprint(item))
+			default:
+				return nil, fmt.Errorf("invalid JSON field %s: expected string, number, boolean or array of them", name)
+			}
+		}
+	}
+	return values, nil
+}
+
+// apiErrorWriter перехватывает ошибку, которую действие админки пишет через http.Error,
+// чтобы APIAdminAction отдал её в виде ошибки API.
+type apiErrorWriter struct {
+	http.ResponseWriter
+	status  int
+	message bytes.Buffer
+}
+
+func (w *apiErrorWriter) WriteHeader(status int) {
+	if status >= http.StatusBadRequest {
+		w.status = status
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *apiErrorWriter) Write(b []byte) (int, error) {
+	if w.status != 0 {
+		return w.message.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// respondAdmin завершает действие админки. Форму перенаправляет на страницу redirect, а запросу API
+// отдаёт запись data со статусом status; без записи ответ API пустой.
+func (h *Handler) respondAdmin(w http.ResponseWriter, r *http.Request, redirect string, status int, data interface{}) {
+	if r.Context().Value(apiRequestKey) == nil {
+		http.Redirect(w, r, redirect, http.StatusSeeOther)
+		return
+	}
+	if data == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	h.writeAPIJSON(w, status, apiItem{Data: data})
+}
+
+// APIAuthError отвечает на запрос к API админки, который не прошёл проверку токена.
+func (h *Handler) APIAuthError(w http.ResponseWriter, r *http.Request, status int) {
+	if status == http.StatusUnauthorized {
+		h.writeAPIError(w, status, "missing or invalid API token")
+		return
+	}
+	h.writeAPIError(w, status, "failed to check API token")
+}
@@ -0,0 +1,249 @@
+package handlers
+
+import (
+	"encoding/json"
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/go-chi/chi"
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+	"net/http"
+)
+
+// apiErrorCodes — коды ошибок API по HTTP-статусу.
+var apiErrorCodes = map[int]string{
+	http.StatusBadRequest:           "bad_request",
+	http.StatusUnauthorized:         "unauthorized",
+	http.StatusNotFound:             "not_found",
+	http.StatusMethodNotAllowed:     "method_not_allowed",
+	http.StatusUnsupportedMediaType: "unsupported_media_type",
+	http.StatusInternalServerError:  "internal_error",
+}
+
+// writeAPIJSON записывает ответ API в JSON.
+func (h *Handler) writeAPIJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		h.logger.Error("Failed to write API response", zap.Error(err))
+	}
+}
+
+// writeAPIError записывает ошибку API в едином для всех методов виде.
+func (h *Handler) writeAPIError(w http.ResponseWriter, status int, message string) {
+	h.writeAPIJSON(
+		w, status, apiErrorBody{
+			Error: apiErrorDetail{Status: status, Code: apiErrorCodes[status], Message: message},
+		},
+	)
+}
+
+// APINotFound отвечает на запрос к несуществующему методу API.
+func (h *Handler) APINotFound(w http.ResponseWriter, r *http.Request) {
+	h.writeAPIError(w, http.StatusNotFound, "unknown API method: "+r.URL.Path)
+}
+
+// APIMethodNotAllowed отвечает на запрос к методу API с неподдерживаемым HTTP-методом.
+func (h *Handler) APIMethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	h.writeAPIError(w, http.StatusMethodNotAllowed, "method "+r.Method+" is not allowed for "+r.URL.Path)
+}
+
+// APIPuppiesHandler отдаёт страницу каталога щенков. Фильтры и пагинация те же, что у страницы каталога,
+// а также breed — порода из справочника и archived=true — проданные щенки из архива.
+func (h *Handler) APIPuppiesHandler(w http.ResponseWriter, r *http.Request) {
+	page, err := ValidatePage(r.URL.Query().Get("page"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	archived, err := ValidateArchived(r.URL.Query().Get("archived"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Failed to get dictionaries", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to get dictionaries")
+		return
+	}
+
+	breed := r.URL.Query().Get("breed")
+	if breed != "" {
+		if _, ok := dictionaries.BreedGet(breed); !ok {
+			h.writeAPIError(w, http.StatusBadRequest, "unknown breed: "+breed)
+			return
+		}
+	}
+
+	allowedStatuses := publicPuppyStatuses
+	if archived {
+		allowedStatuses = archivePuppyStatuses
+	}
+	query, _, err := parsePuppyQuery(r.URL.Query(), dictionaries.ColorsOf(breed), dictionaries.Sexes, allowedStatuses)
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var idPuppy string
+	puppies, _, totalPages, err := h.Services.PuppiesGet(
+		query.Chocolates, query.Genders, query.Statuses, idPuppy, query.ReadyToMove, breed, query.Filter,
+		domain.Page{Number: page, After: query.After},
+	)
+	if err != nil {
+		h.logger.Error("Failed to get puppies", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to get puppies")
+		return
+	}
+
+	pagination := apiPagination{Page: page, TotalPages: totalPages}
+	if len(puppies) > 0 && query.Filter.Keyset() {
+		pagination.NextAfter = puppies[len(puppies)-1].ID
+	}
+
+	h.writeAPIJSON(w, http.StatusOK, apiList{Data: newAPIPuppies(puppies), Pagination: pagination})
+}
+
+// APIPuppyHandler отдаёт щенка вместе с родителями. Щенок, которого вернули в питомник, на сайте
+// не показывается, поэтому и в API его нет.
+func (h *Handler) APIPuppyHandler(w http.ResponseWriter, r *http.Request) {
+	idPuppy := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idPuppy, 1000) {
+		h.writeAPIError(w, http.StatusNotFound, "invalid puppy id: "+idPuppy)
+		return
+	}
+
+	puppy, mother, father, err := h.Services.PuppyGet(idPuppy)
+	if err != nil && err != pgx.ErrNoRows {
+		h.logger.Error("Failed to get puppy", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to get puppy")
+		return
+	}
+	if puppy == nil || puppy.Status == domain.PuppyStatusReturned {
+		h.writeAPIError(w, http.StatusNotFound, "puppy not found: "+idPuppy)
+		return
+	}
+
+	details := apiPuppyDetails{apiPuppy: newAPIPuppy(*puppy)}
+	if mother != nil {
+		dog := newAPIDog(*mother)
+		details.Mother = &dog
+	}
+	if father != nil {
+		dog := newAPIDog(*father)
+		details.Father = &dog
+	}
+
+	h.writeAPIJSON(w, http.StatusOK, apiItem{Data: details})
+}
+
+// APIDogsHandler отдаёт страницу списка собак с фильтрами по окрасу (chocolate) и полу (gender).
+// archived=true выводит собак на пенсии. Собаки идут по убыванию ID, следующая страница выбирается по after.
+func (h *Handler) APIDogsHandler(w http.ResponseWriter, r *http.Request) {
+	page, err := ValidatePage(r.URL.Query().Get("page"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	after, err := ValidatePageAfter(r.URL.Query().Get("after"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	archived, err := ValidateArchived(r.URL.Query().Get("archived"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Failed to get dictionaries", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to get dictionaries")
+		return
+	}
+
+	chocolates, err := ValidateChocolates(
+		r.URL.Query()["chocolate"], domain.DictionaryValues(dictionaries.ColorsOf("")),
+	)
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	genders, err := ValidateGender(r.URL.Query()["gender"], domain.DictionaryValues(dictionaries.Sexes))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dogs, totalPages, err := h.Services.DogsPageGet(chocolates, genders, archived, domain.Page{Number: page, After: after})
+	if err != nil {
+		h.logger.Error("Failed to get dogs", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to get dogs")
+		return
+	}
+
+	pagination := apiPagination{Page: page, TotalPages: totalPages}
+	if len(dogs) > 0 {
+		pagination.NextAfter = dogs[len(dogs)-1].ID
+	}
+
+	h.writeAPIJSON(w, http.StatusOK, apiList{Data: newAPIDogs(dogs), Pagination: pagination})
+}
+
+// APIReviewsHandler отдаёт страницу проверенных отзывов. Отзывы идут от новых к старым,
+// следующая страница выбирается по after.
+func (h *Handler) APIReviewsHandler(w http.ResponseWriter, r *http.Request) {
+	page, err := ValidatePage(r.URL.Query().Get("page"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	after, err := ValidatePageAfter(r.URL.Query().Get("after"))
+	if err != nil {
+		h.writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	checked := true
+	var idReview string
+	reviews, puppyNames, totalPages, err := h.Services.ReviewsGet(
+		idReview, checked, domain.Page{Number: page, After: after},
+	)
+	if err != nil {
+		h.logger.Error("Failed to get reviews", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to get reviews")
+		return
+	}
+
+	pagination := apiPagination{Page: page, TotalPages: totalPages}
+	if len(reviews) > 0 {
+		pagination.NextAfter = reviews[len(reviews)-1].ID
+	}
+
+	h.writeAPIJSON(w, http.StatusOK, apiList{Data: newAPIReviews(reviews, puppyNames), Pagination: pagination})
+}
+
+// OpenAPIHandler отдаёт спецификацию OpenAPI 3 публичного API.
+func (h *Handler) OpenAPIHandler(w http.ResponseWriter, r *http.Request) {
+	spec, err := OpenAPISpec()
+	if err != nil {
+		h.logger.Error("Failed to build OpenAPI spec", zap.Error(err))
+		h.writeAPIError(w, http.StatusInternalServerError, "failed to build OpenAPI spec")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(spec); err != nil {
+		h.logger.Error("Failed to write API response", zap.Error(err))
+	}
+}
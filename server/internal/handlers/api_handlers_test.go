@@ -0,0 +1,930 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/egosha7/site-go/internal/authMiddleware"
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/handlers"
+	"github.com/egosha7/site-go/internal/logger"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/egosha7/site-go/internal/service/mock_service"
+	"github.com/go-chi/chi"
+	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newAPITestRouter создаёт роутер с методами API v1 так же, как в routes.SetupRoutes.
+func newAPITestRouter(t *testing.T, mockServices *mock_service.MockServices) *chi.Mux {
+	services := &service.Service{Services: mockServices}
+	logger2, err := logger.SetupLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+	}
+
+	handler := handlers.NewHandler(services, logger2)
+
+	router := chi.NewRouter()
+	router.Route(
+		"/api/v1", func(r chi.Router) {
+			r.Get("/puppies", handler.APIPuppiesHandler)
+			r.Get("/puppies/{id}", handler.APIPuppyHandler)
+			r.Get("/dogs", handler.APIDogsHandler)
+			r.Get("/reviews", handler.APIReviewsHandler)
+			r.NotFound(handler.APINotFound)
+			r.MethodNotAllowed(handler.APIMethodNotAllowed)
+		},
+	)
+	return router
+}
+
+func TestHandler_APIPuppiesHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices)
+
+	puppies := []domain.Puppy{
+		{
+			ID:        7,
+			Name:      "PuppyTestGo",
+			Title:     "Example Puppy",
+			Sex:       "Кобель",
+			Breed:     "yorkshire-terrier",
+			Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
+			Status:    domain.PuppyStatusAvailable,
+			City:      "Уфа",
+			MotherID:  1,
+			FatherID:  2,
+			DateBirth: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Color:     "Черный",
+			Urls:      []string{"http://Puppy.com", ""},
+		}, {
+			ID:     5,
+			Name:   "PuppyWithoutPrice",
+			Sex:    "Сука",
+			Status: domain.PuppyStatusReserved,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		url            string
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   []string
+		unexpectedBody []string
+	}{
+		{
+			name: "Correct 200",
+			url:  "/api/v1/puppies",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().PuppiesGet(
+					[]string{}, []string{}, []string{domain.PuppyStatusAvailable, domain.PuppyStatusReserved}, "", "", "",
+					domain.PuppyFilter{Sort: domain.PuppySortNewest}, domain.Page{Number: 1},
+				).Return(puppies, map[int]int{}, 3, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				`"id":7`, `"url":"/puppies/7"`, `"name":"PuppyTestGo"`, `"sex":"Кобель"`,
+				`"price":{"amount":3000000,"currency":"RUB"}`, `"dateBirth":"2024-03-01"`,
+				`"photos":["http://Puppy.com"]`, `"id":5`, `"photos":[]`,
+				`"pagination":{"page":1,"totalPages":3,"nextAfter":5}`,
+			},
+			unexpectedBody: []string{`"price":null`},
+		}, {
+			name: "Correct 200 (Archive of breed with keyset page)",
+			url:  "/api/v1/puppies?archived=true&breed=yorkshire-terrier&page=2&after=9",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().PuppiesGet(
+					[]string{}, []string{}, []string{domain.PuppyStatusSold}, "", "", "yorkshire-terrier",
+					domain.PuppyFilter{Sort: domain.PuppySortNewest}, domain.Page{Number: 2, After: 9},
+				).Return([]domain.Puppy{}, map[int]int{}, 2, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{`"data":[]`, `"pagination":{"page":2,"totalPages":2}`},
+		}, {
+			name: "Correct 200 (No nextAfter for price sort)",
+			url:  "/api/v1/puppies?sort=price_asc",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					domain.PuppyFilter{Sort: domain.PuppySortPriceAsc}, domain.Page{Number: 1},
+				).Return(puppies, map[int]int{}, 3, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{`"pagination":{"page":1,"totalPages":3}`},
+			unexpectedBody: []string{"nextAfter"},
+		}, {
+			name: "Correct 200 (After ignored for price sort)",
+			url:  "/api/v1/puppies?sort=price_asc&page=3&after=9",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					domain.PuppyFilter{Sort: domain.PuppySortPriceAsc}, domain.Page{Number: 3},
+				).Return(puppies, map[int]int{}, 3, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{`"pagination":{"page":3,"totalPages":3}`},
+			unexpectedBody: []string{"nextAfter"},
+		}, {
+			name:         "Bad Request 400 (Invalid page)",
+			url:          "/api/v1/puppies?page=abc",
+			mockBehavior: func(s *mock_service.MockServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`{"error":{"status":400,"code":"bad_request","message":"invalid page number"}}`},
+		}, {
+			name:         "Bad Request 400 (Invalid archived)",
+			url:          "/api/v1/puppies?archived=yes",
+			mockBehavior: func(s *mock_service.MockServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`"code":"bad_request"`, "invalid archived value: yes"},
+		}, {
+			name: "Bad Request 400 (Unknown breed)",
+			url:  "/api/v1/puppies?breed=poodle",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"unknown breed: poodle"},
+		}, {
+			name: "Bad Request 400 (Invalid chocolate)",
+			url:  "/api/v1/puppies?chocolate=Белый",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid chocolate color: Белый"},
+		}, {
+			name: "Bad Request 400 (Sold status outside archive)",
+			url:  "/api/v1/puppies?status=sold",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`"code":"bad_request"`},
+		}, {
+			name: "Bad Request 400 (Invalid sort)",
+			url:  "/api/v1/puppies?sort=random",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid sort: random"},
+		}, {
+			name: "Internal Server Error 500 (Service DictionariesGet failure)",
+			url:  "/api/v1/puppies",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody:   []string{`{"error":{"status":500,"code":"internal_error","message":"failed to get dictionaries"}}`},
+			unexpectedBody: []string{"service error"},
+		}, {
+			name: "Internal Server Error 500 (Service PuppiesGet failure)",
+			url:  "/api/v1/puppies",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(),
+				).Return(nil, nil, 0, errors.New("service error"))
+			},
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody:   []string{"failed to get puppies"},
+			unexpectedBody: []string{"service error"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices)
+				mockServices.EXPECT().DictionariesGet().Return(testDictionaries, nil).AnyTimes()
+
+				router := newAPITestRouter(t, mockServices)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+				assert.Equal(t, "application/json; charset=utf-8", rr.Header().Get("Content-Type"))
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_APIPuppyHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idPuppy string)
+
+	tests := []struct {
+		name           string
+		idPuppy        string
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   []string
+		unexpectedBody []string
+	}{
+		{
+			name:    "Correct 200",
+			idPuppy: "7",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string) {
+				s.EXPECT().PuppyGet(idPuppy).Return(
+					&domain.Puppy{ID: 7, Name: "PuppyTestGo", Status: domain.PuppyStatusAvailable, MotherID: 1, FatherID: 2},
+					&domain.Dog{ID: 1, Name: "MotherTestGo", Gender: "Сука"},
+					&domain.Dog{ID: 2, Name: "FatherTestGo", Gender: "Кобель", External: true, Kennel: "Other Kennel"},
+					nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				`{"data":{"id":7,"url":"/puppies/7","name":"PuppyTestGo"`,
+				`"mother":{"id":1,"url":"/dogs/1","name":"MotherTestGo"`,
+				`"father":{"id":2,"url":"/dogs/2","name":"FatherTestGo"`, `"kennel":"Other Kennel"`,
+			},
+		}, {
+			name:    "Correct 200 (Without parents)",
+			idPuppy: "7",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string) {
+				s.EXPECT().PuppyGet(idPuppy).Return(
+					&domain.Puppy{ID: 7, Name: "PuppyTestGo", Status: domain.PuppyStatusSold}, nil, nil, nil,
+				)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{`"status":"sold"`},
+			unexpectedBody: []string{"mother", "father"},
+		}, {
+			name:         "Not Found 404 (Invalid id)",
+			idPuppy:      "abc",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string) {},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{`{"error":{"status":404,"code":"not_found","message":"invalid puppy id: abc"}}`},
+		}, {
+			name:    "Not Found 404 (No rows)",
+			idPuppy: "7",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string) {
+				s.EXPECT().PuppyGet(idPuppy).Return(nil, nil, nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{"puppy not found: 7"},
+		}, {
+			name:    "Not Found 404 (Returned puppy)",
+			idPuppy: "7",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string) {
+				s.EXPECT().PuppyGet(idPuppy).Return(
+					&domain.Puppy{ID: 7, Name: "PuppyTestGo", Status: domain.PuppyStatusReturned}, nil, nil, nil,
+				)
+			},
+			expectedCode:   http.StatusNotFound,
+			expectedBody:   []string{"puppy not found: 7"},
+			unexpectedBody: []string{"PuppyTestGo"},
+		}, {
+			name:    "Internal Server Error 500 (Service PuppyGet failure)",
+			idPuppy: "7",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string) {
+				s.EXPECT().PuppyGet(idPuppy).Return(nil, nil, nil, errors.New("service error"))
+			},
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody:   []string{`"code":"internal_error"`, "failed to get puppy"},
+			unexpectedBody: []string{"service error"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices, test.idPuppy)
+
+				router := newAPITestRouter(t, mockServices)
+
+				req, err := http.NewRequest("GET", "/api/v1/puppies/"+test.idPuppy, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_APIDogsHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices)
+
+	tests := []struct {
+		name           string
+		url            string
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   []string
+		unexpectedBody []string
+	}{
+		{
+			name: "Correct 200",
+			url:  "/api/v1/dogs",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DogsPageGet([]string{}, []string{}, false, domain.Page{Number: 1}).Return(
+					[]domain.Dog{
+						{ID: 8, Name: "ExternalDogTestGo", Gender: "Кобель", External: true, Kennel: "Other Kennel"},
+						{ID: 5, Name: "DogTestGo", Gender: "Сука", Color: "Черный", Urls: []string{"http://Dog.com"}},
+					}, 2, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				`"id":8`, `"external":true`, `"kennel":"Other Kennel"`, `"id":5`, `"retired":false`,
+				`"photos":["http://Dog.com"]`, `"pagination":{"page":1,"totalPages":2,"nextAfter":5}`,
+			},
+		}, {
+			name: "Correct 200 (Retired females)",
+			url:  "/api/v1/dogs?archived=true&gender=Сука&after=5&page=2",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DogsPageGet([]string{}, []string{"Сука"}, true, domain.Page{Number: 2, After: 5}).Return(
+					[]domain.Dog{{ID: 3, Name: "RetiredDogTestGo", Gender: "Сука", Archived: true}}, 2, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{`"retired":true`, `"pagination":{"page":2,"totalPages":2,"nextAfter":3}`},
+		}, {
+			name:         "Bad Request 400 (Invalid after)",
+			url:          "/api/v1/dogs?after=-1",
+			mockBehavior: func(s *mock_service.MockServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid after value: -1"},
+		}, {
+			name: "Bad Request 400 (Invalid gender)",
+			url:  "/api/v1/dogs?gender=Кот",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid gender: Кот"},
+		}, {
+			name: "Internal Server Error 500 (Service DogsPageGet failure)",
+			url:  "/api/v1/dogs",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+				s.EXPECT().DogsPageGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+					nil, 0, errors.New("service error"),
+				)
+			},
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody:   []string{"failed to get dogs"},
+			unexpectedBody: []string{"service error"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices)
+				mockServices.EXPECT().DictionariesGet().Return(testDictionaries, nil).AnyTimes()
+
+				router := newAPITestRouter(t, mockServices)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_APIReviewsHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices)
+
+	tests := []struct {
+		name           string
+		url            string
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   []string
+		unexpectedBody []string
+	}{
+		{
+			name: "Correct 200",
+			url:  "/api/v1/reviews?page=2&after=12",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ReviewsGet("", true, domain.Page{Number: 2, After: 12}).Return(
+					[]domain.Feedback{
+						{
+							ID: 11, PuppyID: 7, Name: "Анна", Number: "+79001112233", Title: "Отличный щенок",
+							Verified: true, Date: "2024-05-01", Urls: []string{"http://Review.com"},
+						},
+						{ID: 10, Name: "Олег", Title: "Спасибо", Verified: true, Date: "2024-04-01"},
+					}, map[int]string{11: "PuppyTestGo"}, 3, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				`{"id":11,"puppyId":7,"puppyName":"PuppyTestGo","author":"Анна","text":"Отличный щенок","date":"2024-05-01","photos":["http://Review.com"]}`,
+				`{"id":10,"author":"Олег","text":"Спасибо","date":"2024-04-01","photos":[]}`,
+				`"pagination":{"page":2,"totalPages":3,"nextAfter":10}`,
+			},
+			unexpectedBody: []string{"+79001112233"},
+		}, {
+			name:         "Bad Request 400 (Invalid page)",
+			url:          "/api/v1/reviews?page=0",
+			mockBehavior: func(s *mock_service.MockServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid page number"},
+		}, {
+			name:         "Bad Request 400 (Invalid after)",
+			url:          "/api/v1/reviews?after=abc",
+			mockBehavior: func(s *mock_service.MockServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid after value: abc"},
+		}, {
+			name: "Internal Server Error 500 (Service ReviewsGet failure)",
+			url:  "/api/v1/reviews",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ReviewsGet("", true, domain.Page{Number: 1}).Return(nil, nil, 0, errors.New("service error"))
+			},
+			expectedCode:   http.StatusInternalServerError,
+			expectedBody:   []string{"failed to get reviews"},
+			unexpectedBody: []string{"service error"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices)
+
+				router := newAPITestRouter(t, mockServices)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_APINotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	router := newAPITestRouter(t, mock_service.NewMockServices(ctrl))
+
+	req, err := http.NewRequest("GET", "/api/v1/kennels", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+	assert.Equal(t, "application/json; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Contains(
+		t, rr.Body.String(),
+		`{"error":{"status":404,"code":"not_found","message":"unknown API method: /api/v1/kennels"}}`,
+	)
+
+	req, err = http.NewRequest("POST", "/api/v1/puppies", nil)
+	assert.NoError(t, err)
+	rr = httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"code":"method_not_allowed"`)
+}
+
+func TestHandler_OpenAPIHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	services := &service.Service{Services: mock_service.NewMockServices(ctrl)}
+	logger2, err := logger.SetupLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+	}
+	handler := handlers.NewHandler(services, logger2)
+
+	router := chi.NewRouter()
+	router.Get("/api/openapi.json", handler.OpenAPIHandler)
+
+	req, err := http.NewRequest("GET", "/api/openapi.json", nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json; charset=utf-8", rr.Header().Get("Content-Type"))
+
+	var spec struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]struct {
+				Required   []string                          `json:"required"`
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &spec))
+	assert.Equal(t, "3.0.3", spec.OpenAPI)
+
+	// Схемы строятся по типам ответов API
+	puppy := spec.Components.Schemas["Puppy"]
+	assert.Contains(t, puppy.Required, "id")
+	assert.NotContains(t, puppy.Required, "price")
+	assert.Equal(t, "#/components/schemas/Money", puppy.Properties["price"]["$ref"])
+	assert.Equal(t, []interface{}{"available", "reserved", "sold"}, puppy.Properties["status"]["enum"])
+	details := spec.Components.Schemas["PuppyDetails"]
+	assert.Contains(t, details.Properties, "name")
+	assert.Equal(t, "#/components/schemas/Dog", details.Properties["mother"]["$ref"])
+	assert.NotContains(t, spec.Components.Schemas["Review"].Properties, "number")
+
+	// Схемы тел запросов админки из openapi.json остаются рядом со схемами ответов
+	form := spec.Components.Schemas["PuppyStatusForm"]
+	assert.Equal(t, []string{"id", "status"}, form.Required)
+	assert.Equal(
+		t, []interface{}{"available", "reserved", "returned", "sold"}, form.Properties["status"]["enum"],
+	)
+	assert.Equal(t, "binary", spec.Components.Schemas["ShowResultForm"].Properties["certificate"]["format"])
+	assert.Equal(t, "#/components/schemas/Litter", spec.Components.Schemas["LitterItem"].Properties["data"]["$ref"])
+	assert.Contains(t, spec.Components.Schemas["PlannedMating"].Properties["status"], "enum")
+}
+
+// newAPIAdminTestRouter создаёт роутер с методами API админки так же, как в routes.SetupRoutes.
+func newAPIAdminTestRouter(
+	t *testing.T, mockServices *mock_service.MockServices, mockAuth *mock_service.MockAuthorizationServices,
+) *chi.Mux {
+	services := &service.Service{Services: mockServices, AuthorizationServices: mockAuth}
+	logger2, err := logger.SetupLogger()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+	}
+
+	handler := handlers.NewHandler(services, logger2)
+	tokenMiddleware := authMiddleware.NewMiddlewareAPIToken(logger2, handler.Services, handler.APIAuthError)
+
+	router := chi.NewRouter()
+	router.Route(
+		"/api/v1/admin", func(r chi.Router) {
+			r.Use(tokenMiddleware.APITokenAuth)
+			r.Post("/puppies/add", handler.APIAdminAction(handler.AddPuppy))
+			r.Post("/puppies/delete", handler.APIAdminAction(handler.DeletePuppy))
+			r.Post("/litters/add", handler.APIAdminAction(handler.AddLitter))
+			r.Post("/shows/add", handler.APIAdminAction(handler.AddShowResult))
+			r.Post("/dictionaries/add", handler.APIAdminAction(handler.AddDictionaryEntry))
+			r.Post("/dictionaries/delete", handler.APIAdminAction(handler.DeleteDictionaryEntry))
+		},
+	)
+	return router
+}
+
+// showResultMultipart собирает тело multipart/form-data результата выставки со сканом сертификата.
+func showResultMultipart(t *testing.T) (string, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	fields := [][2]string{
+		{"dog", "3"}, {"event", "CACIB Москва"}, {"date", "2024-05-12"}, {"grade", "Отлично"},
+		{"titles", "CAC"}, {"titles", "BOB"},
+	}
+	for _, field := range fields {
+		assert.NoError(t, writer.WriteField(field[0], field[1]))
+	}
+	file, err := writer.CreateFormFile("certificate", "certificate.jpg")
+	assert.NoError(t, err)
+	_, err = file.Write([]byte("certificate"))
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	return body.String(), writer.FormDataContentType()
+}
+
+func TestHandler_APIAdminAction(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices)
+
+	const token = "ebt_test-token"
+	tokenOK := func(a *mock_service.MockAuthorizationServices) {
+		a.EXPECT().APITokenCheck(token).Return(&domain.APIToken{ID: 1, Name: "Скрипт"}, nil)
+	}
+	showBody, showContentType := showResultMultipart(t)
+
+	tests := []struct {
+		name           string
+		path           string
+		authorization  string
+		contentType    string
+		body           string
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedHeader string
+		expectedBody   []string
+	}{
+		{
+			name:          "Created 201 (JSON)",
+			path:          "/api/v1/admin/litters/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"mother": 1, "father": 2, "date": "2024-03-01", "readyOutDate": "2024-05-01", "description": null}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().LitterAdd(gomock.Any()).DoAndReturn(
+					func(litter *domain.Litter) error {
+						assert.Equal(t, 1, litter.MotherID)
+						assert.Equal(t, 2, litter.FatherID)
+						litter.ID = 5
+						litter.Breed = "cane-corso"
+						return nil
+					},
+				)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: []string{
+				`{"data":{"id":5,"breed":"cane-corso","motherId":1,"fatherId":2,"dateBirth":"2024-03-01","readyOutDate":"2024-05-01","description":""}}`,
+			},
+		}, {
+			name:          "Created 201 (Multipart with file)",
+			path:          "/api/v1/admin/shows/add",
+			authorization: "Bearer " + token,
+			contentType:   showContentType,
+			body:          showBody,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().ShowResultAdd(gomock.Any(), gomock.Not(gomock.Nil())).DoAndReturn(
+					func(result *domain.ShowResult, fileHeader *multipart.FileHeader) error {
+						assert.Equal(t, "certificate.jpg", fileHeader.Filename)
+						result.ID = 9
+						result.CertificateURL = "https://example.com/certificate.jpg"
+						return nil
+					},
+				)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: []string{
+				`"id":9`, `"dogId":3`, `"grade":"Отлично"`, `"titles":["CAC","BOB"]`,
+				`"certificateUrl":"https://example.com/certificate.jpg"`,
+			},
+		}, {
+			name:          "No Content 204 (Form body)",
+			path:          "/api/v1/admin/puppies/delete",
+			authorization: "bearer " + token,
+			contentType:   "application/x-www-form-urlencoded",
+			body:          "id=7",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().PuppyDelete("7").Return(nil)
+			},
+			expectedCode: http.StatusNoContent,
+		}, {
+			name:          "Created 201 (Puppy city from form)",
+			path:          "/api/v1/admin/puppies/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body: `{"name": "Тося", "breed": "yorkshire-terrier", "gender": "Сука", "color": "Шоколадный",
+				"mother": 1, "father": 2, "city": "  Санкт-Петербург "}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+				s.EXPECT().PuppyAdd(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
+						assert.Equal(t, "Санкт-Петербург", puppy.City)
+						puppy.ID = 12
+						return nil
+					},
+				)
+			},
+			expectedCode: http.StatusCreated,
+			expectedBody: []string{`"id":12`, `"city":"Санкт-Петербург"`},
+		}, {
+			name:          "Bad Request 400 (Invalid puppy city)",
+			path:          "/api/v1/admin/puppies/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body: `{"name": "Тося", "breed": "yorkshire-terrier", "gender": "Сука", "color": "Шоколадный",
+				"mother": 1, "father": 2, "city": "Уфа<script>"}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().DictionariesGet().Return(testDictionaries, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`"code":"bad_request"`, "Invalid city"},
+		}, {
+			name:          "Bad Request 400 (Invalid form value)",
+			path:          "/api/v1/admin/litters/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"mother": "first", "father": 2}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`{"error":{"status":400,"code":"bad_request","message":"Invalid mother ID"}}`},
+		}, {
+			name:          "Bad Request 400 (Nested JSON object)",
+			path:          "/api/v1/admin/litters/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"mother": {"id": 1}}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid JSON field mother"},
+		}, {
+			name:          "Bad Request 400 (Invalid JSON)",
+			path:          "/api/v1/admin/litters/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json; charset=utf-8",
+			body:          `{"mother": 1`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"invalid JSON body"},
+		}, {
+			name:          "Bad Request 400 (Sex added to fixed dictionary)",
+			path:          "/api/v1/admin/dictionaries/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"kind": "sexes", "value": "Кастрат", "labelRu": "Кастрат"}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().DictionaryEntryAdd(gomock.Any()).Return(fmt.Errorf("sexes: %w", domain.ErrDictionaryFixed))
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`"code":"bad_request"`, "В справочник пола нельзя добавлять записи"},
+		}, {
+			name:          "Bad Request 400 (Sex deleted from fixed dictionary)",
+			path:          "/api/v1/admin/dictionaries/delete",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"kind": "sexes", "id": 1}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().DictionaryEntryDelete(domain.DictionarySexes, "1").Return(
+					fmt.Errorf("sexes: %w", domain.ErrDictionaryFixed),
+				)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{`"code":"bad_request"`, "Из справочника пола нельзя удалять записи"},
+		}, {
+			name:          "Unsupported Media Type 415",
+			path:          "/api/v1/admin/puppies/delete",
+			authorization: "Bearer " + token,
+			contentType:   "text/plain",
+			body:          "id=7",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+			},
+			expectedCode: http.StatusUnsupportedMediaType,
+			expectedBody: []string{`"code":"unsupported_media_type"`},
+		}, {
+			name:           "Unauthorized 401 (No token)",
+			path:           "/api/v1/admin/puppies/delete",
+			contentType:    "application/json",
+			body:           `{"id": 7}`,
+			mockBehavior:   func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {},
+			expectedCode:   http.StatusUnauthorized,
+			expectedHeader: "Bearer",
+			expectedBody:   []string{`{"error":{"status":401,"code":"unauthorized","message":"missing or invalid API token"}}`},
+		}, {
+			name:          "Unauthorized 401 (Invalid token)",
+			path:          "/api/v1/admin/puppies/delete",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"id": 7}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				a.EXPECT().APITokenCheck(token).Return(nil, domain.ErrAPITokenInvalid)
+			},
+			expectedCode:   http.StatusUnauthorized,
+			expectedHeader: `Bearer error="invalid_token"`,
+			expectedBody:   []string{`"code":"unauthorized"`},
+		}, {
+			name:          "Unauthorized 401 (Not a bearer token)",
+			path:          "/api/v1/admin/puppies/delete",
+			authorization: "Basic YWRtaW46YWRtaW4=",
+			contentType:   "application/json",
+			body:          `{"id": 7}`,
+			mockBehavior:  func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {},
+			expectedCode:  http.StatusUnauthorized,
+			expectedBody:  []string{`"code":"unauthorized"`},
+		}, {
+			name:          "Internal Server Error 500 (Service APITokenCheck failure)",
+			path:          "/api/v1/admin/puppies/delete",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"id": 7}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				a.EXPECT().APITokenCheck(token).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{`"message":"failed to check API token"`},
+		}, {
+			name:          "Internal Server Error 500 (Service LitterAdd failure)",
+			path:          "/api/v1/admin/litters/add",
+			authorization: "Bearer " + token,
+			contentType:   "application/json",
+			body:          `{"mother": 1, "father": 2, "date": "2024-03-01", "readyOutDate": "2024-05-01"}`,
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				tokenOK(a)
+				s.EXPECT().LitterAdd(gomock.Any()).Return(errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{
+				`{"error":{"status":500,"code":"internal_error","message":"Failed to add litter"}}`,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockAuth := mock_service.NewMockAuthorizationServices(ctrl)
+				test.mockBehavior(mockServices, mockAuth)
+
+				router := newAPIAdminTestRouter(t, mockServices, mockAuth)
+
+				req, err := http.NewRequest("POST", test.path, strings.NewReader(test.body))
+				assert.NoError(t, err)
+				req.Header.Set("Content-Type", test.contentType)
+				if test.authorization != "" {
+					req.Header.Set("Authorization", test.authorization)
+				}
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+				if test.expectedHeader != "" {
+					assert.Equal(t, test.expectedHeader, rr.Header().Get("WWW-Authenticate"))
+				}
+				if test.expectedCode == http.StatusNoContent {
+					assert.Empty(t, rr.Body.String())
+				} else {
+					assert.Equal(t, "application/json; charset=utf-8", rr.Header().Get("Content-Type"))
+				}
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,457 @@
+package handlers
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"strconv"
+	"time"
+)
+
+// Ответы API v1. Имена полей JSON — часть контракта с мобильным приложением и сайтами партнёров:
+// их нельзя переименовывать или менять им тип, можно только добавлять новые поля.
+// Даты передаются в виде "2006-01-02", неизвестная дата не передаётся.
+
+// apiDateLayout — формат дат в ответах API.
+const apiDateLayout = "2006-01-02"
+
+// apiItem — ответ API с одной записью.
+type apiItem struct {
+	Data interface{} `json:"data"`
+}
+
+// apiList — ответ API со страницей списка.
+type apiList struct {
+	Data       interface{}   `json:"data"`
+	Pagination apiPagination `json:"pagination"`
+}
+
+// apiPagination описывает страницу списка. NextAfter — значение параметра after для следующей
+// страницы; его нет, если следующая страница выбирается только по номеру.
+type apiPagination struct {
+	Page       int `json:"page"`
+	TotalPages int `json:"totalPages"`
+	NextAfter  int `json:"nextAfter,omitempty"`
+}
+
+// apiErrorBody — ответ API с ошибкой.
+type apiErrorBody struct {
+	Error apiErrorDetail `json:"error"`
+}
+
+// apiErrorDetail — описание ошибки: HTTP-статус, код для программ и сообщение для разработчика.
+type apiErrorDetail struct {
+	Status  int    `json:"status"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// apiMoney — цена в минимальных единицах валюты, как в domain.Money.
+type apiMoney struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// apiPuppy — щенок в ответах API.
+type apiPuppy struct {
+	ID           int       `json:"id"`
+	URL          string    `json:"url"`
+	Name         string    `json:"name"`
+	Title        string    `json:"title"`
+	Sex          string    `json:"sex"`
+	Breed        string    `json:"breed"`
+	Color        string    `json:"color"`
+	Status       string    `json:"status"`
+	Price        *apiMoney `json:"price,omitempty"`
+	City         string    `json:"city,omitempty"`
+	DateBirth    string    `json:"dateBirth,omitempty"`
+	AgeWeeks     int       `json:"ageWeeks"`
+	ReadyOut     bool      `json:"readyOut"`
+	ReadyOutDate string    `json:"readyOutDate,omitempty"`
+	MotherID     int       `json:"motherId,omitempty"`
+	FatherID     int       `json:"fatherId,omitempty"`
+	LitterID     int       `json:"litterId,omitempty"`
+	Photos       []string  `json:"photos"`
+}
+
+// apiPuppyDetails — щенок вместе с родителями.
+type apiPuppyDetails struct {
+	apiPuppy
+	Mother *apiDog `json:"mother,omitempty"`
+	Father *apiDog `json:"father,omitempty"`
+}
+
+// apiDog — собака в ответах API. External — собака другого питомника, Kennel — его название.
+type apiDog struct {
+	ID       int      `json:"id"`
+	URL      string   `json:"url"`
+	Name     string   `json:"name"`
+	Title    string   `json:"title"`
+	Gender   string   `json:"gender"`
+	Breed    string   `json:"breed"`
+	Color    string   `json:"color"`
+	Retired  bool     `json:"retired"`
+	External bool     `json:"external"`
+	Kennel   string   `json:"kennel,omitempty"`
+	SireID   int      `json:"sireId,omitempty"`
+	DamID    int      `json:"damId,omitempty"`
+	Titles   string   `json:"titles,omitempty"`
+	Photos   []string `json:"photos"`
+}
+
+// apiReview — отзыв в ответах API. Телефон автора не передаётся.
+type apiReview struct {
+	ID        int      `json:"id"`
+	PuppyID   int      `json:"puppyId,omitempty"`
+	PuppyName string   `json:"puppyName,omitempty"`
+	Author    string   `json:"author"`
+	Text      string   `json:"text"`
+	Date      string   `json:"date"`
+	Photos    []string `json:"photos"`
+}
+
+// apiDate возвращает дату для ответа API или пустую строку, если дата не известна.
+func apiDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(apiDateLayout)
+}
+
+// apiPhotos возвращает ссылки на фото без пустых, чтобы в JSON был массив, а не null.
+func apiPhotos(urls []string) []string {
+	photos := make([]string, 0, len(urls))
+	for _, url := range urls {
+		if url != "" {
+			photos = append(photos, url)
+		}
+	}
+	return photos
+}
+
+// newAPIPuppy переводит щенка в ответ API.
+func newAPIPuppy(puppy domain.Puppy) apiPuppy {
+	result := apiPuppy{
+		ID:           puppy.ID,
+		URL:          "/puppies/" + strconv.Itoa(puppy.ID),
+		Name:         puppy.Name,
+		Title:        puppy.Title,
+		Sex:          puppy.Sex,
+		Breed:        puppy.Breed,
+		Color:        puppy.Color,
+		Status:       puppy.Status,
+		City:         puppy.City,
+		DateBirth:    apiDate(puppy.DateBirth),
+		AgeWeeks:     puppy.AgeWeeks(),
+		ReadyOut:     puppy.ReadyOut,
+		ReadyOutDate: apiDate(puppy.ReadyOutDate),
+		MotherID:     puppy.MotherID,
+		FatherID:     puppy.FatherID,
+		LitterID:     puppy.LitterID,
+		Photos:       apiPhotos(puppy.Urls),
+	}
+	if !puppy.Price.IsZero() {
+		result.Price = &apiMoney{Amount: puppy.Price.Amount, Currency: puppy.Price.Currency}
+	}
+	return result
+}
+
+// newAPIPuppies переводит список щенков в ответ API.
+func newAPIPuppies(puppies []domain.Puppy) []apiPuppy {
+	result := make([]apiPuppy, 0, len(puppies))
+	for _, puppy := range puppies {
+		result = append(result, newAPIPuppy(puppy))
+	}
+	return result
+}
+
+// newAPIDog переводит собаку в ответ API.
+func newAPIDog(dog domain.Dog) apiDog {
+	return apiDog{
+		ID:       dog.ID,
+		URL:      "/dogs/" + strconv.Itoa(dog.ID),
+		Name:     dog.Name,
+		Title:    dog.Title,
+		Gender:   dog.Gender,
+		Breed:    dog.Breed,
+		Color:    dog.Color,
+		Retired:  dog.Archived,
+		External: dog.External,
+		Kennel:   dog.Kennel,
+		SireID:   dog.SireID,
+		DamID:    dog.DamID,
+		Titles:   dog.TitlesLine(),
+		Photos:   apiPhotos(dog.Urls),
+	}
+}
+
+// newAPIDogs переводит список собак в ответ API.
+func newAPIDogs(dogs []domain.Dog) []apiDog {
+	result := make([]apiDog, 0, len(dogs))
+	for _, dog := range dogs {
+		result = append(result, newAPIDog(dog))
+	}
+	return result
+}
+
+// newAPIReview переводит отзыв в ответ API.
+func newAPIReview(review domain.Feedback, puppyName string) apiReview {
+	return apiReview{
+		ID:        review.ID,
+		PuppyID:   review.PuppyID,
+		PuppyName: puppyName,
+		Author:    review.Name,
+		Text:      review.Title,
+		Date:      review.Date,
+		Photos:    apiPhotos(review.Urls),
+	}
+}
+
+// newAPIReviews переводит список отзывов в ответ API. puppyNames — имена щенков по ID отзыва.
+func newAPIReviews(reviews []domain.Feedback, puppyNames map[int]string) []apiReview {
+	result := make([]apiReview, 0, len(reviews))
+	for _, review := range reviews {
+		result = append(result, newAPIReview(review, puppyNames[review.ID]))
+	}
+	return result
+}
+
+// Ответы API админки. В них есть служебные поля, которых нет в публичных ответах.
+
+// apiLitter — помёт в ответах API админки.
+type apiLitter struct {
+	ID           int    `json:"id"`
+	Breed        string `json:"breed"`
+	MotherID     int    `json:"motherId"`
+	FatherID     int    `json:"fatherId"`
+	DateBirth    string `json:"dateBirth,omitempty"`
+	ReadyOutDate string `json:"readyOutDate,omitempty"`
+	Description  string `json:"description"`
+}
+
+// apiPlannedMating — запланированная вязка. LitterID есть, когда вязка завершилась помётом.
+type apiPlannedMating struct {
+	ID           int    `json:"id"`
+	MotherID     int    `json:"motherId"`
+	FatherID     int    `json:"fatherId"`
+	ExpectedDate string `json:"expectedDate,omitempty"`
+	Status       string `json:"status"`
+	Description  string `json:"description"`
+	LitterID     int    `json:"litterId,omitempty"`
+}
+
+// apiBuyer — покупатель.
+type apiBuyer struct {
+	ID     int      `json:"id"`
+	Name   string   `json:"name"`
+	Phones []string `json:"phones"`
+	Email  string   `json:"email,omitempty"`
+	City   string   `json:"city,omitempty"`
+	Notes  string   `json:"notes,omitempty"`
+}
+
+// apiHealthEvent — событие здоровья щенка или собаки.
+type apiHealthEvent struct {
+	ID          int    `json:"id"`
+	PuppyID     int    `json:"puppyId,omitempty"`
+	DogID       int    `json:"dogId,omitempty"`
+	Type        string `json:"type"`
+	Date        string `json:"date"`
+	Title       string `json:"title"`
+	Result      string `json:"result"`
+	DocumentURL string `json:"documentUrl,omitempty"`
+}
+
+// apiWeight — взвешивание щенка, вес в граммах.
+type apiWeight struct {
+	ID      int    `json:"id"`
+	PuppyID int    `json:"puppyId"`
+	Date    string `json:"date"`
+	Grams   int    `json:"grams"`
+}
+
+// apiShowResult — результат выставки.
+type apiShowResult struct {
+	ID             int      `json:"id"`
+	DogID          int      `json:"dogId"`
+	Event          string   `json:"event"`
+	Date           string   `json:"date"`
+	Judge          string   `json:"judge"`
+	Grade          string   `json:"grade"`
+	Titles         []string `json:"titles"`
+	CertificateURL string   `json:"certificateUrl,omitempty"`
+}
+
+// apiDictionaryEntry — запись справочника. Breed есть только у окрасов.
+type apiDictionaryEntry struct {
+	ID        int    `json:"id"`
+	Kind      string `json:"kind"`
+	Breed     string `json:"breed,omitempty"`
+	Value     string `json:"value"`
+	LabelRu   string `json:"labelRu"`
+	LabelEn   string `json:"labelEn"`
+	SortOrder int    `json:"sortOrder"`
+}
+
+// apiArticle — статья. Body — текст в Markdown.
+type apiArticle struct {
+	ID          int      `json:"id"`
+	URL         string   `json:"url"`
+	Title       string   `json:"title"`
+	Slug        string   `json:"slug"`
+	Body        string   `json:"body"`
+	CoverURL    string   `json:"coverUrl,omitempty"`
+	Tags        []string `json:"tags"`
+	Published   bool     `json:"published"`
+	PublishedAt string   `json:"publishedAt,omitempty"`
+	UpdatedAt   string   `json:"updatedAt,omitempty"`
+}
+
+// apiSiteSettings — настройки сайта. Нулевые координаты означают, что карты нет.
+type apiSiteSettings struct {
+	Phone          string  `json:"phone"`
+	Email          string  `json:"email"`
+	Address        string  `json:"address"`
+	OpeningHours   string  `json:"openingHours"`
+	Telegram       string  `json:"telegram"`
+	WhatsApp       string  `json:"whatsapp"`
+	Instagram      string  `json:"instagram"`
+	VK             string  `json:"vk"`
+	MapLat         float64 `json:"mapLat"`
+	MapLng         float64 `json:"mapLng"`
+	FooterText     string  `json:"footerText"`
+	SEOTitle       string  `json:"seoTitle"`
+	SEODescription string  `json:"seoDescription"`
+	SEOKeywords    string  `json:"seoKeywords"`
+}
+
+// apiStrings возвращает список строк, пустой список — в виде массива, а не null.
+func apiStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// newAPILitter переводит помёт в ответ API.
+func newAPILitter(litter domain.Litter) apiLitter {
+	return apiLitter{
+		ID:           litter.ID,
+		Breed:        litter.Breed,
+		MotherID:     litter.MotherID,
+		FatherID:     litter.FatherID,
+		DateBirth:    apiDate(litter.DateBirth),
+		ReadyOutDate: apiDate(litter.ReadyOutDate),
+		Description:  litter.Description,
+	}
+}
+
+// newAPIPlannedMating переводит запланированную вязку в ответ API.
+func newAPIPlannedMating(mating domain.PlannedMating) apiPlannedMating {
+	return apiPlannedMating{
+		ID:           mating.ID,
+		MotherID:     mating.MotherID,
+		FatherID:     mating.FatherID,
+		ExpectedDate: apiDate(mating.ExpectedDate),
+		Status:       mating.Status,
+		Description:  mating.Description,
+		LitterID:     mating.LitterID,
+	}
+}
+
+// newAPIBuyer переводит покупателя в ответ API.
+func newAPIBuyer(buyer domain.Buyer) apiBuyer {
+	return apiBuyer{
+		ID:     buyer.ID,
+		Name:   buyer.Name,
+		Phones: apiStrings(buyer.Phones),
+		Email:  buyer.Email,
+		City:   buyer.City,
+		Notes:  buyer.Notes,
+	}
+}
+
+// newAPIHealthEvent переводит событие здоровья в ответ API.
+func newAPIHealthEvent(event domain.HealthEvent) apiHealthEvent {
+	return apiHealthEvent{
+		ID:          event.ID,
+		PuppyID:     event.PuppyID,
+		DogID:       event.DogID,
+		Type:        event.Type,
+		Date:        apiDate(event.Date),
+		Title:       event.Title,
+		Result:      event.Result,
+		DocumentURL: event.DocumentURL,
+	}
+}
+
+// newAPIWeight переводит взвешивание в ответ API.
+func newAPIWeight(measurement domain.WeightMeasurement) apiWeight {
+	return apiWeight{
+		ID:      measurement.ID,
+		PuppyID: measurement.PuppyID,
+		Date:    apiDate(measurement.Date),
+		Grams:   measurement.Grams,
+	}
+}
+
+// newAPIShowResult переводит результат выставки в ответ API.
+func newAPIShowResult(result domain.ShowResult) apiShowResult {
+	return apiShowResult{
+		ID:             result.ID,
+		DogID:          result.DogID,
+		Event:          result.Event,
+		Date:           apiDate(result.Date),
+		Judge:          result.Judge,
+		Grade:          result.Grade,
+		Titles:         apiStrings(result.Titles),
+		CertificateURL: result.CertificateURL,
+	}
+}
+
+// newAPIDictionaryEntry переводит запись справочника в ответ API.
+func newAPIDictionaryEntry(entry domain.DictionaryEntry) apiDictionaryEntry {
+	return apiDictionaryEntry{
+		ID:        entry.ID,
+		Kind:      entry.Kind,
+		Breed:     entry.Breed,
+		Value:     entry.Value,
+		LabelRu:   entry.LabelRu,
+		LabelEn:   entry.LabelEn,
+		SortOrder: entry.SortOrder,
+	}
+}
+
+// newAPIArticle переводит статью в ответ API.
+func newAPIArticle(article domain.Article) apiArticle {
+	return apiArticle{
+		ID:          article.ID,
+		URL:         article.URL(),
+		Title:       article.Title,
+		Slug:        article.Slug,
+		Body:        article.Body,
+		CoverURL:    article.CoverURL,
+		Tags:        apiStrings(article.Tags),
+		Published:   article.Published,
+		PublishedAt: apiDate(article.PublishedAt),
+		UpdatedAt:   apiDate(article.UpdatedAt),
+	}
+}
+
+// newAPISiteSettings переводит настройки сайта в ответ API.
+func newAPISiteSettings(settings domain.SiteSettings) apiSiteSettings {
+	return apiSiteSettings{
+		Phone:          settings.Phone,
+		Email:          settings.Email,
+		Address:        settings.Address,
+		OpeningHours:   settings.OpeningHours,
+		Telegram:       settings.Telegram,
+		WhatsApp:       settings.WhatsApp,
+		Instagram:      settings.Instagram,
+		VK:             settings.VK,
+		MapLat:         settings.MapLat,
+		MapLng:         settings.MapLng,
+		FooterText:     settings.FooterText,
+		SEOTitle:       settings.SEOTitle,
+		SEODescription: settings.SEODescription,
+		SEOKeywords:    settings.SEOKeywords,
+	}
+}
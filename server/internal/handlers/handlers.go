@@ -6,6 +6,8 @@ import (
 	"go.uber.org/zap"
 	"html/template"
 	"net/http"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
@@ -19,14 +21,18 @@ type Handler struct {
 
 // NewHandler создает новый экземпляр Handler.
 func NewHandler(services *service.Service, logger *zap.Logger) *Handler {
-	return &Handler{
-		Services:      services,
-		logger:        logger,
-		ParseTemplate: template.ParseFiles,
+	h := &Handler{
+		Services: services,
+		logger:   logger,
 		ExecuteTemplate: func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
 			return t.ExecuteTemplate(w, name, data)
 		},
 	}
+	// Шаблоны разбираются с функциями страниц сайта, чтобы в них были доступны настройки сайта
+	h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
+		return template.New(filepath.Base(filenames[0])).Funcs(h.templateFuncs()).ParseFiles(filenames...)
+	}
+	return h
 }
 
 func (h *Handler) AddFeedback(w http.ResponseWriter, r *http.Request) {
@@ -81,3 +87,117 @@ func (h *Handler) AddFeedback(w http.ResponseWriter, r *http.Request) {
 
 	http.Redirect(w, r, "/admin/puppy", http.StatusSeeOther)
 }
+
+// AddWaitlistEntry записывает посетителя в лист ожидания щенка.
+func (h *Handler) AddWaitlistEntry(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Invalid request method", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := r.ParseForm()
+	if err != nil {
+		h.logger.Error("Failed to parse form", zap.Error(err))
+		http.Error(w, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	name := strings.TrimSpace(r.FormValue("name"))
+	if name == "" {
+		h.logger.Error("Empty waitlist name")
+		http.Error(w, "Укажите имя", http.StatusBadRequest)
+		return
+	}
+
+	phones, err := ValidatePhones([]string{r.FormValue("phone")})
+	if err != nil || len(phones) == 0 {
+		h.logger.Error("Invalid waitlist phone", zap.Error(err))
+		http.Error(w, "Неверный номер телефона", http.StatusBadRequest)
+		return
+	}
+
+	email, err := ValidateEmail(r.FormValue("email"))
+	if err != nil {
+		h.logger.Error("Invalid waitlist email", zap.Error(err))
+		http.Error(w, "Неверный адрес почты", http.StatusBadRequest)
+		return
+	}
+
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении справочников", zap.Error(err))
+		http.Error(w, "Ошибка при получении справочников", http.StatusInternalServerError)
+		return
+	}
+
+	// Окрасы в заявке — окрасы выбранной породы
+	breed, ok := dictionaries.BreedGet(r.FormValue("breed"))
+	if !ok {
+		h.logger.Error("Invalid waitlist breed", zap.String("breed", r.FormValue("breed")))
+		http.Error(w, "Неверная порода", http.StatusBadRequest)
+		return
+	}
+
+	colors, err := ValidateChocolates(r.Form["color"], domain.DictionaryValues(dictionaries.ColorsOf(breed.Value)))
+	if err != nil {
+		h.logger.Error("Invalid waitlist color", zap.Error(err))
+		http.Error(w, "Неверный окрас", http.StatusBadRequest)
+		return
+	}
+
+	var gender string
+	if r.FormValue("gender") != "" {
+		genders, err := ValidateGender([]string{r.FormValue("gender")}, domain.DictionaryValues(dictionaries.Sexes))
+		if err != nil {
+			h.logger.Error("Invalid waitlist gender", zap.Error(err))
+			http.Error(w, "Неверный пол", http.StatusBadRequest)
+			return
+		}
+		gender = genders[0]
+	}
+
+	budget, err := ValidateBudget(r.FormValue("budget"))
+	if err != nil {
+		h.logger.Error("Invalid waitlist budget", zap.Error(err))
+		http.Error(w, "Неверный бюджет", http.StatusBadRequest)
+		return
+	}
+
+	wantedFrom, wantedUntil, err := ValidateTimeframe(r.FormValue("wantedFrom"), r.FormValue("wantedUntil"))
+	if err != nil {
+		h.logger.Error("Invalid waitlist timeframe", zap.Error(err))
+		http.Error(w, "Неверные сроки", http.StatusBadRequest)
+		return
+	}
+
+	entry := &domain.WaitlistEntry{
+		Name:        name,
+		Phone:       phones[0],
+		Email:       email,
+		Breed:       breed.Value,
+		Colors:      colors,
+		Gender:      gender,
+		Budget:      budget,
+		WantedFrom:  wantedFrom,
+		WantedUntil: wantedUntil,
+		Comment:     r.FormValue("comment"),
+	}
+
+	h.logger.Info(
+		"Waitlist entry add",
+		zap.String("Name", entry.Name),
+		zap.String("Breed", entry.Breed),
+		zap.Strings("Colors", entry.Colors),
+		zap.String("Gender", entry.Gender),
+		zap.Int("Budget", entry.Budget),
+	)
+
+	err = h.Services.WaitlistEntryAdd(entry)
+	if err != nil {
+		h.logger.Error("Ошибка при записи в лист ожидания", zap.Error(err))
+		http.Error(w, "Ошибка при записи в лист ожидания", http.StatusInternalServerError)
+		return
+	}
+
+	http.Redirect(w, r, "/waitlist?joined=true", http.StatusSeeOther)
+}
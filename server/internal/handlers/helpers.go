@@ -1,11 +1,154 @@
 package handlers
 
 import (
+	"bytes"
+	"fmt"
+	"github.com/Masterminds/sprig/v3"
 	"github.com/egosha7/site-go/internal/domain"
-	"log"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+	"go.uber.org/zap"
+	"html/template"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func contains(chocolates []string, genders []string, readyToMove string) string {
+// Статусы щенков, которые показываются в разделах сайта.
+var (
+	publicPuppyStatuses  = []string{domain.PuppyStatusAvailable, domain.PuppyStatusReserved}
+	adminPuppyStatuses   = []string{domain.PuppyStatusAvailable, domain.PuppyStatusReserved, domain.PuppyStatusReturned}
+	archivePuppyStatuses = []string{domain.PuppyStatusSold}
+)
+
+// visitorLocale возвращает язык посетителя из заголовка Accept-Language, например "en" для "en-US,en;q=0.9".
+// Без заголовка цены показываются по-русски.
+func visitorLocale(r *http.Request) string {
+	preferred := strings.SplitN(r.Header.Get("Accept-Language"), ",", 2)[0]
+	tag := strings.TrimSpace(strings.SplitN(preferred, ";", 2)[0])
+	language := strings.ToLower(strings.SplitN(tag, "-", 2)[0])
+	if language == "" || language == "*" {
+		return "ru"
+	}
+	return language
+}
+
+// templateFuncs возвращает функции шаблонов страниц сайта: sprig и settings — настройки сайта.
+// Настройки читаются при первом обращении из шаблона, один раз за вывод страницы. Если получить
+// их не удалось, страница выводится с настройками по умолчанию.
+func (h *Handler) templateFuncs() template.FuncMap {
+	var settings *domain.SiteSettings
+	funcs := sprig.FuncMap()
+	funcs["settings"] = func() *domain.SiteSettings {
+		if settings == nil {
+			var err error
+			settings, err = h.Services.SiteSettingsGet()
+			if err != nil || settings == nil {
+				h.logger.Error("Ошибка получения настроек сайта", zap.Error(err))
+				settings = domain.DefaultSiteSettings()
+			}
+		}
+		return settings
+	}
+	return funcs
+}
+
+// puppyQuery — фильтры каталога щенков из строки запроса.
+type puppyQuery struct {
+	Chocolates  []string
+	Genders     []string
+	Statuses    []string
+	ReadyToMove string
+	Filter      domain.PuppyFilter
+	// After — ID последнего щенка предыдущей страницы, 0 — страница выбирается по номеру.
+	After int
+}
+
+// parsePuppyQuery проверяет фильтры каталога щенков одинаково для страниц сайта и API: окрасы и пол
+// сверяются со справочниками, статусы — с допустимыми для раздела. При ошибке кроме неё возвращается
+// сообщение для страницы сайта.
+func parsePuppyQuery(
+	query url.Values, colors, sexes []domain.DictionaryEntry, allowedStatuses []string,
+) (*puppyQuery, string, error) {
+	chocolates, err := ValidateChocolates(query["chocolate"], domain.DictionaryValues(colors))
+	if err != nil {
+		return nil, "Ошибка при обработке параметра chocolate", err
+	}
+
+	genders, err := ValidateGender(query["gender"], domain.DictionaryValues(sexes))
+	if err != nil {
+		return nil, "Ошибка при обработке параметра gender", err
+	}
+
+	readyToMove, err := ValidateReadyToMove(query.Get("readyToMove"))
+	if err != nil {
+		return nil, "Ошибка при обработке параметра readyToMove", err
+	}
+
+	statuses, err := ValidatePuppyStatuses(query["status"], allowedStatuses)
+	if err != nil {
+		return nil, "Ошибка при обработке параметра status", err
+	}
+
+	sort, err := ValidatePuppySort(query.Get("sort"))
+	if err != nil {
+		return nil, "Ошибка при обработке параметра sort", err
+	}
+
+	priceFrom, priceTo, err := ValidatePriceRange(query.Get("priceFrom"), query.Get("priceTo"))
+	if err != nil {
+		return nil, "Ошибка при обработке диапазона цены", err
+	}
+
+	ageFrom, ageTo, err := ValidateAgeRange(query.Get("ageFrom"), query.Get("ageTo"))
+	if err != nil {
+		return nil, "Ошибка при обработке диапазона возраста", err
+	}
+
+	city, err := ValidateCity(query.Get("city"))
+	if err != nil {
+		return nil, "Ошибка при обработке параметра city", err
+	}
+
+	after, err := ValidatePageAfter(query.Get("after"))
+	if err != nil {
+		return nil, "Ошибка при обработке параметра after", err
+	}
+	filter := domain.PuppyFilter{
+		Sort:      sort,
+		PriceFrom: priceFrom,
+		PriceTo:   priceTo,
+		AgeFrom:   ageFrom,
+		AgeTo:     ageTo,
+		City:      city,
+	}
+	// По ключу выбирается только следующая страница списка по новизне. При другой сортировке after
+	// не учитывается, например в ссылке, сохранённой до смены сортировки: страница выбирается по номеру
+	if !filter.Keyset() {
+		after = 0
+	}
+
+	return &puppyQuery{
+		Chocolates:  chocolates,
+		Genders:     genders,
+		Statuses:    statuses,
+		ReadyToMove: readyToMove,
+		Filter:      filter,
+		After:       after,
+	}, "", nil
+}
+
+// contains собирает параметры фильтров для ссылок пагинации, чтобы при переходе по страницам
+// сохранялся выбранный поиск. Сортировка по умолчанию и пустые границы не добавляются.
+func contains(chocolates []string, genders []string, statuses []string, readyToMove string, filter domain.PuppyFilter) string {
 	var getParams string
 	if len(chocolates) > 0 {
 		for _, v := range chocolates {
@@ -13,6 +156,12 @@ func contains(chocolates []string, genders []string, readyToMove string) string
 		}
 	}
 
+	if len(statuses) > 0 {
+		for _, v := range statuses {
+			getParams += "&status=" + v
+		}
+	}
+
 	if len(genders) > 0 {
 		for _, v := range genders {
 			getParams += "&gender=" + v
@@ -23,119 +172,289 @@ func contains(chocolates []string, genders []string, readyToMove string) string
 		getParams += "&readyToMove=" + readyToMove
 	}
 
-	return getParams
-}
-
-func getPagedReviews(reviews []domain.Feedback, currentPage, perPage int) ([]domain.Feedback, int, error) {
-	// Общее количество щенков
-	reviewsCount := len(reviews)
-
-	// Рассчитываем общее количество страниц
-	totalPages := reviewsCount / perPage
-	if reviewsCount%perPage != 0 {
-		totalPages++
+	if filter.Sort != "" && filter.Sort != domain.PuppySortNewest {
+		getParams += "&sort=" + filter.Sort
 	}
 
-	// Проверка текущей страницы на выход за пределы
-	if currentPage > totalPages {
-		currentPage = totalPages
+	if filter.PriceFrom > 0 {
+		getParams += "&priceFrom=" + strconv.Itoa(filter.PriceFrom)
 	}
-	if currentPage < 1 {
-		currentPage = 1
+
+	if filter.PriceTo > 0 {
+		getParams += "&priceTo=" + strconv.Itoa(filter.PriceTo)
 	}
 
-	// Вычисляем начальный и конечный индекс для текущей страницы
-	startIndex := (currentPage - 1) * perPage
-	endIndex := startIndex + perPage
+	if filter.AgeFrom > 0 {
+		getParams += "&ageFrom=" + strconv.Itoa(filter.AgeFrom)
+	}
 
-	// Проверка на выход за границы
-	if startIndex > reviewsCount {
-		startIndex = reviewsCount
+	if filter.AgeTo > 0 {
+		getParams += "&ageTo=" + strconv.Itoa(filter.AgeTo)
 	}
-	if endIndex > reviewsCount {
-		endIndex = reviewsCount
+
+	if filter.City != "" {
+		getParams += "&city=" + url.QueryEscape(filter.City)
 	}
 
-	log.Println(startIndex, "->", endIndex)
+	return getParams
+}
 
-	// Получаем щенков для текущей страницы
-	pagedReviews := reviews[startIndex:endIndex]
+// Размеры графика роста в пикселях и отступы области построения.
+const (
+	chartWidth  = 640
+	chartHeight = 320
+	chartLeft   = 60
+	chartRight  = 20
+	chartTop    = 20
+	chartBottom = 40
+)
+
+// Цвета кривых роста: текущий щенок выделяется золотым, однопомётники — серым.
+const (
+	chartCurrentColor = "#d4af37"
+	chartLitterColor  = "#6c757d"
+)
+
+// chartWeightSteps — шаги сетки по весу в граммах, из которых выбирается первый подходящий.
+var chartWeightSteps = []int{100, 200, 250, 500, 1000, 2000, 2500, 5000}
+
+// growthChart — график роста щенков, подготовленный для вывода в SVG.
+type growthChart struct {
+	Width, Height            int
+	Left, Right, Top, Bottom int
+	Lines                    []growthLine
+	XTicks                   []chartTick
+	YTicks                   []chartTick
+}
 
-	return pagedReviews, totalPages, nil
+// growthLine — кривая роста одного щенка.
+type growthLine struct {
+	Name    string
+	Current bool
+	Color   string
+	Points  string
+	Dots    []chartDot
 }
 
-func getPagedPuppies(puppies []domain.Puppy, currentPage, perPage int) ([]domain.Puppy, int, error) {
-	// Общее количество щенков
-	puppiesCount := len(puppies)
+// chartDot — точка взвешивания на кривой.
+type chartDot struct {
+	X, Y  float64
+	Label string
+}
+
+// chartTick — деление на оси графика.
+type chartTick struct {
+	Pos   float64
+	Label string
+}
+
+// newGrowthChart строит график роста по кривым щенков помёта. Кривая щенка puppyID выделяется.
+// Если взвешиваний нет, возвращает nil.
+func newGrowthChart(series []domain.GrowthSeries, puppyID int) *growthChart {
+	var first, last time.Time
+	maxGrams := 0
+	for _, s := range series {
+		for _, m := range s.Measurements {
+			if first.IsZero() || m.Date.Before(first) {
+				first = m.Date
+			}
+			if m.Date.After(last) {
+				last = m.Date
+			}
+			if m.Grams > maxGrams {
+				maxGrams = m.Grams
+			}
+		}
+	}
+	if maxGrams == 0 {
+		return nil
+	}
 
-	// Рассчитываем общее количество страниц
-	totalPages := puppiesCount / perPage
-	if puppiesCount%perPage != 0 {
-		totalPages++
+	chart := &growthChart{
+		Width:  chartWidth,
+		Height: chartHeight,
+		Left:   chartLeft,
+		Right:  chartWidth - chartRight,
+		Top:    chartTop,
+		Bottom: chartHeight - chartBottom,
 	}
 
-	// Проверка текущей страницы на выход за пределы
-	if currentPage > totalPages {
-		currentPage = totalPages
+	days := int(last.Sub(first).Hours() / 24)
+	if days < 1 {
+		days = 1
 	}
-	if currentPage < 1 {
-		currentPage = 1
+	step := chartWeightSteps[len(chartWeightSteps)-1]
+	for _, candidate := range chartWeightSteps {
+		if maxGrams <= candidate*6 {
+			step = candidate
+			break
+		}
 	}
+	top := int(math.Ceil(float64(maxGrams)/float64(step))) * step
 
-	// Вычисляем начальный и конечный индекс для текущей страницы
-	startIndex := (currentPage - 1) * perPage
-	endIndex := startIndex + perPage
+	x := func(date time.Time) float64 {
+		return float64(chart.Left) + float64(chart.Right-chart.Left)*date.Sub(first).Hours()/24/float64(days)
+	}
+	y := func(grams int) float64 {
+		return float64(chart.Bottom) - float64(chart.Bottom-chart.Top)*float64(grams)/float64(top)
+	}
 
-	// Проверка на выход за границы
-	if startIndex > puppiesCount {
-		startIndex = puppiesCount
+	for grams := 0; grams <= top; grams += step {
+		chart.YTicks = append(chart.YTicks, chartTick{Pos: y(grams), Label: formatGrams(grams)})
+	}
+	tickDays := 7
+	for days/tickDays > 8 {
+		tickDays *= 2
 	}
-	if endIndex > puppiesCount {
-		endIndex = puppiesCount
+	for day := 0; day <= days; day += tickDays {
+		date := first.AddDate(0, 0, day)
+		chart.XTicks = append(chart.XTicks, chartTick{Pos: x(date), Label: date.Format("02.01")})
 	}
 
-	log.Println(startIndex, "->", endIndex)
+	for _, s := range series {
+		line := growthLine{Name: s.Name, Current: s.PuppyID == puppyID, Color: chartLitterColor}
+		if line.Current {
+			line.Color = chartCurrentColor
+		}
+		points := make([]string, 0, len(s.Measurements))
+		for _, m := range s.Measurements {
+			dot := chartDot{X: x(m.Date), Y: y(m.Grams), Label: m.Date.Format("02.01.2006") + ": " + formatGrams(m.Grams)}
+			line.Dots = append(line.Dots, dot)
+			points = append(points, fmt.Sprintf("%.1f,%.1f", dot.X, dot.Y))
+		}
+		line.Points = strings.Join(points, " ")
+		chart.Lines = append(chart.Lines, line)
+	}
 
-	// Получаем щенков для текущей страницы
-	pagedPuppies := puppies[startIndex:endIndex]
+	return chart
+}
 
-	return pagedPuppies, totalPages, nil
+// formatGrams выводит вес в граммах или килограммах, если вес не меньше килограмма.
+func formatGrams(grams int) string {
+	if grams < 1000 {
+		return fmt.Sprintf("%d г", grams)
+	}
+	kilograms := strconv.FormatFloat(float64(grams)/1000, 'f', -1, 64)
+	return strings.Replace(kilograms, ".", ",", 1) + " кг"
 }
 
-func getPagedDogs(dogs []domain.Dog, currentPage, perPage int) ([]domain.Dog, int, error) {
-	// Общее количество щенков
-	dogsCount := len(dogs)
+// highlightSnippet экранирует фрагмент результата поиска и выделяет в нём совпадения.
+// Разметка добавляется только вместо маркеров, поэтому текст из базы не попадает в HTML как есть.
+func highlightSnippet(snippet string) template.HTML {
+	escaped := template.HTMLEscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, domain.SearchHighlightStart, "<mark>")
+	escaped = strings.ReplaceAll(escaped, domain.SearchHighlightStop, "</mark>")
+	return template.HTML(escaped)
+}
 
-	// Рассчитываем общее количество страниц
-	totalPages := dogsCount / perPage
-	if dogsCount%perPage != 0 {
-		totalPages++
+// kennelDogs отбрасывает сторонних собак, заведённых только для родословных,
+// и оставляет собак питомника.
+func kennelDogs(dogs []domain.Dog) []domain.Dog {
+	own := make([]domain.Dog, 0, len(dogs))
+	for _, dog := range dogs {
+		if !dog.External {
+			own = append(own, dog)
+		}
 	}
+	return own
+}
+
+// markdown переводит текст статей в HTML. Сырой HTML из текста не выводится, а ссылки
+// со схемами вроде javascript: остаются без адреса, поэтому результат можно вставлять в страницу как есть.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithParserOptions(parser.WithASTTransformers(util.Prioritized(safeAutoLinks{}, 100))),
+)
+
+// safeAutoLinks превращает автоссылки вида <javascript:...> в обычный текст: goldmark проверяет адрес
+// только у ссылок и картинок, а автоссылки выводит как есть.
+type safeAutoLinks struct{}
 
-	// Проверка текущей страницы на выход за пределы
-	if currentPage > totalPages {
-		currentPage = totalPages
+// Transform заменяет опасные автоссылки документа их текстом.
+func (safeAutoLinks) Transform(doc *ast.Document, reader text.Reader, _ parser.Context) {
+	source := reader.Source()
+	var unsafe []*ast.AutoLink
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if link, ok := node.(*ast.AutoLink); ok && entering && gmhtml.IsDangerousURL(link.URL(source)) {
+			unsafe = append(unsafe, link)
+		}
+		return ast.WalkContinue, nil
+	})
+	for _, link := range unsafe {
+		link.Parent().ReplaceChild(link.Parent(), link, ast.NewString(link.Label(source)))
 	}
-	if currentPage < 1 {
-		currentPage = 1
+}
+
+// renderMarkdown переводит Markdown-текст статьи в очищенный HTML.
+func renderMarkdown(source string) (template.HTML, error) {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(source), &buf); err != nil {
+		return "", err
 	}
+	return template.HTML(buf.String()), nil
+}
 
-	// Вычисляем начальный и конечный индекс для текущей страницы
-	startIndex := (currentPage - 1) * perPage
-	endIndex := startIndex + perPage
+// translit — латинская запись русских букв для адресов статей.
+var translit = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh", 'з': "z", 'и': "i",
+	'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t",
+	'у': "u", 'ф': "f", 'х': "h", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "",
+	'э': "e", 'ю': "yu", 'я': "ya",
+}
 
-	// Проверка на выход за границы
-	if startIndex > dogsCount {
-		startIndex = dogsCount
-	}
-	if endIndex > dogsCount {
-		endIndex = dogsCount
+// articleSlug собирает адрес статьи из заголовка: русские буквы записываются латиницей,
+// остальные символы, кроме латиницы и цифр, становятся дефисами. Например, "Уход за щенком" — "uhod-za-schenkom".
+func articleSlug(title string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(title) {
+		part, ok := translit[r]
+		switch {
+		case ok:
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			part = string(r)
+		default:
+			dash = b.Len() > 0
+			continue
+		}
+		if part == "" {
+			continue
+		}
+		if dash {
+			part = "-" + part
+			dash = false
+		}
+		if b.Len()+len(part) > articleSlugMaxLength {
+			break
+		}
+		b.WriteString(part)
 	}
 
-	log.Println(startIndex, "->", endIndex)
+	// Заголовок без букв и цифр или из одних цифр не даёт допустимого адреса
+	slug := b.String()
+	if _, err := strconv.Atoi(slug); slug == "" || err == nil {
+		slug = strings.TrimSuffix("article-"+slug, "-")
+	}
+	return slug
+}
 
-	// Получаем щенков для текущей страницы
-	pagedDogs := dogs[startIndex:endIndex]
+// freeArticleSlug возвращает адрес, не занятый другой статьёй: к занятому адресу добавляется номер,
+// например "uhod-za-schenkom-2". Статья articleID может сохранить свой адрес.
+func (h *Handler) freeArticleSlug(slug string, articleID int) (string, error) {
+	candidate := slug
+	for n := 2; ; n++ {
+		owner, err := h.Services.ArticleGetBySlug(candidate)
+		if err != nil {
+			return "", err
+		}
+		if owner == nil || owner.ID == articleID {
+			return candidate, nil
+		}
 
-	return pagedDogs, totalPages, nil
+		suffix := "-" + strconv.Itoa(n)
+		if len(slug)+len(suffix) > articleSlugMaxLength {
+			slug = strings.TrimRight(slug[:articleSlugMaxLength-len(suffix)], "-")
+		}
+		candidate = slug + suffix
+	}
 }
@@ -0,0 +1,198 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/egosha7/site-go/internal/service/mock_service"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name        string
+		source      string
+		contains    []string
+		notContains []string
+	}{
+		{
+			name:     "Markdown",
+			source:   "# Уход за щенком\n\nКормить **четыре** раза в день.\n\n- [Корма](/articles/korma)",
+			contains: []string{"<h1>Уход за щенком</h1>", "<strong>четыре</strong>", `<a href="/articles/korma">Корма</a>`},
+		}, {
+			name:        "Raw HTML block",
+			source:      "<script>alert(1)</script>\n\nТекст",
+			contains:    []string{"<p>Текст</p>"},
+			notContains: []string{"<script", "alert(1)"},
+		}, {
+			name:        "Inline raw HTML",
+			source:      `Фото <img src="x" onerror="alert(1)"> щенка`,
+			notContains: []string{"<img", "onerror"},
+		}, {
+			name:        "Javascript link",
+			source:      "[Нажми](javascript:alert(1))",
+			contains:    []string{"Нажми</a>"},
+			notContains: []string{"javascript:"},
+		}, {
+			name:        "Javascript link in upper case",
+			source:      "[Нажми](JavaScript:alert(1))",
+			notContains: []string{"JavaScript:", "javascript:"},
+		}, {
+			name:        "Javascript autolink",
+			source:      "<javascript:alert(1)>",
+			notContains: []string{`href="javascript:`},
+		}, {
+			name:     "Safe link",
+			source:   "[Выставки](https://example.com/shows)",
+			contains: []string{`<a href="https://example.com/shows">Выставки</a>`},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				html, err := renderMarkdown(test.source)
+				assert.NoError(t, err)
+				for _, expected := range test.contains {
+					assert.Contains(t, string(html), expected)
+				}
+				for _, unexpected := range test.notContains {
+					assert.NotContains(t, string(html), unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestArticleSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		title    string
+		expected string
+	}{
+		{name: "Cyrillic", title: "Уход за щенком", expected: "uhod-za-schenkom"},
+		{name: "Letters ё, ъ and ь", title: "Ёжик и объявление о вязке", expected: "ezhik-i-obyavlenie-o-vyazke"},
+		{name: "Digits and punctuation", title: "Выставка 2024: итоги!", expected: "vystavka-2024-itogi"},
+		{name: "Latin and Cyrillic", title: "  Toy пудель — FAQ  ", expected: "toy-pudel-faq"},
+		{name: "Only digits", title: "2024", expected: "article-2024"},
+		{name: "No letters", title: "!!! ???", expected: "article"},
+		{name: "Unsupported alphabet", title: "小狗", expected: "article"},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				slug := articleSlug(test.title)
+				assert.Equal(t, test.expected, slug)
+				assert.Equal(t, slug, articleSlug(test.title))
+
+				_, err := ValidateArticleSlug(slug)
+				assert.NoError(t, err)
+			},
+		)
+	}
+
+	t.Run(
+		"Long title", func(t *testing.T) {
+			slug := articleSlug(strings.Repeat("Щенок шоколадного окраса ", 10))
+			assert.LessOrEqual(t, len(slug), articleSlugMaxLength)
+			assert.False(t, strings.HasSuffix(slug, "-"))
+			_, err := ValidateArticleSlug(slug)
+			assert.NoError(t, err)
+		},
+	)
+
+	t.Run(
+		"Different titles", func(t *testing.T) {
+			titles := []string{
+				"Щенки", "Щенок", "Щенки 2024", "Щенки 2025", "Вязка", "Вязки", "Цена щенка", "Цены щенков",
+				"Уход за щенком", "Уход за щенками", "Шоколадный окрас", "Чёрный окрас",
+			}
+			slugs := map[string]string{}
+			for _, title := range titles {
+				slug := articleSlug(title)
+				if other, ok := slugs[slug]; ok {
+					t.Errorf("titles %q and %q share slug %q", other, title, slug)
+				}
+				slugs[slug] = title
+			}
+		},
+	)
+}
+
+func TestHandler_freeArticleSlug(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices)
+
+	tests := []struct {
+		name          string
+		slug          string
+		articleID     int
+		mockBehavior  mockBehavior
+		expected      string
+		expectedError bool
+	}{
+		{
+			name: "Free slug",
+			slug: "uhod-za-schenkom",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ArticleGetBySlug("uhod-za-schenkom").Return(nil, nil)
+			},
+			expected: "uhod-za-schenkom",
+		}, {
+			name: "Slug taken twice",
+			slug: "uhod-za-schenkom",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ArticleGetBySlug("uhod-za-schenkom").Return(&domain.Article{ID: 1}, nil)
+				s.EXPECT().ArticleGetBySlug("uhod-za-schenkom-2").Return(&domain.Article{ID: 2}, nil)
+				s.EXPECT().ArticleGetBySlug("uhod-za-schenkom-3").Return(nil, nil)
+			},
+			expected: "uhod-za-schenkom-3",
+		}, {
+			name:      "Own slug kept",
+			slug:      "uhod-za-schenkom",
+			articleID: 1,
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ArticleGetBySlug("uhod-za-schenkom").Return(&domain.Article{ID: 1}, nil)
+			},
+			expected: "uhod-za-schenkom",
+		}, {
+			name: "Long slug shortened for number",
+			slug: strings.Repeat("a", articleSlugMaxLength-1) + "-b",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ArticleGetBySlug(strings.Repeat("a", articleSlugMaxLength-1)+"-b").
+					Return(&domain.Article{ID: 1}, nil)
+				s.EXPECT().ArticleGetBySlug(strings.Repeat("a", articleSlugMaxLength-2)+"-2").Return(nil, nil)
+			},
+			expected: strings.Repeat("a", articleSlugMaxLength-2) + "-2",
+		}, {
+			name: "Service failure",
+			slug: "uhod-za-schenkom",
+			mockBehavior: func(s *mock_service.MockServices) {
+				s.EXPECT().ArticleGetBySlug("uhod-za-schenkom").Return(nil, errors.New("service error"))
+			},
+			expectedError: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices)
+				handler := NewHandler(&service.Service{Services: mockServices}, zap.NewNop())
+
+				slug, err := handler.freeArticleSlug(test.slug, test.articleID)
+				if test.expectedError {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, slug)
+			},
+		)
+	}
+}
@@ -0,0 +1,510 @@
+package handlers
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// openAPIBase — спецификация OpenAPI 3 методов API: пути, параметры, ответы и схемы тел запросов
+// админки. Схемы ответов в ней не пишутся — они строятся по типам ответов API из openAPISchemaTypes,
+// поэтому не расходятся с тем, что API отдаёт на самом деле. Расхождение путей с маршрутами роутера
+// проверяет тест роутера.
+//
+//go:embed openapi.json
+var openAPIBase []byte
+
+// openAPISchemaTypes — типы ответов API и имена их схем в спецификации.
+var openAPISchemaTypes = map[string]reflect.Type{
+	"Money":           reflect.TypeOf(apiMoney{}),
+	"Pagination":      reflect.TypeOf(apiPagination{}),
+	"Puppy":           reflect.TypeOf(apiPuppy{}),
+	"PuppyDetails":    reflect.TypeOf(apiPuppyDetails{}),
+	"Dog":             reflect.TypeOf(apiDog{}),
+	"Review":          reflect.TypeOf(apiReview{}),
+	"Error":           reflect.TypeOf(apiErrorBody{}),
+	"ErrorDetail":     reflect.TypeOf(apiErrorDetail{}),
+	"Litter":          reflect.TypeOf(apiLitter{}),
+	"PlannedMating":   reflect.TypeOf(apiPlannedMating{}),
+	"Buyer":           reflect.TypeOf(apiBuyer{}),
+	"HealthEvent":     reflect.TypeOf(apiHealthEvent{}),
+	"Weight":          reflect.TypeOf(apiWeight{}),
+	"ShowResult":      reflect.TypeOf(apiShowResult{}),
+	"DictionaryEntry": reflect.TypeOf(apiDictionaryEntry{}),
+	"Article":         reflect.TypeOf(apiArticle{}),
+	"SiteSettings":    reflect.TypeOf(apiSiteSettings{}),
+}
+
+// openAPIListSchemas и openAPIItemSchemas — схемы ответов apiList и apiItem по имени схемы записи.
+var (
+	openAPIListSchemas = map[string]string{"PuppyList": "Puppy", "DogList": "Dog", "ReviewList": "Review"}
+	openAPIItemSchemas = map[string]string{
+		"PuppyItem":           "PuppyDetails",
+		"DogItem":             "Dog",
+		"ReviewItem":          "Review",
+		"LitterItem":          "Litter",
+		"PlannedMatingItem":   "PlannedMating",
+		"BuyerItem":           "Buyer",
+		"HealthEventItem":     "HealthEvent",
+		"WeightItem":          "Weight",
+		"ShowResultItem":      "ShowResult",
+		"DictionaryEntryItem": "DictionaryEntry",
+		"ArticleItem":         "Article",
+		"SiteSettingsItem":    "SiteSettings",
+	}
+)
+
+var (
+	openAPIOnce sync.Once
+	openAPIJSON []byte
+	openAPIErr  error
+)
+
+// OpenAPISpec возвращает спецификацию OpenAPI 3 API в JSON.
+func OpenAPISpec() ([]byte, error) {
+	openAPIOnce.Do(
+		func() {
+			openAPIJSON, openAPIErr = buildOpenAPISpec()
+		},
+	)
+	return openAPIJSON, openAPIErr
+}
+
+// buildOpenAPISpec дополняет спецификацию схемами ответов и значениями параметров из справочников domain.
+func buildOpenAPISpec() ([]byte, error) {
+	var spec map[string]interface{}
+	if err := json.Unmarshal(openAPIBase, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse openapi.json: %w", err)
+	}
+
+	components, err := openAPIObject(spec, "components")
+	if err != nil {
+		return nil, err
+	}
+	// Схемы тел запросов написаны в openapi.json, схемы ответов добавляются к ним
+	schemas, ok := components["schemas"].(map[string]interface{})
+	if !ok {
+		schemas = map[string]interface{}{}
+		components["schemas"] = schemas
+	}
+	for name, schema := range openAPISchemas() {
+		if _, ok := schemas[name]; ok {
+			return nil, fmt.Errorf("openapi.json: schema %s is built from API types", name)
+		}
+		schemas[name] = schema
+	}
+
+	// Значения, которые принимает и отдаёт API, берутся из тех же списков, что и на сайте
+	statuses := append(append([]string{}, publicPuppyStatuses...), archivePuppyStatuses...)
+	enums := []struct {
+		path   []string
+		values []string
+	}{
+		{[]string{"parameters", "sort", "schema"}, domain.PuppySorts},
+		{[]string{"parameters", "status", "schema", "items"}, statuses},
+	}
+	for _, enum := range enums {
+		schema, err := openAPIObject(components, enum.path...)
+		if err != nil {
+			return nil, err
+		}
+		schema["enum"] = enum.values
+	}
+	openAPISchemaProperty(schemas, "Puppy", "status")["enum"] = statuses
+
+	// Админка видит щенков в любом статусе
+	allStatuses := openAPIKeys(domain.PuppyStatusTitles)
+	matingStatuses := openAPIKeys(domain.MatingStatusTitles)
+	healthEventTypes := openAPIKeys(domain.HealthEventTypeTitles)
+	dictionaryKinds := openAPIKeys(domain.DictionaryTitles)
+	properties := []struct {
+		schema string
+		name   string
+		values []string
+	}{
+		{"PuppyDetails", "status", allStatuses},
+		{"PuppyStatusForm", "status", allStatuses},
+		{"PuppyForm", "currency", openAPIKeys(domain.CurrencySymbols)},
+		{"PlannedMating", "status", matingStatuses},
+		{"PlannedMatingForm", "status", matingStatuses},
+		{"HealthEvent", "type", healthEventTypes},
+		{"HealthEventForm", "type", healthEventTypes},
+		{"ShowResult", "grade", domain.ShowGrades},
+		{"ShowResultForm", "grade", domain.ShowGrades},
+		{"DictionaryEntry", "kind", dictionaryKinds},
+		{"DictionaryEntryForm", "kind", dictionaryKinds},
+		{"DictionaryDeleteForm", "kind", dictionaryKinds},
+	}
+	for _, property := range properties {
+		openAPISchemaProperty(schemas, property.schema, property.name)["enum"] = property.values
+	}
+	for _, schema := range []string{"ShowResult", "ShowResultForm"} {
+		openAPISchemaProperty(schemas, schema, "titles")["items"].(map[string]interface{})["enum"] = domain.ShowTitles
+	}
+
+	codes := make([]string, 0, len(apiErrorCodes))
+	for _, code := range apiErrorCodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	openAPISchemaProperty(schemas, "ErrorDetail", "code")["enum"] = codes
+
+	return json.MarshalIndent(spec, "", "  ")
+}
+
+// openAPIKeys возвращает значения справочника domain по алфавиту.
+func openAPIKeys(titles map[string]string) []string {
+	keys := make([]string, 0, len(titles))
+	for key := range titles {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// openAPIObject возвращает вложенный объект спецификации по цепочке ключей.
+func openAPIObject(object map[string]interface{}, keys ...string) (map[string]interface{}, error) {
+	for _, key := range keys {
+		next, ok := object[key].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("openapi.json: missing object %s", strings.Join(keys, "."))
+		}
+		object = next
+	}
+	return object, nil
+}
+
+// openAPISchemaProperty возвращает схему поля name схемы schema.
+func openAPISchemaProperty(schemas map[string]interface{}, schema, name string) map[string]interface{} {
+	properties := schemas[schema].(map[string]interface{})["properties"].(map[string]interface{})
+	return properties[name].(map[string]interface{})
+}
+
+// openAPISchemas строит схемы ответов API по их типам.
+func openAPISchemas() map[string]interface{} {
+	names := make(map[reflect.Type]string, len(openAPISchemaTypes))
+	for name, t := range openAPISchemaTypes {
+		names[t] = name
+	}
+
+	schemas := make(map[string]interface{}, len(openAPISchemaTypes)+len(openAPIListSchemas)+len(openAPIItemSchemas))
+	for name, t := range openAPISchemaTypes {
+		schemas[name] = openAPITypeSchema(t, names)
+	}
+	for name, item := range openAPIListSchemas {
+		schemas[name] = map[string]interface{}{
+			"type":     "object",
+			"required": []string{"data", "pagination"},
+			"properties": map[string]interface{}{
+				"data":       map[string]interface{}{"type": "array", "items": openAPIRef(item)},
+				"pagination": openAPIRef("Pagination"),
+			},
+		}
+	}
+	for name, item := range openAPIItemSchemas {
+		schemas[name] = map[string]interface{}{
+			"type":       "object",
+			"required":   []string{"data"},
+			"properties": map[string]interface{}{"data": openAPIRef(item)},
+		}
+	}
+	return schemas
+}
+
+// openAPIRef возвращает ссылку на схему спецификации.
+func openAPIRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+// openAPISchemaOf возвращает ссылку на схему типа, если она есть в спецификации, иначе саму схему.
+func openAPISchemaOf(t reflect.Type, names map[reflect.Type]string) map[string]interface{} {
+	if name, ok := names[t]; ok {
+		return openAPIRef(name)
+	}
+	return openAPITypeSchema(t, names)
+}
+
+// openAPITypeSchema строит схему типа по его полям и тегам json.
+func openAPITypeSchema(t reflect.Type, names map[reflect.Type]string) map[string]interface{} {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return openAPISchemaOf(t.Elem(), names)
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int32:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": openAPISchemaOf(t.Elem(), names)}
+	case reflect.Struct:
+		properties := map[string]interface{}{}
+		required := []string{}
+		openAPIStructFields(t, names, properties, &required)
+		return map[string]interface{}{"type": "object", "required": required, "properties": properties}
+	}
+	return map[string]interface{}{}
+}
+
+// openAPIStructFields добавляет в схему поля структуры. Поля встроенной структуры становятся полями
+// самой структуры, как и в JSON. Обязательны поля без omitempty.
+func openAPIStructFields(
+	t reflect.Type, names map[reflect.Type]string, properties map[string]interface{}, required *[]string,
+) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if field.Anonymous && tag == "" && field.Type.Kind() == reflect.Struct {
+			openAPIStructFields(field.Type, names, properties, required)
+			continue
+		}
+		if !field.IsExported() || tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = openAPISchemaOf(field.Type, names)
+		if !strings.Contains(options, "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+}
+
+// openAPIDocument — часть спецификации, которая выводится на странице документации API.
+type openAPIDocument struct {
+	Info struct {
+		Title       string `json:"title"`
+		Version     string `json:"version"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components struct {
+		Parameters map[string]openAPIParameter `json:"parameters"`
+		Responses  map[string]openAPIResponse  `json:"responses"`
+		Schemas    map[string]openAPISchema    `json:"schemas"`
+	} `json:"components"`
+}
+
+// openAPIOperation — метод API.
+type openAPIOperation struct {
+	Summary     string                     `json:"summary"`
+	Description string                     `json:"description"`
+	Parameters  []openAPIParameter         `json:"parameters"`
+	RequestBody *openAPIRequestBody        `json:"requestBody"`
+	Security    []map[string][]string      `json:"security"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+// openAPIRequestBody — тело запроса метода API.
+type openAPIRequestBody struct {
+	Content map[string]struct {
+		Schema openAPISchema `json:"schema"`
+	} `json:"content"`
+}
+
+// openAPIParameter — параметр метода API или ссылка на него.
+type openAPIParameter struct {
+	Ref         string        `json:"$ref"`
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description"`
+	Required    bool          `json:"required"`
+	Schema      openAPISchema `json:"schema"`
+}
+
+// openAPIResponse — ответ метода API или ссылка на него.
+type openAPIResponse struct {
+	Ref         string `json:"$ref"`
+	Description string `json:"description"`
+	Content     map[string]struct {
+		Schema openAPISchema `json:"schema"`
+	} `json:"content"`
+}
+
+// openAPISchema — схема значения или ссылка на неё.
+type openAPISchema struct {
+	Ref         string                   `json:"$ref"`
+	Type        string                   `json:"type"`
+	Format      string                   `json:"format"`
+	Description string                   `json:"description"`
+	Items       *openAPISchema           `json:"items"`
+	Enum        []interface{}            `json:"enum"`
+	Required    []string                 `json:"required"`
+	Properties  map[string]openAPISchema `json:"properties"`
+}
+
+// TypeName возвращает тип значения для документации: имя схемы, тип или массив из них.
+func (s openAPISchema) TypeName() string {
+	if s.Ref != "" {
+		return s.Ref[strings.LastIndex(s.Ref, "/")+1:]
+	}
+	if s.Type == "array" && s.Items != nil {
+		return s.Items.TypeName() + "[]"
+	}
+	if s.Format == "binary" {
+		return "file"
+	}
+	return s.Type
+}
+
+// EnumLine возвращает допустимые значения через запятую.
+func (s openAPISchema) EnumLine() string {
+	if s.Type == "array" && s.Items != nil {
+		return s.Items.EnumLine()
+	}
+	values := make([]string, 0, len(s.Enum))
+	for _, value := range s.Enum {
+		values = append(values, fmt.Sprint(value))
+	}
+	return strings.Join(values, ", ")
+}
+
+// apiDocsOperation — метод API на странице документации.
+type apiDocsOperation struct {
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Parameters  []openAPIParameter
+	// Request — схема тела запроса, Auth — нужен ли токен API.
+	Request   string
+	Auth      bool
+	Responses []apiDocsResponse
+}
+
+// apiDocsResponse — ответ метода API на странице документации.
+type apiDocsResponse struct {
+	Status      string
+	Description string
+	Schema      string
+}
+
+// apiDocsSchema — схема ответа на странице документации.
+type apiDocsSchema struct {
+	Name       string
+	Properties []apiDocsProperty
+}
+
+// apiDocsProperty — поле схемы ответа на странице документации.
+type apiDocsProperty struct {
+	Name     string
+	Required bool
+	Schema   openAPISchema
+}
+
+// openAPIMethods — HTTP-методы в том порядке, в каком они выводятся в документации.
+var openAPIMethods = []string{"get", "post", "put", "patch", "delete"}
+
+// apiDocs разбирает спецификацию для страницы документации: методы по пути и HTTP-методу,
+// схемы по имени. Ссылки на параметры и ответы заменяются ими самими.
+func apiDocs(spec []byte) (*openAPIDocument, []apiDocsOperation, []apiDocsSchema, error) {
+	var document openAPIDocument
+	if err := json.Unmarshal(spec, &document); err != nil {
+		return nil, nil, nil, err
+	}
+
+	paths := make([]string, 0, len(document.Paths))
+	for path := range document.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	operations := []apiDocsOperation{}
+	for _, path := range paths {
+		for _, method := range openAPIMethods {
+			operation, ok := document.Paths[path][method]
+			if !ok {
+				continue
+			}
+
+			parameters := make([]openAPIParameter, 0, len(operation.Parameters))
+			for _, parameter := range operation.Parameters {
+				if parameter.Ref != "" {
+					parameter = document.Components.Parameters[parameter.Ref[strings.LastIndex(parameter.Ref, "/")+1:]]
+				}
+				parameters = append(parameters, parameter)
+			}
+
+			statuses := make([]string, 0, len(operation.Responses))
+			for status := range operation.Responses {
+				statuses = append(statuses, status)
+			}
+			sort.Strings(statuses)
+			responses := make([]apiDocsResponse, 0, len(statuses))
+			for _, status := range statuses {
+				response := operation.Responses[status]
+				if response.Ref != "" {
+					response = document.Components.Responses[response.Ref[strings.LastIndex(response.Ref, "/")+1:]]
+				}
+				docsResponse := apiDocsResponse{Status: status, Description: response.Description}
+				if content, ok := response.Content["application/json"]; ok {
+					docsResponse.Schema = content.Schema.TypeName()
+				}
+				responses = append(responses, docsResponse)
+			}
+
+			request := ""
+			if operation.RequestBody != nil {
+				if content, ok := operation.RequestBody.Content["application/json"]; ok {
+					request = content.Schema.TypeName()
+				}
+			}
+
+			operations = append(
+				operations, apiDocsOperation{
+					Method:      strings.ToUpper(method),
+					Path:        path,
+					Summary:     operation.Summary,
+					Description: operation.Description,
+					Parameters:  parameters,
+					Request:     request,
+					Auth:        len(operation.Security) > 0,
+					Responses:   responses,
+				},
+			)
+		}
+	}
+
+	names := make([]string, 0, len(document.Components.Schemas))
+	for name := range document.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	schemas := make([]apiDocsSchema, 0, len(names))
+	for _, name := range names {
+		schema := document.Components.Schemas[name]
+		required := map[string]bool{}
+		for _, property := range schema.Required {
+			required[property] = true
+		}
+		properties := make([]string, 0, len(schema.Properties))
+		for property := range schema.Properties {
+			properties = append(properties, property)
+		}
+		sort.Strings(properties)
+
+		docsSchema := apiDocsSchema{Name: name}
+		for _, property := range properties {
+			docsSchema.Properties = append(
+				docsSchema.Properties, apiDocsProperty{
+					Name: property, Required: required[property], Schema: schema.Properties[property],
+				},
+			)
+		}
+		schemas = append(schemas, docsSchema)
+	}
+
+	return &document, operations, schemas, nil
+}
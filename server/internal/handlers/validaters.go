@@ -2,8 +2,14 @@ package handlers
 
 import (
 	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
 	"html"
+	"net/mail"
+	"net/url"
+	"regexp"
 	"strconv"
+	"strings"
+	"time"
 	"unicode"
 )
 
@@ -23,13 +29,25 @@ func ValidatePage(pageStr string) (int, error) {
 	return page, nil
 }
 
-// ValidateGender функция для валидации параметра gender
-func ValidateGender(genders []string) ([]string, error) {
-	if len(genders) < 1 {
-		validatedGenders := []string{}
-		return validatedGenders, nil
+// ValidatePageAfter функция для валидации параметра after — ID последней записи предыдущей страницы.
+// Пустой параметр означает, что страница выбирается по номеру.
+func ValidatePageAfter(afterStr string) (int, error) {
+	if afterStr == "" {
+		return 0, nil
+	}
+	after, err := strconv.Atoi(afterStr)
+	if err != nil || after < 1 {
+		return 0, fmt.Errorf("invalid after value: %s", afterStr)
+	}
+	return after, nil
+}
+
+// ValidateGender функция для валидации параметра gender по справочнику пола
+func ValidateGender(genders, allowed []string) ([]string, error) {
+	validGenders := map[string]bool{}
+	for _, gender := range allowed {
+		validGenders[gender] = true
 	}
-	validGenders := map[string]bool{"Кобель": true, "Сука": true}
 	validatedGenders := []string{}
 	for _, gender := range genders {
 		if validGenders[gender] {
@@ -52,17 +70,22 @@ func ValidateReadyToMove(readyToMove string) (string, error) {
 	return "", fmt.Errorf("invalid readyToMove value")
 }
 
-// ValidateChocolates функция для валидации параметра chocolates
-func ValidateChocolates(chocolates []string) ([]string, error) {
-	validChocolates := map[string]bool{
-		"Классический":     true,
-		"Шоколадный":       true,
-		"Черный":           true,
-		"Биро":             true,
-		"Бивер":            true,
-		"Голддаст":         true,
-		"Черный мерле":     true,
-		"Шоколадный мерле": true,
+// ValidateArchived функция для валидации параметра archived. Пустой параметр означает false.
+func ValidateArchived(archived string) (bool, error) {
+	switch archived {
+	case "", "false":
+		return false, nil
+	case "true":
+		return true, nil
+	}
+	return false, fmt.Errorf("invalid archived value: %s", archived)
+}
+
+// ValidateChocolates функция для валидации параметра chocolates по справочнику окрасов
+func ValidateChocolates(chocolates, allowed []string) ([]string, error) {
+	validChocolates := map[string]bool{}
+	for _, color := range allowed {
+		validChocolates[color] = true
 	}
 	validatedChocolates := []string{}
 	for _, chocolate := range chocolates {
@@ -94,3 +117,457 @@ func isValidID(id string, maxID int) bool {
 
 	return num <= maxID
 }
+
+// ValidateGenotype функция для валидации генотипа по локусу окраса (B, D или M)
+func ValidateGenotype(locus, genotype string) (string, error) {
+	if genotype == "" {
+		return "", nil
+	}
+	upper, lower := strings.ToUpper(locus), strings.ToLower(locus)
+	validGenotypes := map[string]bool{
+		upper + upper: true,
+		upper + lower: true,
+		lower + lower: true,
+	}
+	if !validGenotypes[genotype] {
+		return "", fmt.Errorf("invalid genotype for locus %s: %s", locus, genotype)
+	}
+	return genotype, nil
+}
+
+// ValidateMatingStatus функция для валидации статуса запланированной вязки
+func ValidateMatingStatus(status string) (string, error) {
+	if status == "" {
+		return domain.MatingStatusPlanned, nil
+	}
+	if _, ok := domain.MatingStatusTitles[status]; !ok {
+		return "", fmt.Errorf("invalid mating status: %s", status)
+	}
+	return status, nil
+}
+
+// ValidatePuppyStatuses функция для валидации параметра status среди допустимых для раздела статусов.
+// Если статусы не выбраны, возвращаются все допустимые.
+func ValidatePuppyStatuses(statuses, allowed []string) ([]string, error) {
+	if len(statuses) < 1 {
+		return allowed, nil
+	}
+	validStatuses := map[string]bool{}
+	for _, status := range allowed {
+		validStatuses[status] = true
+	}
+	validatedStatuses := []string{}
+	for _, status := range statuses {
+		if validStatuses[status] {
+			validatedStatuses = append(validatedStatuses, status)
+		} else {
+			return nil, fmt.Errorf("invalid puppy status: %s", status)
+		}
+	}
+	return validatedStatuses, nil
+}
+
+// phonePattern — телефон в формате маски ввода на сайте: +7 (999) 999-99-99.
+var phonePattern = regexp.MustCompile(`^\+7 \(\d{3}\) \d{3}-\d{2}-\d{2}$`)
+
+// ValidatePhones функция для валидации телефонов покупателя.
+// Пустые поля и незаполненная маска пропускаются, повторы убираются.
+func ValidatePhones(phones []string) ([]string, error) {
+	seen := map[string]bool{}
+	validatedPhones := []string{}
+	for _, phone := range phones {
+		phone = strings.TrimSpace(phone)
+		if phone == "" || phone == "+7" || seen[phone] {
+			continue
+		}
+		if !phonePattern.MatchString(phone) {
+			return nil, fmt.Errorf("invalid phone: %s", phone)
+		}
+		seen[phone] = true
+		validatedPhones = append(validatedPhones, phone)
+	}
+	return validatedPhones, nil
+}
+
+// ValidateEmail функция для валидации почты покупателя. Почта необязательна.
+func ValidateEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return "", nil
+	}
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", fmt.Errorf("invalid email: %s", email)
+	}
+	return email, nil
+}
+
+// ValidateBudget функция для валидации бюджета в рублях. Пустой бюджет означает любую цену.
+func ValidateBudget(budget string) (int, error) {
+	budget = strings.ReplaceAll(strings.TrimSpace(budget), " ", "")
+	if budget == "" {
+		return 0, nil
+	}
+	rubles, err := strconv.Atoi(budget)
+	if err != nil || rubles < 0 {
+		return 0, fmt.Errorf("invalid budget: %s", budget)
+	}
+	return rubles, nil
+}
+
+// ValidateTimeframe функция для валидации срока, к которому нужен щенок. Любая из дат может быть пустой.
+func ValidateTimeframe(from, until string) (time.Time, time.Time, error) {
+	var wantedFrom, wantedUntil time.Time
+	var err error
+	if from != "" {
+		wantedFrom, err = time.Parse("2006-01-02", from)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid timeframe start: %s", from)
+		}
+	}
+	if until != "" {
+		wantedUntil, err = time.Parse("2006-01-02", until)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid timeframe end: %s", until)
+		}
+	}
+	if !wantedFrom.IsZero() && !wantedUntil.IsZero() && wantedUntil.Before(wantedFrom) {
+		return time.Time{}, time.Time{}, fmt.Errorf("timeframe ends before it starts")
+	}
+	return wantedFrom, wantedUntil, nil
+}
+
+// ValidateHealthEventType функция для валидации типа события здоровья
+func ValidateHealthEventType(eventType string) (string, error) {
+	if _, ok := domain.HealthEventTypeTitles[eventType]; !ok {
+		return "", fmt.Errorf("invalid health event type: %s", eventType)
+	}
+	return eventType, nil
+}
+
+// ValidateWeight функция для валидации веса щенка в граммах
+func ValidateWeight(grams string) (int, error) {
+	weight, err := strconv.Atoi(strings.TrimSpace(grams))
+	if err != nil || weight <= 0 || weight > 100000 {
+		return 0, fmt.Errorf("invalid weight: %s", grams)
+	}
+	return weight, nil
+}
+
+// ValidateShowGrade функция для валидации выставочной оценки. Оценка необязательна.
+func ValidateShowGrade(grade string) (string, error) {
+	if grade == "" {
+		return "", nil
+	}
+	for _, valid := range domain.ShowGrades {
+		if grade == valid {
+			return grade, nil
+		}
+	}
+	return "", fmt.Errorf("invalid show grade: %s", grade)
+}
+
+// ValidateShowTitles функция для валидации титулов, полученных на выставке
+func ValidateShowTitles(titles []string) ([]string, error) {
+	validTitles := map[string]bool{}
+	for _, title := range domain.ShowTitles {
+		validTitles[title] = true
+	}
+	validatedTitles := []string{}
+	for _, title := range titles {
+		if validTitles[title] {
+			validatedTitles = append(validatedTitles, title)
+		} else {
+			return nil, fmt.Errorf("invalid show title: %s", title)
+		}
+	}
+	return validatedTitles, nil
+}
+
+// ValidatePrice функция для валидации цены щенка. Без валюты цена считается в рублях.
+// Пустая цена допустима: щенок показывается с ценой по запросу.
+func ValidatePrice(price, currency string) (domain.Money, error) {
+	if currency == "" {
+		currency = domain.CurrencyRUB
+	}
+	if strings.TrimSpace(price) == "" {
+		if _, ok := domain.CurrencySymbols[currency]; !ok {
+			return domain.Money{}, fmt.Errorf("unknown currency: %s", currency)
+		}
+		return domain.Money{Currency: currency}, nil
+	}
+	return domain.ParseMoney(price, currency)
+}
+
+// ValidateDateBirth функция для валидации даты рождения щенка. Пустая дата допустима:
+// у щенка из помёта её подставит помёт.
+func ValidateDateBirth(date string) (time.Time, error) {
+	date = strings.TrimSpace(date)
+	if date == "" {
+		return time.Time{}, nil
+	}
+	dateBirth, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid birth date: %s", date)
+	}
+	if dateBirth.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("birth date is in the future: %s", date)
+	}
+	return dateBirth, nil
+}
+
+// ValidateReadyOut функция для валидации ручной отметки готовности к переезду.
+// Пустое значение означает, что готовность считается автоматически.
+func ValidateReadyOut(readyOut string) (*bool, error) {
+	if readyOut == "" {
+		return nil, nil
+	}
+	ready, err := strconv.ParseBool(readyOut)
+	if err != nil {
+		return nil, fmt.Errorf("invalid readyOut value: %s", readyOut)
+	}
+	return &ready, nil
+}
+
+// ValidateDictionaryKind функция для валидации названия справочника
+func ValidateDictionaryKind(kind string) (string, error) {
+	if _, ok := domain.DictionaryTitles[kind]; !ok {
+		return "", fmt.Errorf("invalid dictionary: %s", kind)
+	}
+	return kind, nil
+}
+
+// ValidateDictionaryValue функция для валидации значения записи справочника
+func ValidateDictionaryValue(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || len([]rune(value)) > 64 {
+		return "", fmt.Errorf("invalid dictionary value: %q", value)
+	}
+	return value, nil
+}
+
+// breedSlugPattern — значение породы, оно же адрес страницы породы: латиница, цифры и дефисы.
+var breedSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ValidateBreedSlug функция для валидации значения породы, которое используется в адресе страницы породы
+func ValidateBreedSlug(slug string) (string, error) {
+	if !breedSlugPattern.MatchString(slug) {
+		return "", fmt.Errorf("invalid breed slug: %s", slug)
+	}
+	return slug, nil
+}
+
+// searchQueryMaxLength — наибольшая длина поискового запроса в символах.
+const searchQueryMaxLength = 100
+
+// ValidateSearchQuery функция для валидации поискового запроса. Пустой запрос допустим:
+// тогда показывается только форма поиска.
+func ValidateSearchQuery(query string) (string, error) {
+	query = strings.Join(strings.Fields(query), " ")
+	if len([]rune(query)) > searchQueryMaxLength {
+		return "", fmt.Errorf("search query is too long: %d characters", len([]rune(query)))
+	}
+	return query, nil
+}
+
+// ValidatePuppySort функция для валидации порядка вывода щенков. По умолчанию сначала новые.
+func ValidatePuppySort(sort string) (string, error) {
+	if sort == "" {
+		return domain.PuppySortNewest, nil
+	}
+	if _, ok := domain.PuppySortTitles[sort]; !ok {
+		return "", fmt.Errorf("invalid sort: %s", sort)
+	}
+	return sort, nil
+}
+
+// ValidatePriceRange функция для валидации диапазона цены в рублях. Любая из границ может быть пустой.
+func ValidatePriceRange(from, to string) (int, int, error) {
+	priceFrom, err := ValidateBudget(from)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid price from: %s", from)
+	}
+	priceTo, err := ValidateBudget(to)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid price to: %s", to)
+	}
+	if priceTo != 0 && priceTo < priceFrom {
+		return 0, 0, fmt.Errorf("price range ends before it starts")
+	}
+	return priceFrom, priceTo, nil
+}
+
+// puppyAgeMaxWeeks — наибольший возраст щенка в неделях, который можно указать в фильтре.
+const puppyAgeMaxWeeks = 104
+
+// ValidateAgeRange функция для валидации диапазона возраста щенка в неделях. Любая из границ может быть пустой.
+func ValidateAgeRange(from, to string) (int, int, error) {
+	parseWeeks := func(weeks string) (int, error) {
+		weeks = strings.TrimSpace(weeks)
+		if weeks == "" {
+			return 0, nil
+		}
+		value, err := strconv.Atoi(weeks)
+		if err != nil || value < 0 || value > puppyAgeMaxWeeks {
+			return 0, fmt.Errorf("invalid age: %s", weeks)
+		}
+		return value, nil
+	}
+
+	ageFrom, err := parseWeeks(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	ageTo, err := parseWeeks(to)
+	if err != nil {
+		return 0, 0, err
+	}
+	if ageTo != 0 && ageTo < ageFrom {
+		return 0, 0, fmt.Errorf("age range ends before it starts")
+	}
+	return ageFrom, ageTo, nil
+}
+
+// cityPattern — название города: буквы, пробелы, дефисы и точки, например "Санкт-Петербург" или "пос. Мирный".
+var cityPattern = regexp.MustCompile(`^\p{L}[\p{L} .\-]*$`)
+
+// ValidateCity функция для валидации названия города в фильтре. Пустой город означает любой.
+func ValidateCity(city string) (string, error) {
+	city = strings.Join(strings.Fields(city), " ")
+	if city == "" {
+		return "", nil
+	}
+	if len([]rune(city)) > 64 || !cityPattern.MatchString(city) {
+		return "", fmt.Errorf("invalid city: %s", city)
+	}
+	return city, nil
+}
+
+// ValidateArticleTitle функция для валидации заголовка статьи
+func ValidateArticleTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" || len([]rune(title)) > 200 {
+		return "", fmt.Errorf("invalid article title: %q", title)
+	}
+	return title, nil
+}
+
+// articleSlugMaxLength — наибольшая длина адреса статьи.
+const articleSlugMaxLength = 100
+
+// ValidateArticleSlug функция для валидации адреса статьи: латиница, цифры и дефисы, как у породы.
+// Адрес из одних цифр не допускается: по такому адресу статья ищется по ID.
+func ValidateArticleSlug(slug string) (string, error) {
+	if len(slug) > articleSlugMaxLength || !breedSlugPattern.MatchString(slug) {
+		return "", fmt.Errorf("invalid article slug: %s", slug)
+	}
+	if _, err := strconv.Atoi(slug); err == nil {
+		return "", fmt.Errorf("article slug must not be a number: %s", slug)
+	}
+	return slug, nil
+}
+
+// articleTagPattern — тег статьи: буквы, цифры, пробелы и дефисы, например "уход" или "выставки 2024".
+var articleTagPattern = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{N} \-]*$`)
+
+// ValidateArticleTag функция для валидации тега статьи. Тег приводится к нижнему регистру,
+// пустой тег в фильтре означает любой.
+func ValidateArticleTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.Join(strings.Fields(tag), " "))
+	if tag == "" {
+		return "", nil
+	}
+	if len([]rune(tag)) > 32 || !articleTagPattern.MatchString(tag) {
+		return "", fmt.Errorf("invalid article tag: %s", tag)
+	}
+	return tag, nil
+}
+
+// articleTagsMax — наибольшее количество тегов у статьи.
+const articleTagsMax = 10
+
+// ValidateArticleTags функция для валидации тегов статьи, перечисленных через запятую. Повторы отбрасываются.
+func ValidateArticleTags(tags string) ([]string, error) {
+	result := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, raw := range strings.Split(tags, ",") {
+		tag, err := ValidateArticleTag(raw)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[tag]; tag == "" || ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	if len(result) > articleTagsMax {
+		return nil, fmt.Errorf("too many article tags: %d", len(result))
+	}
+	return result, nil
+}
+
+// sitePhonePattern — телефон в контактах сайта в свободной записи, например "+7 961 367 85-83".
+var sitePhonePattern = regexp.MustCompile(`^\+?[0-9][0-9 ()\-]{4,24}$`)
+
+// ValidateSitePhone функция для валидации телефона в контактах сайта. Пустой телефон не выводится.
+func ValidateSitePhone(phone string) (string, error) {
+	phone = strings.Join(strings.Fields(phone), " ")
+	if phone == "" {
+		return "", nil
+	}
+	if !sitePhonePattern.MatchString(phone) {
+		return "", fmt.Errorf("invalid site phone: %s", phone)
+	}
+	return phone, nil
+}
+
+// ValidateSiteLink функция для валидации ссылки на соцсеть или мессенджер: только http и https.
+// Пустая ссылка не выводится.
+func ValidateSiteLink(link string) (string, error) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return "", nil
+	}
+	parsed, err := url.Parse(link)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || len(link) > 255 {
+		return "", fmt.Errorf("invalid site link: %s", link)
+	}
+	return link, nil
+}
+
+// ValidateMapCoordinates функция для валидации координат точки на карте.
+// Если обе координаты пустые, карта не выводится.
+func ValidateMapCoordinates(lat, lng string) (float64, float64, error) {
+	lat, lng = strings.TrimSpace(lat), strings.TrimSpace(lng)
+	if lat == "" && lng == "" {
+		return 0, 0, nil
+	}
+	latitude, err := strconv.ParseFloat(strings.ReplaceAll(lat, ",", "."), 64)
+	if err != nil || latitude < -90 || latitude > 90 {
+		return 0, 0, fmt.Errorf("invalid latitude: %s", lat)
+	}
+	longitude, err := strconv.ParseFloat(strings.ReplaceAll(lng, ",", "."), 64)
+	if err != nil || longitude < -180 || longitude > 180 {
+		return 0, 0, fmt.Errorf("invalid longitude: %s", lng)
+	}
+	return latitude, longitude, nil
+}
+
+// ValidateSiteText функция для валидации текстовой настройки сайта: адреса, часов работы, футера и SEO.
+func ValidateSiteText(text string, maxLength int) (string, error) {
+	text = strings.TrimSpace(text)
+	if len([]rune(text)) > maxLength {
+		return "", fmt.Errorf("site setting is longer than %d characters", maxLength)
+	}
+	return text, nil
+}
+
+// ValidateAPITokenName функция для валидации названия токена API
+func ValidateAPITokenName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > 64 {
+		return "", fmt.Errorf("invalid API token name: %q", name)
+	}
+	return name, nil
+}
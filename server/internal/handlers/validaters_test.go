@@ -0,0 +1,41 @@
+package handlers_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/handlers"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidatePrice(t *testing.T) {
+	tests := []struct {
+		name          string
+		price         string
+		currency      string
+		expectedPrice domain.Money
+		expectError   bool
+	}{
+		{name: "Rubles by default", price: "120 000", expectedPrice: domain.Money{Amount: 12000000, Currency: domain.CurrencyRUB}},
+		{name: "Euro", price: "1500,50", currency: domain.CurrencyEUR, expectedPrice: domain.Money{Amount: 150050, Currency: domain.CurrencyEUR}},
+		// Пустая цена — цена по запросу
+		{name: "Empty price", price: "", expectedPrice: domain.Money{Currency: domain.CurrencyRUB}},
+		{name: "Blank price in euro", price: "  ", currency: domain.CurrencyEUR, expectedPrice: domain.Money{Currency: domain.CurrencyEUR}},
+		{name: "Empty price unknown currency", price: "", currency: "GBP", expectError: true},
+		{name: "Negative price", price: "-100", expectError: true},
+		{name: "Not a price", price: "дорого", expectError: true},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				price, err := handlers.ValidatePrice(test.price, test.currency)
+				if test.expectError {
+					assert.Error(t, err)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, test.expectedPrice, price)
+				assert.Equal(t, test.price == "" || test.price == "  ", price.IsZero())
+			},
+		)
+	}
+}
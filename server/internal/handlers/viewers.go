@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"github.com/Masterminds/sprig/v3"
 	"github.com/egosha7/site-go/internal/domain"
 	"github.com/go-chi/chi"
 	"github.com/jackc/pgx/v4"
@@ -59,7 +58,8 @@ func (h *Handler) AuthView(w http.ResponseWriter, r *http.Request) {
 }
 
 // PuppiesView обрабатывает запрос на отображение страницы с щенками.
-func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived bool) {
+// Непустая breed ограничивает список щенками одной породы.
+func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived bool, breed string) {
 	lastUrlQuery := r.URL.RequestURI
 	println(lastUrlQuery)
 
@@ -73,34 +73,49 @@ func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived b
 		return
 	}
 
-	// Валидация параметров поиска
-	chocolates := r.URL.Query()["chocolate"]
-	validatedChocolates, err := ValidateChocolates(chocolates)
+	dictionaries, err := h.Services.DictionariesGet()
 	if err != nil {
-		h.logger.Error("Ошибка при обработке параметра chocolate", zap.Error(err))
-		http.Error(w, "Ошибка при обработке параметра chocolate", http.StatusBadRequest)
+		h.logger.Error("Ошибка при получении справочников", zap.Error(err))
+		http.Error(w, "Ошибка при получении справочников", http.StatusInternalServerError)
 		return
 	}
 
-	genders := r.URL.Query()["gender"]
-	validatedGenders, err := ValidateGender(genders)
-	if err != nil {
-		h.logger.Error("Ошибка при обработке параметра gender", zap.Error(err))
-		http.Error(w, "Ошибка при обработке параметра gender", http.StatusBadRequest)
-		return
+	// Страница породы открывается только для породы из справочника
+	basePath := "/puppies"
+	var breedEntry domain.DictionaryEntry
+	if breed != "" {
+		var ok bool
+		breedEntry, ok = dictionaries.BreedGet(breed)
+		if !ok {
+			h.logger.Error("Неизвестная порода", zap.String("breed", breed))
+			h.NotFoundView(w, r)
+			return
+		}
+		basePath = "/breeds/" + breed + "/puppies"
 	}
+	colors := dictionaries.ColorsOf(breed)
 
-	readyToMoveStr := r.URL.Query().Get("readyToMove")
-	readyToMove, err := ValidateReadyToMove(readyToMoveStr)
+	// Валидация параметров поиска
+	chocolates := r.URL.Query()["chocolate"]
+	genders := r.URL.Query()["gender"]
+	statuses := r.URL.Query()["status"]
+	allowedStatuses := publicPuppyStatuses
+	if archived {
+		allowedStatuses = archivePuppyStatuses
+	}
+	query, message, err := parsePuppyQuery(r.URL.Query(), colors, dictionaries.Sexes, allowedStatuses)
 	if err != nil {
-		h.logger.Error("Ошибка при обработке параметра readyToMove", zap.Error(err))
-		http.Error(w, "Ошибка при обработке параметра readyToMove", http.StatusBadRequest)
+		h.logger.Error(message, zap.Error(err))
+		http.Error(w, message, http.StatusBadRequest)
 		return
 	}
+	validatedChocolates, validatedGenders, validatedStatuses := query.Chocolates, query.Genders, query.Statuses
+	readyToMove, filter := query.ReadyToMove, query.Filter
 	var idPuppy string
 
 	pagedPuppies, puppyReviews, totalPages, err := h.Services.PuppiesGet(
-		validatedChocolates, validatedGenders, idPuppy, readyToMove, page, archived,
+		validatedChocolates, validatedGenders, validatedStatuses, idPuppy, readyToMove, breed, filter,
+		domain.Page{Number: page, After: query.After},
 	)
 	if err != nil {
 		h.logger.Error("Ошибка при получении данных о щенках", zap.Error(err))
@@ -108,11 +123,17 @@ func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived b
 		return
 	}
 
-	getParams := contains(validatedChocolates, validatedGenders, readyToMove)
+	// При выводе по убыванию ID ссылка на следующую страницу выбирает её по ключу — ID последнего щенка
+	var nextAfter int
+	if len(pagedPuppies) > 0 && filter.Keyset() {
+		nextAfter = pagedPuppies[len(pagedPuppies)-1].ID
+	}
+
+	getParams := contains(validatedChocolates, validatedGenders, validatedStatuses, readyToMove, filter)
 
 	if archived {
 		t := template.Must(
-			template.New("puppyArchive").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("puppyArchive").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/menu_archive.html",
 				"cmd/templates/parts/footer.html",
 				"cmd/templates/parts/nav.html",
@@ -125,6 +146,9 @@ func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived b
 		err = t.ExecuteTemplate(
 			w, "puppyArchive", struct {
 				SelectedColors      []string
+				Colors              []domain.DictionaryEntry
+				Sexes               []domain.DictionaryEntry
+				Locale              string
 				SelectedGenders     []string
 				IsReadyToMove       string
 				GetParams           string
@@ -132,8 +156,12 @@ func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived b
 				PuppiesWithFeedback map[int]int
 				TotalPages          int
 				CurrentPage         int
+				NextAfter           int
 			}{
 				SelectedColors:      chocolates,
+				Colors:              colors,
+				Sexes:               dictionaries.Sexes,
+				Locale:              visitorLocale(r),
 				SelectedGenders:     genders,
 				IsReadyToMove:       readyToMove,
 				GetParams:           getParams,
@@ -141,15 +169,23 @@ func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived b
 				PuppiesWithFeedback: puppyReviews,
 				TotalPages:          totalPages,
 				CurrentPage:         page,
+				NextAfter:           nextAfter,
 			},
 		)
 		if err != nil {
 			h.logger.Error("Ошибка вывода страницы с щенками", zap.Error(err))
 		}
 	} else {
+		// Количество щенков рядом с фильтрами — только подсказка, без него каталог всё равно выводится
+		facets, err := h.Services.PuppyFacetsGet(
+			validatedChocolates, validatedGenders, validatedStatuses, readyToMove, breed, filter,
+		)
+		if err != nil {
+			h.logger.Error("Ошибка при получении количества щенков по фильтрам", zap.Error(err))
+		}
 
 		t := template.Must(
-			template.New("puppyMenu").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("puppyMenu").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/menu_puppy.html",
 				"cmd/templates/parts/footer.html",
 				"cmd/templates/parts/nav.html",
@@ -161,21 +197,43 @@ func (h *Handler) PuppiesView(w http.ResponseWriter, r *http.Request, archived b
 
 		err = t.ExecuteTemplate(
 			w, "puppyMenu", struct {
-				SelectedColors  []string
-				SelectedGenders []string
-				IsReadyToMove   string
-				GetParams       string
-				Puppies         []domain.Puppy
-				TotalPages      int
-				CurrentPage     int
+				Breed            domain.DictionaryEntry
+				BasePath         string
+				SelectedColors   []string
+				Colors           []domain.DictionaryEntry
+				Sexes            []domain.DictionaryEntry
+				Locale           string
+				SelectedGenders  []string
+				SelectedStatuses []string
+				IsReadyToMove    string
+				Filter           domain.PuppyFilter
+				Facets           *domain.PuppyFacets
+				Sorts            []string
+				SortTitles       map[string]string
+				GetParams        string
+				Puppies          []domain.Puppy
+				TotalPages       int
+				CurrentPage      int
+				NextAfter        int
 			}{
-				SelectedColors:  chocolates,
-				SelectedGenders: genders,
-				IsReadyToMove:   readyToMove,
-				GetParams:       getParams,
-				Puppies:         pagedPuppies,
-				TotalPages:      totalPages,
-				CurrentPage:     page,
+				Breed:            breedEntry,
+				BasePath:         basePath,
+				SelectedColors:   chocolates,
+				Colors:           colors,
+				Sexes:            dictionaries.Sexes,
+				Locale:           visitorLocale(r),
+				SelectedGenders:  genders,
+				SelectedStatuses: statuses,
+				IsReadyToMove:    readyToMove,
+				Filter:           filter,
+				Facets:           facets,
+				Sorts:            domain.PuppySorts,
+				SortTitles:       domain.PuppySortTitles,
+				GetParams:        getParams,
+				Puppies:          pagedPuppies,
+				TotalPages:       totalPages,
+				CurrentPage:      page,
+				NextAfter:        nextAfter,
 			},
 		)
 		if err != nil {
@@ -214,9 +272,36 @@ func (h *Handler) PuppyView(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var pedigree *domain.PedigreeNode
+	var health *domain.HealthSummary
+	var growth *growthChart
+	if puppyInfo != nil {
+		pedigree, err = h.Services.PuppyPedigreeGet(puppyInfo)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить родословную щенка", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить родословную щенка", http.StatusInternalServerError)
+			return
+		}
+		health, err = h.Services.PuppyHealthGet(idPuppy)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить сведения о здоровье щенка", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить сведения о здоровье щенка", http.StatusInternalServerError)
+			return
+		}
+		series, err := h.Services.PuppyGrowthGet(idPuppy)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить график роста щенка", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить график роста щенка", http.StatusInternalServerError)
+			return
+		}
+		growth = newGrowthChart(series, puppyInfo.ID)
+	}
+
 	t := template.Must(
-		template.New("puppyView").Funcs(sprig.FuncMap()).ParseFiles(
+		template.New("puppyView").Funcs(h.templateFuncs()).ParseFiles(
 			"cmd/templates/puppy.html",
+			"cmd/templates/parts/pedigree.html",
+			"cmd/templates/parts/growth_chart.html",
 			"cmd/templates/parts/footer.html",
 			"cmd/templates/parts/nav.html",
 			"cmd/templates/parts/preloader.html",
@@ -231,11 +316,19 @@ func (h *Handler) PuppyView(w http.ResponseWriter, r *http.Request) {
 			Mother   *domain.Dog
 			Father   *domain.Dog
 			Feedback *domain.Feedback
+			Pedigree *domain.PedigreeNode
+			Health   *domain.HealthSummary
+			Growth   *growthChart
+			Locale   string
 		}{
 			Puppy:    puppyInfo,
 			Mother:   motherInfo,
 			Father:   fatherInfo,
 			Feedback: feedback,
+			Pedigree: pedigree,
+			Health:   health,
+			Growth:   growth,
+			Locale:   visitorLocale(r),
 		},
 	)
 	if err != nil {
@@ -245,6 +338,219 @@ func (h *Handler) PuppyView(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// DogView обрабатывает запрос на отображение страницы взрослой собаки.
+func (h *Handler) DogView(w http.ResponseWriter, r *http.Request) {
+	idDog := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idDog, 1000) {
+		h.logger.Error("Неверный идентификатор собаки", zap.String("id", idDog))
+		http.Error(w, "Неверный идентификатор собаки", http.StatusNotFound)
+		return
+	}
+
+	dog, err := h.Services.DogGet(idDog)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			h.logger.Error("Ошибка сервиса: не удалось получить информацию о собаке", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить информацию о собаке", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	var pedigree *domain.PedigreeNode
+	var results []domain.ShowResult
+	var health *domain.HealthSummary
+	var offspring []domain.Puppy
+	if dog != nil {
+		pedigree, err = h.Services.DogPedigreeGet(idDog)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить родословную собаки", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить родословную собаки", http.StatusInternalServerError)
+			return
+		}
+		results, err = h.Services.ShowResultsGet(dog.ID)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить результаты выставок собаки", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить результаты выставок собаки", http.StatusInternalServerError)
+			return
+		}
+		health, err = h.Services.DogHealthGet(dog.ID)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить сведения о здоровье собаки", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить сведения о здоровье собаки", http.StatusInternalServerError)
+			return
+		}
+		offspring, err = h.Services.DogOffspringGet(dog.ID)
+		if err != nil {
+			h.logger.Error("Ошибка сервиса: не удалось получить потомков собаки", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить потомков собаки", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	t := template.Must(
+		template.New("dogView").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/dog.html",
+			"cmd/templates/parts/pedigree.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "dogView", struct {
+			Dog         *domain.Dog
+			Pedigree    *domain.PedigreeNode
+			ShowResults []domain.ShowResult
+			Health      *domain.HealthSummary
+			Offspring   []domain.Puppy
+		}{
+			Dog:         dog,
+			Pedigree:    pedigree,
+			ShowResults: results,
+			Health:      health,
+			Offspring:   offspring,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы собаки", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось отобразить страницу", http.StatusInternalServerError)
+		return
+	}
+}
+
+// DogsView обрабатывает запрос на отображение каталога взрослых собак питомника.
+// Собаки из архива выводятся отдельно как собаки на пенсии.
+func (h *Handler) DogsView(w http.ResponseWriter, r *http.Request) {
+	dogs, err := h.Services.DogsGet(nil, nil, "", false)
+	if err != nil {
+		h.logger.Error("Ошибка сервиса: не удалось получить список собак", zap.Error(err))
+		http.Error(w, "Ошибка сервиса: не удалось получить список собак", http.StatusInternalServerError)
+		return
+	}
+	retired, err := h.Services.DogsGet(nil, nil, "", true)
+	if err != nil {
+		h.logger.Error("Ошибка сервиса: не удалось получить список собак на пенсии", zap.Error(err))
+		http.Error(w, "Ошибка сервиса: не удалось получить список собак на пенсии", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("dogsView").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/dogs.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "dogsView", struct {
+			Dogs    []domain.Dog
+			Retired []domain.Dog
+		}{
+			Dogs:    kennelDogs(dogs),
+			Retired: kennelDogs(retired),
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с собаками", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось отобразить страницу", http.StatusInternalServerError)
+		return
+	}
+}
+
+// LitterView обрабатывает запрос на отображение страницы помёта.
+func (h *Handler) LitterView(w http.ResponseWriter, r *http.Request) {
+	idLitter := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idLitter, 1000) {
+		h.logger.Error("Неверный идентификатор помёта", zap.String("id", idLitter))
+		http.Error(w, "Неверный идентификатор помёта", http.StatusNotFound)
+		return
+	}
+
+	litter, mother, father, err := h.Services.LitterGet(idLitter)
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			h.logger.Error("Ошибка сервиса: не удалось получить информацию о помёте", zap.Error(err))
+			http.Error(w, "Ошибка сервиса: не удалось получить информацию о помёте", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	t := template.Must(
+		template.New("litterView").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/litter.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "litterView", struct {
+			Litter *domain.Litter
+			Mother *domain.Dog
+			Father *domain.Dog
+		}{
+			Litter: litter,
+			Mother: mother,
+			Father: father,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы помёта", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось отобразить страницу", http.StatusInternalServerError)
+		return
+	}
+}
+
+// UpcomingLittersView обрабатывает запрос на отображение страницы с планируемыми помётами.
+func (h *Handler) UpcomingLittersView(w http.ResponseWriter, r *http.Request) {
+	litters, err := h.Services.UpcomingLittersGet()
+	if err != nil {
+		h.logger.Error("Ошибка сервиса: не удалось получить планируемые помёты", zap.Error(err))
+		http.Error(w, "Ошибка сервиса: не удалось получить планируемые помёты", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("upcomingLittersView").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/upcoming_litters.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "upcomingLittersView", struct {
+			Litters      []domain.UpcomingLitter
+			StatusTitles map[string]string
+		}{
+			Litters:      litters,
+			StatusTitles: domain.MatingStatusTitles,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с планируемыми помётами", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось отобразить страницу", http.StatusInternalServerError)
+		return
+	}
+}
+
 // ReviewsView обрабатывает запрос на отображение страницы с отзывами.
 func (h *Handler) ReviewsView(w http.ResponseWriter, r *http.Request) {
 	lastUrlQuery := r.URL.RequestURI
@@ -256,17 +562,29 @@ func (h *Handler) ReviewsView(w http.ResponseWriter, r *http.Request) {
 		h.logger.Error("Ошибка при обработке параметра страницы", zap.Error(err))
 		page = 1 // если параметр страницы отсутствует или некорректен, установить его в 1
 	}
+	after, err := ValidatePageAfter(r.URL.Query().Get("after"))
+	if err != nil {
+		h.logger.Error("Ошибка при обработке параметра after", zap.Error(err))
+		after = 0 // если ключ некорректен, страница выбирается по номеру
+	}
 
 	checked := true
 	var idFeedback string
-	reviews, puppyNames, err := h.Services.ReviewsGet(idFeedback, checked)
-	pagedReviews, totalPages, err := getPagedReviews(reviews, page, 2)
+	pagedReviews, puppyNames, totalPages, err := h.Services.ReviewsGet(
+		idFeedback, checked, domain.Page{Number: page, After: after},
+	)
 	if err != nil {
 		h.logger.Error("Ошибка при получении отзывов", zap.Error(err))
 	}
 
+	// Ссылка на следующую страницу выбирает её по ключу — ID последнего отзыва на странице
+	var nextAfter int
+	if len(pagedReviews) > 0 {
+		nextAfter = pagedReviews[len(pagedReviews)-1].ID
+	}
+
 	t := template.Must(
-		template.New("reviews").Funcs(sprig.FuncMap()).ParseFiles(
+		template.New("reviews").Funcs(h.templateFuncs()).ParseFiles(
 			"cmd/templates/reviews.html",
 			"cmd/templates/parts/footer.html",
 			"cmd/templates/parts/nav.html",
@@ -282,11 +600,13 @@ func (h *Handler) ReviewsView(w http.ResponseWriter, r *http.Request) {
 			FeedbackWithPuppyName map[int]string
 			TotalPages            int
 			CurrentPage           int
+			NextAfter             int
 		}{
 			Reviews:               pagedReviews,
 			FeedbackWithPuppyName: puppyNames,
 			TotalPages:            totalPages,
 			CurrentPage:           page,
+			NextAfter:             nextAfter,
 		},
 	)
 	if err != nil {
@@ -297,7 +617,7 @@ func (h *Handler) ReviewsView(w http.ResponseWriter, r *http.Request) {
 // NewFeedbackView обрабатывает запрос на отображение страницы для добавления отзыва.
 func (h *Handler) NewFeedbackView(w http.ResponseWriter, r *http.Request) {
 	t := template.Must(
-		template.New("newFeedback").Funcs(sprig.FuncMap()).ParseFiles(
+		template.New("newFeedback").Funcs(h.templateFuncs()).ParseFiles(
 			"cmd/templates/new_feedback.html",
 			"cmd/templates/parts/footer.html",
 			"cmd/templates/parts/nav.html",
@@ -318,10 +638,11 @@ func (h *Handler) NewFeedbackView(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ContactsView обрабатывает запрос на отображение страницы контактов.
+// ContactsView обрабатывает запрос на отображение страницы контактов. Контакты, ссылки и карта
+// берутся в шаблоне из настроек сайта.
 func (h *Handler) ContactsView(w http.ResponseWriter, r *http.Request) {
 	t := template.Must(
-		template.New("Contacts").Funcs(sprig.FuncMap()).ParseFiles(
+		template.New("Contacts").Funcs(h.templateFuncs()).ParseFiles(
 			"cmd/templates/contacts.html",
 			"cmd/templates/parts/footer.html",
 			"cmd/templates/parts/nav.html",
@@ -342,10 +663,110 @@ func (h *Handler) ContactsView(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ArticleView обрабатывает запрос на отображение страницы со статьей.
+// ArticlesView обрабатывает запрос на отображение списка опубликованных статей базы знаний.
+func (h *Handler) ArticlesView(w http.ResponseWriter, r *http.Request) {
+	// Получаем параметр страницы из URL
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1 // если параметр страницы отсутствует или некорректен, установить его в 1
+	}
+
+	tag, err := ValidateArticleTag(r.URL.Query().Get("tag"))
+	if err != nil {
+		h.logger.Error("Ошибка при обработке тега статьи", zap.Error(err))
+		http.Error(w, "Неверный тег статьи", http.StatusBadRequest)
+		return
+	}
+
+	drafts := false
+	articles, totalPages, err := h.Services.ArticlesGet(tag, drafts, domain.Page{Number: page})
+	if err != nil {
+		h.logger.Error("Ошибка сервиса: не удалось получить статьи", zap.Error(err))
+		http.Error(w, "Ошибка сервиса: не удалось получить статьи", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("articles").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/articles.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "articles", struct {
+			Articles    []domain.Article
+			Tag         string
+			TotalPages  int
+			CurrentPage int
+		}{
+			Articles:    articles,
+			Tag:         tag,
+			TotalPages:  totalPages,
+			CurrentPage: page,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы со статьями", zap.Error(err))
+		http.Error(w, "Ошибка вывода страницы со статьями", http.StatusInternalServerError)
+		return
+	}
+}
+
+// ArticleView обрабатывает запрос на отображение страницы со статьей. Статья ищется по адресу,
+// а по ID — перенаправляет на свой адрес. Черновики на сайте не показываются.
 func (h *Handler) ArticleView(w http.ResponseWriter, r *http.Request) {
+	idArticle := chi.URLParam(r, "id")
+
+	var article *domain.Article
+	var err error
+	if isValidID(idArticle, 1000000) {
+		article, err = h.Services.ArticleGet(idArticle)
+		if err == pgx.ErrNoRows {
+			article, err = nil, nil
+		}
+	} else {
+		slug, slugErr := ValidateArticleSlug(idArticle)
+		if slugErr != nil {
+			h.logger.Error("Неверный адрес статьи", zap.String("id", idArticle))
+			http.Error(w, "Неверный адрес статьи", http.StatusNotFound)
+			return
+		}
+		article, err = h.Services.ArticleGetBySlug(slug)
+	}
+	if err != nil {
+		h.logger.Error("Ошибка сервиса: не удалось получить статью", zap.Error(err))
+		http.Error(w, "Ошибка сервиса: не удалось получить статью", http.StatusInternalServerError)
+		return
+	}
+	if article == nil || !article.Published {
+		h.NotFoundView(w, r)
+		return
+	}
+	if idArticle != article.Slug {
+		http.Redirect(w, r, article.URL(), http.StatusMovedPermanently)
+		return
+	}
+
+	h.renderArticle(w, article, false)
+}
+
+// renderArticle выводит страницу статьи. В предпросмотре из админки страница помечается как черновик,
+// если статья ещё не опубликована.
+func (h *Handler) renderArticle(w http.ResponseWriter, article *domain.Article, preview bool) {
+	body, err := renderMarkdown(article.Body)
+	if err != nil {
+		h.logger.Error("Ошибка разбора текста статьи", zap.Error(err))
+		http.Error(w, "Ошибка разбора текста статьи", http.StatusInternalServerError)
+		return
+	}
+
 	t := template.Must(
-		template.New("article").Funcs(sprig.FuncMap()).ParseFiles(
+		template.New("article").Funcs(h.templateFuncs()).ParseFiles(
 			"cmd/templates/article.html",
 			"cmd/templates/parts/footer.html",
 			"cmd/templates/parts/nav.html",
@@ -355,9 +776,16 @@ func (h *Handler) ArticleView(w http.ResponseWriter, r *http.Request) {
 		),
 	)
 
-	err := h.ExecuteTemplate(
+	err = h.ExecuteTemplate(
 		t, w, "article", struct {
-		}{},
+			Article *domain.Article
+			Body    template.HTML
+			Preview bool
+		}{
+			Article: article,
+			Body:    body,
+			Preview: preview,
+		},
 	)
 	if err != nil {
 		h.logger.Error("Ошибка вывода страницы статьи", zap.Error(err))
@@ -366,11 +794,61 @@ func (h *Handler) ArticleView(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// SearchView обрабатывает запрос на отображение страницы поиска по сайту.
+func (h *Handler) SearchView(w http.ResponseWriter, r *http.Request) {
+	query, err := ValidateSearchQuery(r.URL.Query().Get("q"))
+	if err != nil {
+		h.logger.Error("Ошибка при обработке поискового запроса", zap.Error(err))
+		http.Error(w, "Ошибка при обработке поискового запроса", http.StatusBadRequest)
+		return
+	}
+
+	results := []domain.SearchResult{}
+	if query != "" {
+		results, err = h.Services.Search(query)
+		if err != nil {
+			h.logger.Error("Ошибка при поиске", zap.Error(err))
+			http.Error(w, "Ошибка при поиске", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	t := template.Must(
+		template.New("searchView").Funcs(h.templateFuncs()).Funcs(
+			template.FuncMap{"highlight": highlightSnippet},
+		).ParseFiles(
+			"cmd/templates/search.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "searchView", struct {
+			Query     string
+			MaxLength int
+			Results   []domain.SearchResult
+		}{
+			Query:     query,
+			MaxLength: searchQueryMaxLength,
+			Results:   results,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы поиска", zap.Error(err))
+		http.Error(w, "Ошибка вывода страницы поиска", http.StatusInternalServerError)
+		return
+	}
+}
+
 // NotFoundView обрабатывает не найденные маршруты.
 func (h *Handler) NotFoundView(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
 	t := template.Must(
-		template.New("notFound").Funcs(sprig.FuncMap()).ParseFiles(
+		template.New("notFound").Funcs(h.templateFuncs()).ParseFiles(
 			"cmd/templates/not_found.html",
 			"cmd/templates/parts/footer.html",
 			"cmd/templates/parts/nav.html",
@@ -390,3 +868,110 @@ func (h *Handler) NotFoundView(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// WaitlistView обрабатывает запрос на отображение страницы записи в лист ожидания. Порода выбирается
+// параметром breed, без него открывается первая порода справочника.
+func (h *Handler) WaitlistView(w http.ResponseWriter, r *http.Request) {
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении справочников", zap.Error(err))
+		http.Error(w, "Ошибка при получении справочников", http.StatusInternalServerError)
+		return
+	}
+
+	var breed domain.DictionaryEntry
+	if value := r.URL.Query().Get("breed"); value != "" {
+		var ok bool
+		breed, ok = dictionaries.BreedGet(value)
+		if !ok {
+			h.logger.Error("Неизвестная порода", zap.String("breed", value))
+			h.NotFoundView(w, r)
+			return
+		}
+	} else if len(dictionaries.Breeds) > 0 {
+		breed = dictionaries.Breeds[0]
+	}
+
+	t := template.Must(
+		template.New("waitlistView").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/waitlist.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "waitlistView", struct {
+			Breeds []domain.DictionaryEntry
+			Breed  domain.DictionaryEntry
+			Colors []domain.DictionaryEntry
+			Sexes  []domain.DictionaryEntry
+			Locale string
+			Joined bool
+		}{
+			Breeds: dictionaries.Breeds,
+			Breed:  breed,
+			Colors: dictionaries.ColorsOf(breed.Value),
+			Sexes:  dictionaries.Sexes,
+			Locale: visitorLocale(r),
+			Joined: r.URL.Query().Get("joined") == "true",
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы листа ожидания", zap.Error(err))
+		http.Error(w, "Ошибка вывода страницы листа ожидания", http.StatusInternalServerError)
+		return
+	}
+}
+
+// APIDocsView обрабатывает запрос на отображение документации публичного API по его спецификации OpenAPI.
+func (h *Handler) APIDocsView(w http.ResponseWriter, r *http.Request) {
+	spec, err := OpenAPISpec()
+	if err != nil {
+		h.logger.Error("Ошибка построения спецификации API", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось получить описание API", http.StatusInternalServerError)
+		return
+	}
+
+	document, operations, schemas, err := apiDocs(spec)
+	if err != nil {
+		h.logger.Error("Ошибка разбора спецификации API", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось получить описание API", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("ApiDocs").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/api_docs.html",
+			"cmd/templates/parts/footer.html",
+			"cmd/templates/parts/nav.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/parts/links.html",
+			"cmd/templates/parts/scripts.html",
+		),
+	)
+
+	err = h.ExecuteTemplate(
+		t, w, "ApiDocs", struct {
+			Title       string
+			Version     string
+			Description string
+			Operations  []apiDocsOperation
+			Schemas     []apiDocsSchema
+		}{
+			Title:       document.Info.Title,
+			Version:     document.Info.Version,
+			Description: document.Info.Description,
+			Operations:  operations,
+			Schemas:     schemas,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода документации API", zap.Error(err))
+		http.Error(w, "Ошибка сервера: не удалось отобразить страницу", http.StatusInternalServerError)
+		return
+	}
+}
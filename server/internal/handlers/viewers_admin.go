@@ -1,14 +1,17 @@
 package handlers
 
 import (
-	"github.com/Masterminds/sprig/v3"
+	"errors"
 	"github.com/egosha7/site-go/internal/authMiddleware"
 	"github.com/egosha7/site-go/internal/domain"
+	"github.com/go-chi/chi"
+	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
 	"html/template"
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, archived bool) {
@@ -33,9 +36,16 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 		return
 	}
 
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении справочников", zap.Error(err))
+		http.Error(w, "Ошибка при получении справочников", http.StatusInternalServerError)
+		return
+	}
+
 	// Валидация параметров поиска
 	chocolates := r.URL.Query()["chocolate"]
-	validatedChocolates, err := ValidateChocolates(chocolates)
+	validatedChocolates, err := ValidateChocolates(chocolates, domain.DictionaryValues(dictionaries.ColorsOf("")))
 	if err != nil {
 		h.logger.Error("Ошибка при обработке параметра chocolate", zap.Error(err))
 		http.Error(w, "Ошибка при обработке параметра chocolate", http.StatusBadRequest)
@@ -43,7 +53,7 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 	}
 
 	genders := r.URL.Query()["gender"]
-	validatedGenders, err := ValidateGender(genders)
+	validatedGenders, err := ValidateGender(genders, domain.DictionaryValues(dictionaries.Sexes))
 	if err != nil {
 		h.logger.Error("Ошибка при обработке параметра gender", zap.Error(err))
 		http.Error(w, "Ошибка при обработке параметра gender", http.StatusBadRequest)
@@ -58,15 +68,28 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 		return
 	}
 
+	allowedStatuses := adminPuppyStatuses
+	if archived {
+		allowedStatuses = archivePuppyStatuses
+	}
+	statuses := r.URL.Query()["status"]
+	validatedStatuses, err := ValidatePuppyStatuses(statuses, allowedStatuses)
+	if err != nil {
+		h.logger.Error("Ошибка при обработке параметра status", zap.Error(err))
+		http.Error(w, "Ошибка при обработке параметра status", http.StatusBadRequest)
+		return
+	}
+
 	var idPuppy string
 	var idParent string
 
 	archivedParents := false
 
-	getParams := contains(chocolates, genders, readyToMove)
+	getParams := contains(chocolates, genders, statuses, readyToMove, domain.PuppyFilter{})
 
 	pagedPuppies, puppyReviews, totalPages, err := h.Services.PuppiesGet(
-		validatedChocolates, validatedGenders, idPuppy, readyToMove, page, archived,
+		validatedChocolates, validatedGenders, validatedStatuses, idPuppy, readyToMove, "", domain.PuppyFilter{},
+		domain.Page{Number: page},
 	)
 	if err != nil {
 		h.logger.Error("Ошибка при получении данных о щенках", zap.Error(err))
@@ -82,7 +105,7 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 
 	if archived {
 		t := template.Must(
-			template.New("adminPuppyArchive").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("adminPuppyArchive").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/admin/admin_menu_archive.html",
 				"cmd/templates/parts/preloader.html",
 				"cmd/templates/admin/admin_nav.html",
@@ -93,6 +116,9 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 		err = t.ExecuteTemplate(
 			w, "adminPuppyArchive", struct {
 				SelectedColors      []string
+				Breeds              []domain.DictionaryEntry
+				Colors              []domain.DictionaryEntry
+				Sexes               []domain.DictionaryEntry
 				SelectedGenders     []string
 				IsReadyToMove       string
 				GetParams           string
@@ -103,6 +129,9 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 				Parents             []domain.Dog
 			}{
 				SelectedColors:      chocolates,
+				Breeds:              dictionaries.Breeds,
+				Colors:              dictionaries.ColorsOf(""),
+				Sexes:               dictionaries.Sexes,
 				SelectedGenders:     genders,
 				IsReadyToMove:       readyToMove,
 				GetParams:           getParams,
@@ -117,8 +146,15 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 			h.logger.Error("Ошибка вывода страницы с щенками (Архив)", zap.Error(err))
 		}
 	} else {
+		litters, err := h.Services.LittersGet()
+		if err != nil {
+			h.logger.Error("Ошибка при получении данных о помётах", zap.Error(err))
+			http.Error(w, "Ошибка при получении данных о помётах", http.StatusInternalServerError)
+			return
+		}
+
 		t := template.Must(
-			template.New("adminPuppyMenu").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("adminPuppyMenu").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/admin/admin_menu_puppy.html",
 				"cmd/templates/parts/preloader.html",
 				"cmd/templates/admin/admin_nav.html",
@@ -128,23 +164,35 @@ func (h *Handler) AdminPuppiesHandler(w http.ResponseWriter, r *http.Request, ar
 
 		err = t.ExecuteTemplate(
 			w, "adminPuppyMenu", struct {
-				SelectedColors  []string
-				SelectedGenders []string
-				IsReadyToMove   string
-				GetParams       string
-				Puppies         []domain.Puppy
-				TotalPages      int
-				CurrentPage     int
-				Parents         []domain.Dog
+				SelectedColors   []string
+				Breeds           []domain.DictionaryEntry
+				Colors           []domain.DictionaryEntry
+				Sexes            []domain.DictionaryEntry
+				SelectedGenders  []string
+				SelectedStatuses []string
+				IsReadyToMove    string
+				GetParams        string
+				Puppies          []domain.Puppy
+				TotalPages       int
+				CurrentPage      int
+				Parents          []domain.Dog
+				Litters          []domain.Litter
+				StatusTitles     map[string]string
 			}{
-				SelectedColors:  chocolates,
-				SelectedGenders: genders,
-				IsReadyToMove:   readyToMove,
-				GetParams:       getParams,
-				Puppies:         pagedPuppies,
-				TotalPages:      totalPages,
-				CurrentPage:     page,
-				Parents:         parentsList,
+				SelectedColors:   chocolates,
+				Breeds:           dictionaries.Breeds,
+				Colors:           dictionaries.ColorsOf(""),
+				Sexes:            dictionaries.Sexes,
+				SelectedGenders:  genders,
+				SelectedStatuses: statuses,
+				IsReadyToMove:    readyToMove,
+				GetParams:        getParams,
+				Puppies:          pagedPuppies,
+				TotalPages:       totalPages,
+				CurrentPage:      page,
+				Parents:          parentsList,
+				Litters:          litters,
+				StatusTitles:     domain.PuppyStatusTitles,
 			},
 		)
 		if err != nil {
@@ -175,9 +223,23 @@ func (h *Handler) AdminDogsHandler(w http.ResponseWriter, r *http.Request, archi
 		return
 	}
 
+	after, err := ValidatePageAfter(r.URL.Query().Get("after"))
+	if err != nil {
+		h.logger.Error("Ошибка при обработке параметра after", zap.Error(err))
+		http.Error(w, "Ошибка при обработке параметра after", http.StatusBadRequest)
+		return
+	}
+
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении справочников", zap.Error(err))
+		http.Error(w, "Ошибка при получении справочников", http.StatusInternalServerError)
+		return
+	}
+
 	// Валидация параметров поиска
 	chocolates := r.URL.Query()["chocolate"]
-	validatedChocolates, err := ValidateChocolates(chocolates)
+	validatedChocolates, err := ValidateChocolates(chocolates, domain.DictionaryValues(dictionaries.ColorsOf("")))
 	if err != nil {
 		h.logger.Error("Ошибка при обработке параметра chocolate", zap.Error(err))
 		http.Error(w, "Ошибка при обработке параметра chocolate", http.StatusBadRequest)
@@ -185,7 +247,7 @@ func (h *Handler) AdminDogsHandler(w http.ResponseWriter, r *http.Request, archi
 	}
 
 	genders := r.URL.Query()["gender"]
-	validatedGenders, err := ValidateGender(genders)
+	validatedGenders, err := ValidateGender(genders, domain.DictionaryValues(dictionaries.Sexes))
 	if err != nil {
 		h.logger.Error("Ошибка при обработке параметра gender", zap.Error(err))
 		http.Error(w, "Ошибка при обработке параметра gender", http.StatusBadRequest)
@@ -200,25 +262,36 @@ func (h *Handler) AdminDogsHandler(w http.ResponseWriter, r *http.Request, archi
 		return
 	}
 
-	var idParent string
-
-	parentsList, err := h.Services.DogsGet(validatedChocolates, validatedGenders, idParent, archived)
-
+	pagedDogs, totalPages, err := h.Services.DogsPageGet(
+		validatedChocolates, validatedGenders, archived, domain.Page{Number: page, After: after},
+	)
 	if err != nil {
 		h.logger.Error("Ошибка при получении данных о собаках", zap.Error(err))
 		http.Error(w, "Ошибка при получении данных о собаках", http.StatusInternalServerError)
 		return
 	}
 
-	getParams := contains(chocolates, genders, readyToMove)
+	getParams := contains(chocolates, genders, nil, readyToMove, domain.PuppyFilter{})
 	log.Println(getParams)
 
-	pagedDogs, totalPages, err := getPagedDogs(parentsList, page, 4)
+	// Ссылка на следующую страницу выбирает её по ключу — ID последней собаки на странице
+	var nextAfter int
+	if len(pagedDogs) > 0 {
+		nextAfter = pagedDogs[len(pagedDogs)-1].ID
+	}
+
+	allDogs, err := h.Services.DogsAllGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении списка всех собак", zap.Error(err))
+		http.Error(w, "Ошибка при получении списка всех собак", http.StatusInternalServerError)
+		return
+	}
 
 	if archived {
 		t := template.Must(
-			template.New("adminMenuArchiveDog").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("adminMenuArchiveDog").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/admin/admin_menu_archive_dog.html",
+				"cmd/templates/admin/admin_dog_fields.html",
 				"cmd/templates/parts/preloader.html",
 				"cmd/templates/admin/admin_nav.html",
 				"cmd/templates/admin/admin_footer.html",
@@ -228,20 +301,30 @@ func (h *Handler) AdminDogsHandler(w http.ResponseWriter, r *http.Request, archi
 		err = t.ExecuteTemplate(
 			w, "adminMenuArchiveDog", struct {
 				SelectedColors  []string
+				Breeds          []domain.DictionaryEntry
+				Colors          []domain.DictionaryEntry
+				Sexes           []domain.DictionaryEntry
 				SelectedGenders []string
 				IsReadyToMove   string
 				GetParams       string
 				TotalPages      int
 				CurrentPage     int
+				NextAfter       int
 				Parents         []domain.Dog
+				AllDogs         []domain.Dog
 			}{
 				SelectedColors:  chocolates,
+				Breeds:          dictionaries.Breeds,
+				Colors:          dictionaries.ColorsOf(""),
+				Sexes:           dictionaries.Sexes,
 				SelectedGenders: genders,
 				IsReadyToMove:   readyToMove,
 				GetParams:       getParams,
 				TotalPages:      totalPages,
 				CurrentPage:     page,
+				NextAfter:       nextAfter,
 				Parents:         pagedDogs,
+				AllDogs:         allDogs,
 			},
 		)
 		if err != nil {
@@ -249,8 +332,9 @@ func (h *Handler) AdminDogsHandler(w http.ResponseWriter, r *http.Request, archi
 		}
 	} else {
 		t := template.Must(
-			template.New("adminDogMenu").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("adminDogMenu").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/admin/admin_menu_dog.html",
+				"cmd/templates/admin/admin_dog_fields.html",
 				"cmd/templates/parts/preloader.html",
 				"cmd/templates/admin/admin_nav.html",
 				"cmd/templates/admin/admin_footer.html",
@@ -260,20 +344,30 @@ func (h *Handler) AdminDogsHandler(w http.ResponseWriter, r *http.Request, archi
 		err = t.ExecuteTemplate(
 			w, "adminDogMenu", struct {
 				SelectedColors  []string
+				Breeds          []domain.DictionaryEntry
+				Colors          []domain.DictionaryEntry
+				Sexes           []domain.DictionaryEntry
 				SelectedGenders []string
 				IsReadyToMove   string
 				GetParams       string
 				TotalPages      int
 				CurrentPage     int
+				NextAfter       int
 				Parents         []domain.Dog
+				AllDogs         []domain.Dog
 			}{
 				SelectedColors:  chocolates,
+				Breeds:          dictionaries.Breeds,
+				Colors:          dictionaries.ColorsOf(""),
+				Sexes:           dictionaries.Sexes,
 				SelectedGenders: genders,
 				IsReadyToMove:   readyToMove,
 				GetParams:       getParams,
 				TotalPages:      totalPages,
 				CurrentPage:     page,
+				NextAfter:       nextAfter,
 				Parents:         pagedDogs,
+				AllDogs:         allDogs,
 			},
 		)
 		if err != nil {
@@ -298,15 +392,14 @@ func (h *Handler) AdminReviewsView(w http.ResponseWriter, r *http.Request, check
 	}
 
 	var idFeedback string
-	reviews, puppyNames, err := h.Services.ReviewsGet(idFeedback, checked)
-	pagedReviews, totalPages, err := getPagedReviews(reviews, page, 2)
+	pagedReviews, puppyNames, totalPages, err := h.Services.ReviewsGet(idFeedback, checked, domain.Page{Number: page})
 	if err != nil {
 		h.logger.Error("Ошибка при получении отзывов", zap.Error(err))
 	}
 
 	if checked {
 		t := template.Must(
-			template.New("adminReviews").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("adminReviews").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/admin/admin_reviews.html",
 				"cmd/templates/admin/admin_nav.html",
 				"cmd/templates/admin/admin_footer.html",
@@ -332,7 +425,7 @@ func (h *Handler) AdminReviewsView(w http.ResponseWriter, r *http.Request, check
 		}
 	} else {
 		t := template.Must(
-			template.New("adminReviewsArchive").Funcs(sprig.FuncMap()).ParseFiles(
+			template.New("adminReviewsArchive").Funcs(h.templateFuncs()).ParseFiles(
 				"cmd/templates/admin/admin_reviews_archive.html",
 				"cmd/templates/admin/admin_nav.html",
 				"cmd/templates/admin/admin_footer.html",
@@ -358,3 +451,819 @@ func (h *Handler) AdminReviewsView(w http.ResponseWriter, r *http.Request, check
 		}
 	}
 }
+
+// AdminLittersHandler обрабатывает запрос на отображение страницы с помётами.
+func (h *Handler) AdminLittersHandler(w http.ResponseWriter, r *http.Request) {
+	litters, err := h.Services.LittersGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении данных о помётах", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о помётах", http.StatusInternalServerError)
+		return
+	}
+
+	var idParent string
+	parentsList, err := h.Services.DogsGet([]string{}, []string{}, idParent, false)
+	if err != nil {
+		h.logger.Error("Ошибка при получении данных о родителях", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о родителях", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminLitters").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_litters.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminLitters", struct {
+			Litters []domain.Litter
+			Parents []domain.Dog
+		}{
+			Litters: litters,
+			Parents: parentsList,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с помётами", zap.Error(err))
+	}
+}
+
+// AdminPlannedMatingsHandler обрабатывает запрос на отображение страницы с запланированными вязками.
+func (h *Handler) AdminPlannedMatingsHandler(w http.ResponseWriter, r *http.Request) {
+	matings, err := h.Services.PlannedMatingsGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении данных о вязках", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о вязках", http.StatusInternalServerError)
+		return
+	}
+
+	var idParent string
+	parentsList, err := h.Services.DogsGet([]string{}, []string{}, idParent, false)
+	if err != nil {
+		h.logger.Error("Ошибка при получении данных о родителях", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о родителях", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminPlannedMatings").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_matings.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminPlannedMatings", struct {
+			Matings      []domain.PlannedMating
+			Parents      []domain.Dog
+			StatusTitles map[string]string
+		}{
+			Matings:      matings,
+			Parents:      parentsList,
+			StatusTitles: domain.MatingStatusTitles,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с вязками", zap.Error(err))
+	}
+}
+
+// AdminMatingHandler обрабатывает запрос на отображение страницы планирования вязки
+// с расчётом коэффициента инбридинга и окрасов для выбранной пары или существующего щенка.
+func (h *Handler) AdminMatingHandler(w http.ResponseWriter, r *http.Request) {
+	var coi *domain.COIResult
+	var err error
+
+	idPuppy := r.URL.Query().Get("puppy")
+	motherStr := r.URL.Query().Get("mother")
+	fatherStr := r.URL.Query().Get("father")
+
+	switch {
+	case idPuppy != "":
+		if !isValidID(idPuppy, 1000) {
+			h.logger.Error("Неверный идентификатор щенка", zap.String("id", idPuppy))
+			http.Error(w, "Неверный идентификатор щенка", http.StatusBadRequest)
+			return
+		}
+		coi, err = h.Services.PuppyCOIGet(idPuppy)
+	case motherStr != "" || fatherStr != "":
+		if !isValidID(motherStr, 1000) || !isValidID(fatherStr, 1000) {
+			h.logger.Error("Неверный идентификатор родителя", zap.String("mother", motherStr), zap.String("father", fatherStr))
+			http.Error(w, "Неверный идентификатор родителя", http.StatusBadRequest)
+			return
+		}
+		motherID, _ := strconv.Atoi(motherStr)
+		fatherID, _ := strconv.Atoi(fatherStr)
+		coi, err = h.Services.MatingCOIGet(fatherID, motherID)
+	}
+	if errors.Is(err, domain.ErrMatingSexes) {
+		h.logger.Error("Неверный пол родителей", zap.Error(err))
+		http.Error(w, "Мать должна быть сукой, а отец — кобелем", http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Ошибка при расчёте коэффициента инбридинга", zap.Error(err))
+		http.Error(w, "Ошибка при расчёте коэффициента инбридинга", http.StatusInternalServerError)
+		return
+	}
+
+	var idParent string
+	parentsList, err := h.Services.DogsGet([]string{}, []string{}, idParent, false)
+	if err != nil {
+		h.logger.Error("Ошибка при получении данных о родителях", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о родителях", http.StatusInternalServerError)
+		return
+	}
+
+	var motherID, fatherID int
+	var colors *domain.ColorPrediction
+	if coi != nil {
+		motherID, fatherID = coi.DamID, coi.SireID
+		colors, err = h.Services.ColorPredictionGet(fatherID, motherID)
+		if err != nil {
+			h.logger.Error("Ошибка при расчёте окрасов щенков", zap.Error(err))
+			http.Error(w, "Ошибка при расчёте окрасов щенков", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	t := template.Must(
+		template.New("adminMating").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_mating.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminMating", struct {
+			Parents  []domain.Dog
+			MotherID int
+			FatherID int
+			COI      *domain.COIResult
+			Colors   *domain.ColorPrediction
+		}{
+			Parents:  parentsList,
+			MotherID: motherID,
+			FatherID: fatherID,
+			COI:      coi,
+			Colors:   colors,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы планирования вязки", zap.Error(err))
+	}
+}
+
+// AdminBuyersHandler обрабатывает запрос на отображение страницы с покупателями и поиском по ним.
+func (h *Handler) AdminBuyersHandler(w http.ResponseWriter, r *http.Request) {
+	search := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	buyers, err := h.Services.BuyersGet(search)
+	if err != nil {
+		h.logger.Error("Ошибка при получении данных о покупателях", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о покупателях", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminBuyers").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_buyers.html",
+			"cmd/templates/admin/admin_buyer_fields.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminBuyers", struct {
+			Buyers []domain.Buyer
+			Search string
+		}{
+			Buyers: buyers,
+			Search: search,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с покупателями", zap.Error(err))
+	}
+}
+
+// AdminBuyerHandler обрабатывает запрос на отображение карточки покупателя с историей его щенков.
+func (h *Handler) AdminBuyerHandler(w http.ResponseWriter, r *http.Request) {
+	idBuyer := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idBuyer, 1000000) {
+		h.logger.Error("Неверный идентификатор покупателя", zap.String("id", idBuyer))
+		http.Error(w, "Неверный идентификатор покупателя", http.StatusNotFound)
+		return
+	}
+
+	buyer, err := h.Services.BuyerGet(idBuyer)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Покупатель не найден", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении данных о покупателе", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о покупателе", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminBuyer").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_buyer.html",
+			"cmd/templates/admin/admin_buyer_fields.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminBuyer", struct {
+			Buyer *domain.Buyer
+		}{
+			Buyer: buyer,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы покупателя", zap.Error(err))
+	}
+}
+
+// AdminWaitlistHandler обрабатывает запрос на отображение страницы с листом ожидания.
+func (h *Handler) AdminWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	entries, err := h.Services.WaitlistEntriesGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении листа ожидания", zap.Error(err))
+		http.Error(w, "Ошибка при получении листа ожидания", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminWaitlist").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_waitlist.html",
+			"cmd/templates/admin/admin_waitlist_entry.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminWaitlist", struct {
+			Entries []domain.WaitlistEntry
+		}{
+			Entries: entries,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с листом ожидания", zap.Error(err))
+	}
+}
+
+// AdminPuppyWaitlistHandler обрабатывает запрос на отображение очереди из листа ожидания для щенка.
+func (h *Handler) AdminPuppyWaitlistHandler(w http.ResponseWriter, r *http.Request) {
+	idPuppy := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idPuppy, 1000) {
+		h.logger.Error("Неверный идентификатор щенка", zap.String("id", idPuppy))
+		http.Error(w, "Неверный идентификатор щенка", http.StatusNotFound)
+		return
+	}
+
+	puppy, candidates, err := h.Services.WaitlistCandidatesGet(idPuppy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Щенок не найден", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении очереди на щенка", zap.Error(err))
+		http.Error(w, "Ошибка при получении очереди на щенка", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminPuppyWaitlist").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_puppy_waitlist.html",
+			"cmd/templates/admin/admin_waitlist_entry.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminPuppyWaitlist", struct {
+			Puppy      *domain.Puppy
+			Candidates []domain.WaitlistCandidate
+		}{
+			Puppy:      puppy,
+			Candidates: candidates,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с очередью на щенка", zap.Error(err))
+	}
+}
+
+// AdminPuppyHealthHandler обрабатывает запрос на отображение ветеринарной истории щенка.
+func (h *Handler) AdminPuppyHealthHandler(w http.ResponseWriter, r *http.Request) {
+	idPuppy := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idPuppy, 1000) {
+		h.logger.Error("Неверный идентификатор щенка", zap.String("id", idPuppy))
+		http.Error(w, "Неверный идентификатор щенка", http.StatusNotFound)
+		return
+	}
+
+	puppy, _, _, err := h.Services.PuppyGet(idPuppy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Щенок не найден", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении данных о щенке", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о щенке", http.StatusInternalServerError)
+		return
+	}
+
+	events, err := h.Services.HealthEventsGet(puppy.ID, 0)
+	if err != nil {
+		h.logger.Error("Ошибка при получении записей о здоровье щенка", zap.Error(err))
+		http.Error(w, "Ошибка при получении записей о здоровье щенка", http.StatusInternalServerError)
+		return
+	}
+
+	h.renderAdminHealth(w, "puppy", puppy.ID, puppy.Name, events)
+}
+
+// AdminDogHealthHandler обрабатывает запрос на отображение ветеринарной истории взрослой собаки.
+func (h *Handler) AdminDogHealthHandler(w http.ResponseWriter, r *http.Request) {
+	idDog := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idDog, 1000) {
+		h.logger.Error("Неверный идентификатор собаки", zap.String("id", idDog))
+		http.Error(w, "Неверный идентификатор собаки", http.StatusNotFound)
+		return
+	}
+
+	dog, err := h.Services.DogGet(idDog)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Собака не найдена", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении данных о собаке", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о собаке", http.StatusInternalServerError)
+		return
+	}
+
+	events, err := h.Services.HealthEventsGet(0, dog.ID)
+	if err != nil {
+		h.logger.Error("Ошибка при получении записей о здоровье собаки", zap.Error(err))
+		http.Error(w, "Ошибка при получении записей о здоровье собаки", http.StatusInternalServerError)
+		return
+	}
+
+	h.renderAdminHealth(w, "dog", dog.ID, dog.Name, events)
+}
+
+// renderAdminHealth выводит общую для щенков и собак страницу редактора ветеринарной истории.
+// ownerField — имя поля формы, в котором передаётся владелец новой записи.
+func (h *Handler) renderAdminHealth(
+	w http.ResponseWriter, ownerField string, ownerID int, ownerName string, events []domain.HealthEvent,
+) {
+	t := template.Must(
+		template.New("adminHealth").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_health.html",
+			"cmd/templates/admin/admin_health_fields.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err := t.ExecuteTemplate(
+		w, "adminHealth", struct {
+			OwnerField string
+			OwnerID    int
+			OwnerName  string
+			Events     []domain.HealthEvent
+			Types      map[string]string
+		}{
+			OwnerField: ownerField,
+			OwnerID:    ownerID,
+			OwnerName:  ownerName,
+			Events:     events,
+			Types:      domain.HealthEventTypeTitles,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы со здоровьем", zap.Error(err))
+	}
+}
+
+// AdminPuppyWeightsHandler обрабатывает запрос на отображение взвешиваний щенка.
+func (h *Handler) AdminPuppyWeightsHandler(w http.ResponseWriter, r *http.Request) {
+	idPuppy := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idPuppy, 1000) {
+		h.logger.Error("Неверный идентификатор щенка", zap.String("id", idPuppy))
+		http.Error(w, "Неверный идентификатор щенка", http.StatusNotFound)
+		return
+	}
+
+	puppy, _, _, err := h.Services.PuppyGet(idPuppy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Щенок не найден", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении данных о щенке", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о щенке", http.StatusInternalServerError)
+		return
+	}
+
+	measurements, err := h.Services.PuppyWeightsGet(puppy.ID)
+	if err != nil {
+		h.logger.Error("Ошибка при получении взвешиваний щенка", zap.Error(err))
+		http.Error(w, "Ошибка при получении взвешиваний щенка", http.StatusInternalServerError)
+		return
+	}
+
+	series := []domain.GrowthSeries{{PuppyID: puppy.ID, Name: puppy.Name, Measurements: measurements}}
+
+	t := template.Must(
+		template.New("adminPuppyWeights").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_puppy_weights.html",
+			"cmd/templates/parts/growth_chart.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminPuppyWeights", struct {
+			Puppy        *domain.Puppy
+			Measurements []domain.WeightMeasurement
+			Growth       *growthChart
+		}{
+			Puppy:        puppy,
+			Measurements: measurements,
+			Growth:       newGrowthChart(series, puppy.ID),
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы со взвешиваниями щенка", zap.Error(err))
+	}
+}
+
+// AdminDictionariesHandler обрабатывает запрос на отображение справочников пород, окрасов и пола.
+func (h *Handler) AdminDictionariesHandler(w http.ResponseWriter, r *http.Request) {
+	dictionaries, err := h.Services.DictionariesGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении справочников", zap.Error(err))
+		http.Error(w, "Ошибка при получении справочников", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminDictionaries").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_dictionaries.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminDictionaries", struct {
+			Kinds        []string
+			Titles       map[string]string
+			Fixed        map[string]bool
+			Dictionaries map[string][]domain.DictionaryEntry
+		}{
+			Kinds:  []string{domain.DictionaryBreeds, domain.DictionaryColors, domain.DictionarySexes},
+			Titles: domain.DictionaryTitles,
+			Fixed:  domain.DictionaryFixed,
+			Dictionaries: map[string][]domain.DictionaryEntry{
+				domain.DictionaryBreeds: dictionaries.Breeds,
+				domain.DictionaryColors: dictionaries.Colors,
+				domain.DictionarySexes:  dictionaries.Sexes,
+			},
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы справочников", zap.Error(err))
+	}
+}
+
+// AdminPuppyPricesHandler обрабатывает запрос на отображение истории цены щенка.
+func (h *Handler) AdminPuppyPricesHandler(w http.ResponseWriter, r *http.Request) {
+	idPuppy := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idPuppy, 1000) {
+		h.logger.Error("Неверный идентификатор щенка", zap.String("id", idPuppy))
+		http.Error(w, "Неверный идентификатор щенка", http.StatusNotFound)
+		return
+	}
+
+	puppy, _, _, err := h.Services.PuppyGet(idPuppy)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Щенок не найден", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении данных о щенке", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о щенке", http.StatusInternalServerError)
+		return
+	}
+
+	changes, err := h.Services.PriceHistoryGet(puppy.ID)
+	if err != nil {
+		h.logger.Error("Ошибка при получении истории цены щенка", zap.Error(err))
+		http.Error(w, "Ошибка при получении истории цены щенка", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminPuppyPrices").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_puppy_prices.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminPuppyPrices", struct {
+			Puppy   *domain.Puppy
+			Changes []domain.PriceChange
+		}{
+			Puppy:   puppy,
+			Changes: changes,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с историей цены щенка", zap.Error(err))
+	}
+}
+
+// AdminDogShowsHandler обрабатывает запрос на отображение результатов выставок взрослой собаки.
+func (h *Handler) AdminDogShowsHandler(w http.ResponseWriter, r *http.Request) {
+	idDog := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idDog, 1000) {
+		h.logger.Error("Неверный идентификатор собаки", zap.String("id", idDog))
+		http.Error(w, "Неверный идентификатор собаки", http.StatusNotFound)
+		return
+	}
+
+	dog, err := h.Services.DogGet(idDog)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Собака не найдена", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении данных о собаке", zap.Error(err))
+		http.Error(w, "Ошибка при получении данных о собаке", http.StatusInternalServerError)
+		return
+	}
+
+	results, err := h.Services.ShowResultsGet(dog.ID)
+	if err != nil {
+		h.logger.Error("Ошибка при получении результатов выставок", zap.Error(err))
+		http.Error(w, "Ошибка при получении результатов выставок", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminDogShows").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_dog_shows.html",
+			"cmd/templates/admin/admin_show_fields.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminDogShows", struct {
+			Dog     *domain.Dog
+			Results []domain.ShowResult
+			Titles  []string
+			Grades  []string
+		}{
+			Dog:     dog,
+			Results: results,
+			Titles:  domain.ShowTitles,
+			Grades:  domain.ShowGrades,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы с результатами выставок", zap.Error(err))
+	}
+}
+
+// AdminArticlesHandler обрабатывает запрос на отображение списка статей вместе с черновиками.
+func (h *Handler) AdminArticlesHandler(w http.ResponseWriter, r *http.Request) {
+	// Получаем параметр страницы из URL
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil || page < 1 {
+		page = 1 // если параметр страницы отсутствует или некорректен, установить его в 1
+	}
+
+	drafts := true
+	articles, totalPages, err := h.Services.ArticlesGet("", drafts, domain.Page{Number: page})
+	if err != nil {
+		h.logger.Error("Ошибка при получении статей", zap.Error(err))
+		http.Error(w, "Ошибка при получении статей", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminArticles").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_articles.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminArticles", struct {
+			Articles    []domain.Article
+			TotalPages  int
+			CurrentPage int
+		}{
+			Articles:    articles,
+			TotalPages:  totalPages,
+			CurrentPage: page,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы со статьями", zap.Error(err))
+	}
+}
+
+// AdminArticleHandler обрабатывает запрос на отображение формы статьи: новой, если ID не указан,
+// или существующей для редактирования.
+func (h *Handler) AdminArticleHandler(w http.ResponseWriter, r *http.Request) {
+	idArticle := chi.URLParam(r, "id")
+
+	var article *domain.Article
+	if idArticle != "" {
+		// Проверяем валидность ID
+		if !isValidID(idArticle, 1000000) {
+			h.logger.Error("Неверный идентификатор статьи", zap.String("id", idArticle))
+			http.Error(w, "Неверный идентификатор статьи", http.StatusNotFound)
+			return
+		}
+
+		var err error
+		article, err = h.Services.ArticleGet(idArticle)
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				http.Error(w, "Статья не найдена", http.StatusNotFound)
+				return
+			}
+			h.logger.Error("Ошибка при получении статьи", zap.Error(err))
+			http.Error(w, "Ошибка при получении статьи", http.StatusInternalServerError)
+			return
+		}
+	}
+
+	t := template.Must(
+		template.New("adminArticle").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_article.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err := t.ExecuteTemplate(
+		w, "adminArticle", struct {
+			Article *domain.Article
+		}{
+			Article: article,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы статьи", zap.Error(err))
+	}
+}
+
+// AdminArticlePreviewHandler обрабатывает запрос на предпросмотр статьи в том виде, в каком она
+// выводится на сайте. Черновик тоже можно посмотреть.
+func (h *Handler) AdminArticlePreviewHandler(w http.ResponseWriter, r *http.Request) {
+	idArticle := chi.URLParam(r, "id")
+
+	// Проверяем валидность ID
+	if !isValidID(idArticle, 1000000) {
+		h.logger.Error("Неверный идентификатор статьи", zap.String("id", idArticle))
+		http.Error(w, "Неверный идентификатор статьи", http.StatusNotFound)
+		return
+	}
+
+	article, err := h.Services.ArticleGet(idArticle)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			http.Error(w, "Статья не найдена", http.StatusNotFound)
+			return
+		}
+		h.logger.Error("Ошибка при получении статьи", zap.Error(err))
+		http.Error(w, "Ошибка при получении статьи", http.StatusInternalServerError)
+		return
+	}
+
+	h.renderArticle(w, article, true)
+}
+
+// AdminSettingsHandler обрабатывает запрос на отображение формы настроек сайта.
+func (h *Handler) AdminSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	settings, err := h.Services.SiteSettingsGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении настроек сайта", zap.Error(err))
+		http.Error(w, "Ошибка при получении настроек сайта", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminSettings").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_settings.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminSettings", struct {
+			Settings *domain.SiteSettings
+		}{
+			Settings: settings,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы настроек сайта", zap.Error(err))
+	}
+}
+
+// AdminAPITokensHandler обрабатывает запрос на отображение токенов API.
+func (h *Handler) AdminAPITokensHandler(w http.ResponseWriter, r *http.Request) {
+	h.renderAdminAPITokens(w, "", "")
+}
+
+// renderAdminAPITokens выводит страницу токенов API. newToken — только что выпущенный токен:
+// он показывается один раз, поэтому страница выводится в ответ на выпуск, а не после перенаправления.
+func (h *Handler) renderAdminAPITokens(w http.ResponseWriter, newTokenName, newToken string) {
+	tokens, err := h.Services.AuthorizationServices.APITokensGet()
+	if err != nil {
+		h.logger.Error("Ошибка при получении токенов API", zap.Error(err))
+		http.Error(w, "Ошибка при получении токенов API", http.StatusInternalServerError)
+		return
+	}
+
+	t := template.Must(
+		template.New("adminTokens").Funcs(h.templateFuncs()).ParseFiles(
+			"cmd/templates/admin/admin_tokens.html",
+			"cmd/templates/parts/preloader.html",
+			"cmd/templates/admin/admin_nav.html",
+			"cmd/templates/admin/admin_footer.html",
+		),
+	)
+
+	err = t.ExecuteTemplate(
+		w, "adminTokens", struct {
+			Tokens       []domain.APIToken
+			NewTokenName string
+			NewToken     string
+		}{
+			Tokens:       tokens,
+			NewTokenName: newTokenName,
+			NewToken:     newToken,
+		},
+	)
+	if err != nil {
+		h.logger.Error("Ошибка вывода страницы токенов API", zap.Error(err))
+	}
+}
@@ -10,11 +10,14 @@ import (
 	"github.com/egosha7/site-go/internal/service/mock_service"
 	"github.com/go-chi/chi"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestHandler_AdminPuppiesHandler(t *testing.T) {
@@ -23,7 +26,7 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 	if err != nil {
 		t.Fatalf("не удалось изменить рабочую директорию: %v", err)
 	}
-	type mockBehavior func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog)
+	type mockBehavior func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog)
 
 	tests := []struct {
 		name            string
@@ -33,6 +36,7 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 		idPuppy         string
 		readyToMove     string
 		archived        bool
+		statuses        []string
 		page            int
 		totalpages      int
 		idParent        string
@@ -52,6 +56,7 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 			genders:         []string{},
 			readyToMove:     "",
 			archived:        false,
+			statuses:        []string{"available", "reserved", "returned"},
 			page:            1,
 			totalpages:      1,
 			archivedParents: false,
@@ -61,13 +66,13 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 					Name:      "PuppyTestGo",
 					Title:     "Example Puppy",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  false,
+					Status:    domain.PuppyStatusAvailable,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"http://Puppy.com", "http://Puppy2.com", "http://Puppy3.com"},
 				}, {
@@ -75,13 +80,13 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 					Name:      "PuppyTestArchivedTrue",
 					Title:     "Archived True",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  true,
+					Status:    domain.PuppyStatusSold,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"http://Puppy.com", "http://Puppy2.com", "http://Puppy3.com"},
 				},
@@ -106,13 +111,14 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 					Urls:     []string{"http://Dog.com", "http://Dog2.com", "http://Dog3.com"},
 				},
 			},
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, page, totalpages int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
-				s.EXPECT().PuppiesGet(chocolates, genders, idPuppy, readyToMove, page, archived).Return(
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, page, totalpages int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, "", domain.PuppyFilter{}, domain.Page{Number: page}).Return(
 					expectedPuppies, expectedReviews, totalpages, nil,
 				)
 				s.EXPECT().DogsGet(chocolates, genders, idPuppy, archivedParents).Return(
 					expectedParents, nil,
 				)
+				s.EXPECT().LittersGet().Return([]domain.Litter{}, nil)
 			},
 			expectedCode: http.StatusOK,
 		}, {
@@ -122,6 +128,7 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 			genders:         []string{},
 			readyToMove:     "",
 			archived:        true,
+			statuses:        []string{"sold"},
 			page:            1,
 			totalpages:      1,
 			archivedParents: false,
@@ -131,13 +138,13 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 					Name:      "PuppyTestGo",
 					Title:     "Example Puppy",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  true,
+					Status:    domain.PuppyStatusSold,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"http://PuppyTestGo.com", "http://PuppyTestGo2.com", "http://PuppyTestGo3.com"},
 				}, {
@@ -145,13 +152,13 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 					Name:      "PuppyTestTrue",
 					Title:     "PuppyTestTrue",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  true,
+					Status:    domain.PuppyStatusSold,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"PuppyTestTrue", "PuppyTestTrue2", "PuppyTestTrue3"},
 				},
@@ -180,8 +187,8 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 					Urls:     []string{"http://Dog.com", "http://Dog2.com", "http://Dog3.com"},
 				},
 			},
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
-				s.EXPECT().PuppiesGet(chocolates, genders, idPuppy, readyToMove, page, archived).Return(
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, "", domain.PuppyFilter{}, domain.Page{Number: page}).Return(
 					expectedPuppies, expectedReviews, totalpages, nil,
 				)
 				s.EXPECT().DogsGet(chocolates, genders, idPuppy, archivedParents).Return(
@@ -195,9 +202,9 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 			expectedPuppies: []domain.Puppy{},
 			expectedReviews: map[int]int{},
 			totalpages:      1,
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Return(expectedPuppies, expectedReviews, totalpages, errors.New("service error"))
 			},
 			expectedCode: http.StatusInternalServerError,
@@ -209,9 +216,9 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 			expectedParents: []domain.Dog{},
 			expectedReviews: map[int]int{},
 			totalpages:      1,
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Return(expectedPuppies, expectedReviews, totalpages, nil)
 				s.EXPECT().DogsGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -222,9 +229,9 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 		}, {
 			name: "Failure validate page 400",
 			url:  "/puppy?page=abc",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 				s.EXPECT().DogsGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -235,10 +242,10 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 		}, {
 			name: "Failure validate chocolates 400",
 			url:  "/puppy?chocolate=<script>alert(XSS)</script>&chocolate=Бивер",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
 				// Ожидаем, что метод PuppiesGet не будет вызываться
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 				s.EXPECT().DogsGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -249,10 +256,10 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 		}, {
 			name: "Failure validate gender 400",
 			url:  "/puppy?gender=SELECT",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
 				// Ожидаем, что метод PuppiesGet не будет вызываться
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 				s.EXPECT().DogsGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -263,10 +270,10 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 		}, {
 			name: "Failure validate readyToMove 400",
 			url:  "/puppy?readyToMove=Да",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove string, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int, idParent string, archivedParents bool, expectedParents []domain.Dog) {
 				// Ожидаем, что метод PuppiesGet не будет вызываться
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 				s.EXPECT().DogsGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
@@ -283,8 +290,10 @@ func TestHandler_AdminPuppiesHandler(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				mockServices.EXPECT().DictionariesGet().Return(testDictionaries, nil).AnyTimes()
 				test.mockBehavior(
-					mockServices, test.chocolates, test.genders, test.idPuppy, test.readyToMove, test.archived,
+					mockServices, test.chocolates, test.genders, test.statuses, test.idPuppy, test.readyToMove,
 					test.expectedPuppies, test.expectedReviews, test.totalpages, test.page, test.idParent,
 					test.archivedParents, test.expectedParents,
 				)
@@ -386,9 +395,10 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 				},
 			},
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedDogs []domain.Dog) {
-				s.EXPECT().DogsGet(chocolates, genders, idDog, archived).Return(
-					expectedDogs, nil,
+				s.EXPECT().DogsPageGet(chocolates, genders, archived, domain.Page{Number: page}).Return(
+					expectedDogs, totalpages, nil,
 				)
+				s.EXPECT().DogsAllGet().Return(expectedDogs, nil)
 			},
 			expectedCode: http.StatusOK,
 		}, {
@@ -420,9 +430,10 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 				},
 			},
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedDogs []domain.Dog) {
-				s.EXPECT().DogsGet(chocolates, genders, idDog, archived).Return(
-					expectedDogs, nil,
+				s.EXPECT().DogsPageGet(chocolates, genders, archived, domain.Page{Number: page}).Return(
+					expectedDogs, totalpages, nil,
 				)
+				s.EXPECT().DogsAllGet().Return(expectedDogs, nil)
 			},
 			expectedCode: http.StatusOK,
 		}, {
@@ -430,28 +441,50 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 			url:        "/archive/dogs",
 			totalpages: 1,
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
-				s.EXPECT().DogsGet(
+				s.EXPECT().DogsPageGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-				).Return(expectedParents, errors.New("service error"))
+				).Return(expectedParents, 0, errors.New("service error"))
 			},
 			expectedCode: http.StatusInternalServerError,
 			expectedBody: "Ошибка при получении данных о собаках",
+		}, {
+			name:       "Failure service DogsAllGet 500",
+			url:        "/dogs",
+			totalpages: 1,
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
+				s.EXPECT().DogsPageGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return([]domain.Dog{}, 1, nil)
+				s.EXPECT().DogsAllGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении списка всех собак",
 		}, {
 			name: "Failure validate page 400",
 			url:  "/dogs?page=abc",
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
-				s.EXPECT().DogsGet(
+				s.EXPECT().DogsPageGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
 			expectedCode: http.StatusBadRequest,
 			expectedBody: "Ошибка при обработке параметра страницы",
+		}, {
+			name: "Failure validate after 400",
+			url:  "/dogs?page=2&after=abc",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
+				s.EXPECT().DogsPageGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке параметра after",
 		}, {
 			name: "Failure validate chocolates 400",
 			url:  "/dogs?chocolate=<script>alert(XSS)</script>&chocolate=Бивер",
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
-				// Ожидаем, что метод DogsGet не будет вызываться
-				s.EXPECT().DogsGet(
+				// Ожидаем, что метод DogsPageGet не будет вызываться
+				s.EXPECT().DogsPageGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
@@ -461,8 +494,8 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 			name: "Failure validate gender 400",
 			url:  "/dogs?gender=SELECT",
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
-				// Ожидаем, что метод DogsGet не будет вызываться
-				s.EXPECT().DogsGet(
+				// Ожидаем, что метод DogsPageGet не будет вызываться
+				s.EXPECT().DogsPageGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
@@ -472,8 +505,8 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 			name: "Failure validate readyToMove 400",
 			url:  "/dogs?readyToMove=Да",
 			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idDog, readyToMove string, archived bool, page, totalpages int, expectedParents []domain.Dog) {
-				// Ожидаем, что метод DogsGet не будет вызываться
-				s.EXPECT().DogsGet(
+				// Ожидаем, что метод DogsPageGet не будет вызываться
+				s.EXPECT().DogsPageGet(
 					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
@@ -488,6 +521,8 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 				defer ctrl.Finish()
 
 				MockServices := mock_service.NewMockServices(ctrl)
+				MockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				MockServices.EXPECT().DictionariesGet().Return(testDictionaries, nil).AnyTimes()
 				test.mockBehavior(
 					MockServices, test.chocolates, test.genders, test.idDog, test.readyToMove, test.archived,
 					test.page, test.totalpages, test.expectedDogs,
@@ -548,3 +583,1569 @@ func TestHandler_AdminDogsHandler(t *testing.T) {
 		)
 	}
 }
+
+func TestHandler_AdminMatingHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog)
+
+	parents := []domain.Dog{
+		{ID: 1, Name: "DogTestMale", Gender: "Кобель", Color: "Черный"},
+		{ID: 2, Name: "DogTestGirl", Gender: "Сука", Color: "Черный"},
+	}
+
+	tests := []struct {
+		name            string
+		url             string
+		expectedCOI     *domain.COIResult
+		expectedParents []domain.Dog
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    string
+	}{
+		{
+			name:            "Correct 200 (empty form)",
+			url:             "/mating",
+			expectedParents: parents,
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().MatingCOIGet(gomock.Any(), gomock.Any()).Times(0)
+				s.EXPECT().DogsGet([]string{}, []string{}, "", false).Return(expectedParents, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "DogTestGirl",
+		}, {
+			name: "Correct 200 (pair)",
+			url:  "/mating?mother=2&father=1",
+			expectedCOI: &domain.COIResult{
+				SireID:      1,
+				DamID:       2,
+				Coefficient: 0.125,
+				CommonAncestors: []domain.CommonAncestor{
+					{Dog: domain.Dog{ID: 7, Name: "CommonGrandSire"}, Contribution: 0.125},
+				},
+			},
+			expectedParents: parents,
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().MatingCOIGet(1, 2).Return(expectedCOI, nil)
+				s.EXPECT().DogsGet([]string{}, []string{}, "", false).Return(expectedParents, nil)
+				s.EXPECT().ColorPredictionGet(1, 2).Return(
+					&domain.ColorPrediction{
+						Outcomes: []domain.ColorOutcome{
+							{Color: "Черный мерле", Probability: 0.5},
+							{Color: "Черный (двойной мерле)", Probability: 0.25},
+							{Color: "Черный", Probability: 0.25},
+						},
+						DoubleMerle: true,
+						Warnings:    []string{"Вязка мерле × мерле"},
+					}, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Вязка мерле × мерле недопустима",
+		}, {
+			name:            "Correct 200 (puppy)",
+			url:             "/mating?puppy=12",
+			expectedCOI:     &domain.COIResult{SireID: 1, DamID: 2},
+			expectedParents: parents,
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().PuppyCOIGet("12").Return(expectedCOI, nil)
+				s.EXPECT().DogsGet([]string{}, []string{}, "", false).Return(expectedParents, nil)
+				s.EXPECT().ColorPredictionGet(1, 2).Return(&domain.ColorPrediction{}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Общих предков в родословной не найдено",
+		}, {
+			name: "Failure validate parents 400",
+			url:  "/mating?mother=2&father=abc",
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().MatingCOIGet(gomock.Any(), gomock.Any()).Times(0)
+				s.EXPECT().DogsGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Неверный идентификатор родителя",
+		}, {
+			name: "Failure parents sexes 400",
+			url:  "/mating?mother=1&father=2",
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().MatingCOIGet(2, 1).Return(nil, domain.ErrMatingSexes)
+				s.EXPECT().DogsGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Мать должна быть сукой, а отец — кобелем",
+		}, {
+			name: "Failure service MatingCOIGet 500",
+			url:  "/mating?mother=2&father=1",
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().MatingCOIGet(1, 2).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при расчёте коэффициента инбридинга",
+		}, {
+			name:        "Failure service ColorPredictionGet 500",
+			url:         "/mating?mother=2&father=1",
+			expectedCOI: &domain.COIResult{SireID: 1, DamID: 2},
+			mockBehavior: func(s *mock_service.MockServices, expectedCOI *domain.COIResult, expectedParents []domain.Dog) {
+				s.EXPECT().MatingCOIGet(1, 2).Return(expectedCOI, nil)
+				s.EXPECT().DogsGet([]string{}, []string{}, "", false).Return(expectedParents, nil)
+				s.EXPECT().ColorPredictionGet(1, 2).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при расчёте окрасов щенков",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.expectedCOI, test.expectedParents)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/mating", handler.AdminMatingHandler)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCOI != nil {
+					for _, ancestor := range test.expectedCOI.CommonAncestors {
+						assert.Contains(t, body, ancestor.Dog.Name)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminPlannedMatingsHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, expectedMatings []domain.PlannedMating, expectedParents []domain.Dog)
+
+	parents := []domain.Dog{
+		{ID: 1, Name: "DogTestMale", Gender: "Кобель", Color: "Черный"},
+		{ID: 2, Name: "DogTestGirl", Gender: "Сука", Color: "Черный"},
+	}
+
+	tests := []struct {
+		name            string
+		expectedMatings []domain.PlannedMating
+		expectedParents []domain.Dog
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    string
+	}{
+		{
+			name: "Correct 200",
+			expectedMatings: []domain.PlannedMating{
+				{
+					ID:           4,
+					MotherID:     2,
+					FatherID:     1,
+					ExpectedDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+					Status:       domain.MatingStatusConfirmed,
+					Description:  "Осенний помёт",
+				}, {
+					ID:           5,
+					MotherID:     2,
+					FatherID:     1,
+					ExpectedDate: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+					Status:       domain.MatingStatusWhelped,
+					LitterID:     3,
+				},
+			},
+			expectedParents: parents,
+			mockBehavior: func(s *mock_service.MockServices, expectedMatings []domain.PlannedMating, expectedParents []domain.Dog) {
+				s.EXPECT().PlannedMatingsGet().Return(expectedMatings, nil)
+				s.EXPECT().DogsGet([]string{}, []string{}, "", false).Return(expectedParents, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "/litters/3",
+		}, {
+			name: "Failure service PlannedMatingsGet 500",
+			mockBehavior: func(s *mock_service.MockServices, expectedMatings []domain.PlannedMating, expectedParents []domain.Dog) {
+				s.EXPECT().PlannedMatingsGet().Return(nil, errors.New("service error"))
+				s.EXPECT().DogsGet(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении данных о вязках",
+		}, {
+			name:            "Failure service DogsGet 500",
+			expectedMatings: []domain.PlannedMating{},
+			mockBehavior: func(s *mock_service.MockServices, expectedMatings []domain.PlannedMating, expectedParents []domain.Dog) {
+				s.EXPECT().PlannedMatingsGet().Return(expectedMatings, nil)
+				s.EXPECT().DogsGet([]string{}, []string{}, "", false).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении данных о родителях",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.expectedMatings, test.expectedParents)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/matings", handler.AdminPlannedMatingsHandler)
+
+				req, err := http.NewRequest("GET", "/matings", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					for _, mating := range test.expectedMatings {
+						assert.Contains(t, body, mating.Description)
+					}
+					assert.Contains(t, body, "Вязка состоялась")
+					assert.Contains(t, body, "whelpMatingModal_4")
+					assert.NotContains(t, body, "whelpMatingModal_5")
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminBuyersHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, search string, expectedBuyers []domain.Buyer)
+
+	tests := []struct {
+		name           string
+		query          string
+		search         string
+		expectedBuyers []domain.Buyer
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   string
+	}{
+		{
+			name: "Correct 200",
+			expectedBuyers: []domain.Buyer{
+				{ID: 1, Name: "Анна Смирнова", Phones: []string{"+7 (999) 123-45-67"}, City: "Москва"},
+				{ID: 2, Phones: []string{"+7 (999) 765-43-21"}},
+			},
+			mockBehavior: func(s *mock_service.MockServices, search string, expectedBuyers []domain.Buyer) {
+				s.EXPECT().BuyersGet(search).Return(expectedBuyers, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Без имени",
+		}, {
+			name:           "Search 200",
+			query:          "?q=+999+123",
+			search:         "999 123",
+			expectedBuyers: []domain.Buyer{},
+			mockBehavior: func(s *mock_service.MockServices, search string, expectedBuyers []domain.Buyer) {
+				s.EXPECT().BuyersGet(search).Return(expectedBuyers, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Никого не нашлось",
+		}, {
+			name: "Failure service BuyersGet 500",
+			mockBehavior: func(s *mock_service.MockServices, search string, expectedBuyers []domain.Buyer) {
+				s.EXPECT().BuyersGet(search).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении данных о покупателях",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.search, test.expectedBuyers)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/buyers", handler.AdminBuyersHandler)
+
+				req, err := http.NewRequest("GET", "/buyers"+test.query, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					for _, buyer := range test.expectedBuyers {
+						assert.Contains(t, body, buyer.Name)
+						assert.Contains(t, body, fmt.Sprintf("/admin/buyers/%d", buyer.ID))
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminDictionariesHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, expectedDictionaries *domain.Dictionaries)
+
+	tests := []struct {
+		name                 string
+		expectedDictionaries *domain.Dictionaries
+		mockBehavior         mockBehavior
+		expectedCode         int
+		expectedBody         string
+	}{
+		{
+			name:                 "Correct 200",
+			expectedDictionaries: testDictionaries,
+			mockBehavior: func(s *mock_service.MockServices, expectedDictionaries *domain.Dictionaries) {
+				s.EXPECT().DictionariesGet().Return(expectedDictionaries, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Справочники",
+		}, {
+			name:                 "Empty dictionary 200",
+			expectedDictionaries: &domain.Dictionaries{},
+			mockBehavior: func(s *mock_service.MockServices, expectedDictionaries *domain.Dictionaries) {
+				s.EXPECT().DictionariesGet().Return(expectedDictionaries, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Записей пока нет",
+		}, {
+			name: "Failure service DictionariesGet 500",
+			mockBehavior: func(s *mock_service.MockServices, expectedDictionaries *domain.Dictionaries) {
+				s.EXPECT().DictionariesGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении справочников",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.expectedDictionaries)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/dictionaries", handler.AdminDictionariesHandler)
+
+				req, err := http.NewRequest("GET", "/dictionaries", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					for _, entry := range test.expectedDictionaries.Colors {
+						assert.Contains(t, body, entry.LabelRu)
+					}
+					for _, entry := range test.expectedDictionaries.Sexes {
+						assert.Contains(t, body, entry.LabelEn)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminBuyerHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idBuyer string, expectedBuyer *domain.Buyer)
+
+	tests := []struct {
+		name          string
+		idBuyer       string
+		expectedBuyer *domain.Buyer
+		mockBehavior  mockBehavior
+		expectedCode  int
+		expectedBody  string
+	}{
+		{
+			name:    "Correct 200",
+			idBuyer: "1",
+			expectedBuyer: &domain.Buyer{
+				ID:     1,
+				Name:   "Анна Смирнова",
+				Phones: []string{"+7 (999) 123-45-67", "+7 (999) 765-43-21"},
+				Email:  "anna@example.com",
+				Notes:  "Берут второго щенка",
+				Puppies: []domain.Puppy{
+					{ID: 3, Name: "PuppyTestSold", Status: domain.PuppyStatusSold, BuyerID: 1},
+					{ID: 4, Name: "PuppyTestReturned", Status: domain.PuppyStatusAvailable},
+				},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idBuyer string, expectedBuyer *domain.Buyer) {
+				s.EXPECT().BuyerGet(idBuyer).Return(expectedBuyer, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Был у покупателя",
+		}, {
+			name:    "Failure validate id 404",
+			idBuyer: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idBuyer string, expectedBuyer *domain.Buyer) {
+				s.EXPECT().BuyerGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор покупателя",
+		}, {
+			name:    "Not found 404",
+			idBuyer: "7",
+			mockBehavior: func(s *mock_service.MockServices, idBuyer string, expectedBuyer *domain.Buyer) {
+				s.EXPECT().BuyerGet(idBuyer).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Покупатель не найден",
+		}, {
+			name:    "Failure service BuyerGet 500",
+			idBuyer: "1",
+			mockBehavior: func(s *mock_service.MockServices, idBuyer string, expectedBuyer *domain.Buyer) {
+				s.EXPECT().BuyerGet(idBuyer).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении данных о покупателе",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idBuyer, test.expectedBuyer)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/buyers/{id}", handler.AdminBuyerHandler)
+
+				req, err := http.NewRequest("GET", "/buyers/"+test.idBuyer, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					// html/template экранирует "+" в номере
+					assert.Contains(t, body, "(999) 123-45-67")
+					assert.Contains(t, body, "(999) 765-43-21")
+					for _, puppy := range test.expectedBuyer.Puppies {
+						assert.Contains(t, body, puppy.Name)
+					}
+					assert.Contains(t, body, "Продан")
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminPuppyWaitlistHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedCandidates []domain.WaitlistCandidate)
+
+	puppy := &domain.Puppy{ID: 5, Name: "PuppyTest", Sex: "Сука", Color: "Шоколадный", Price: domain.Money{Amount: 12000000, Currency: domain.CurrencyRUB}, Status: domain.PuppyStatusAvailable}
+
+	tests := []struct {
+		name               string
+		idPuppy            string
+		expectedPuppy      *domain.Puppy
+		expectedCandidates []domain.WaitlistCandidate
+		mockBehavior       mockBehavior
+		expectedCode       int
+		expectedBody       string
+	}{
+		{
+			name:          "Correct 200",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			expectedCandidates: []domain.WaitlistCandidate{
+				{
+					Rank:         1,
+					Entry:        domain.WaitlistEntry{ID: 2, Name: "Первая Семья", Colors: []string{"Шоколадный"}, Active: true},
+					Notification: domain.WaitlistNotification{ID: 11, EntryID: 2, PuppyID: 5, SentAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+				}, {
+					Rank:         2,
+					Entry:        domain.WaitlistEntry{ID: 7, Name: "Вторая Семья", Gender: "Сука", Budget: 150000, Active: true},
+					Notification: domain.WaitlistNotification{ID: 12, EntryID: 7, PuppyID: 5},
+				},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedCandidates []domain.WaitlistCandidate) {
+				s.EXPECT().WaitlistCandidatesGet(idPuppy).Return(expectedPuppy, expectedCandidates, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Очередь на PuppyTest",
+		}, {
+			name:    "Failure validate id 404",
+			idPuppy: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedCandidates []domain.WaitlistCandidate) {
+				s.EXPECT().WaitlistCandidatesGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор щенка",
+		}, {
+			name:    "Not found 404",
+			idPuppy: "9",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedCandidates []domain.WaitlistCandidate) {
+				s.EXPECT().WaitlistCandidatesGet(idPuppy).Return(nil, nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Щенок не найден",
+		}, {
+			name:    "Failure service WaitlistCandidatesGet 500",
+			idPuppy: "5",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedCandidates []domain.WaitlistCandidate) {
+				s.EXPECT().WaitlistCandidatesGet(idPuppy).Return(nil, nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении очереди на щенка",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idPuppy, test.expectedPuppy, test.expectedCandidates)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/puppies/{id}/waitlist", handler.AdminPuppyWaitlistHandler)
+
+				req, err := http.NewRequest("GET", "/puppies/"+test.idPuppy+"/waitlist", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					for _, candidate := range test.expectedCandidates {
+						assert.Contains(t, body, candidate.Entry.Name)
+					}
+					assert.Contains(t, body, "Предложили 01.05.2024")
+					assert.Contains(t, body, `name="id" value="12"`)
+					assert.NotContains(t, body, `name="id" value="11"`)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminPuppyHealthHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedEvents []domain.HealthEvent)
+
+	puppy := &domain.Puppy{ID: 5, Name: "PuppyTest", Status: domain.PuppyStatusAvailable}
+
+	tests := []struct {
+		name           string
+		idPuppy        string
+		expectedPuppy  *domain.Puppy
+		expectedEvents []domain.HealthEvent
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   string
+	}{
+		{
+			name:          "Correct 200",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			expectedEvents: []domain.HealthEvent{
+				{
+					ID: 3, PuppyID: 5, Type: domain.HealthEventChip, Date: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+					Result: "643094100123456",
+				}, {
+					ID: 4, PuppyID: 5, Type: domain.HealthEventVaccination, Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+					Title: "Нобивак DHPPi", DocumentURL: "http://document.com/passport.pdf",
+				},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedEvents []domain.HealthEvent) {
+				s.EXPECT().PuppyGet(idPuppy).Return(expectedPuppy, nil, nil, nil)
+				s.EXPECT().HealthEventsGet(expectedPuppy.ID, 0).Return(expectedEvents, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Здоровье: PuppyTest",
+		}, {
+			name:    "Failure validate id 404",
+			idPuppy: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedEvents []domain.HealthEvent) {
+				s.EXPECT().PuppyGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор щенка",
+		}, {
+			name:    "Not found 404",
+			idPuppy: "9",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedEvents []domain.HealthEvent) {
+				s.EXPECT().PuppyGet(idPuppy).Return(nil, nil, nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Щенок не найден",
+		}, {
+			name:          "Failure service HealthEventsGet 500",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedEvents []domain.HealthEvent) {
+				s.EXPECT().PuppyGet(idPuppy).Return(expectedPuppy, nil, nil, nil)
+				s.EXPECT().HealthEventsGet(expectedPuppy.ID, 0).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении записей о здоровье щенка",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idPuppy, test.expectedPuppy, test.expectedEvents)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/puppies/{id}/health", handler.AdminPuppyHealthHandler)
+
+				req, err := http.NewRequest("GET", "/puppies/"+test.idPuppy+"/health", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					assert.Contains(t, body, "643094100123456")
+					assert.Contains(t, body, "Нобивак DHPPi")
+					assert.Contains(t, body, "http://document.com/passport.pdf")
+					assert.Contains(t, body, `name="puppy" value="5"`)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminPuppyWeightsHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedMeasurements []domain.WeightMeasurement)
+
+	puppy := &domain.Puppy{ID: 5, Name: "PuppyTest", Status: domain.PuppyStatusAvailable}
+
+	tests := []struct {
+		name                 string
+		idPuppy              string
+		expectedPuppy        *domain.Puppy
+		expectedMeasurements []domain.WeightMeasurement
+		mockBehavior         mockBehavior
+		expectedCode         int
+		expectedBody         string
+	}{
+		{
+			name:          "Correct 200",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			expectedMeasurements: []domain.WeightMeasurement{
+				{ID: 1, PuppyID: 5, Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Grams: 350},
+				{ID: 2, PuppyID: 5, Date: time.Date(2024, 5, 8, 0, 0, 0, 0, time.UTC), Grams: 780},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedMeasurements []domain.WeightMeasurement) {
+				s.EXPECT().PuppyGet(idPuppy).Return(expectedPuppy, nil, nil, nil)
+				s.EXPECT().PuppyWeightsGet(expectedPuppy.ID).Return(expectedMeasurements, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Взвешивания: PuppyTest",
+		}, {
+			name:    "Failure validate id 404",
+			idPuppy: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedMeasurements []domain.WeightMeasurement) {
+				s.EXPECT().PuppyGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор щенка",
+		}, {
+			name:    "Not found 404",
+			idPuppy: "9",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedMeasurements []domain.WeightMeasurement) {
+				s.EXPECT().PuppyGet(idPuppy).Return(nil, nil, nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Щенок не найден",
+		}, {
+			name:          "Failure service PuppyWeightsGet 500",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedMeasurements []domain.WeightMeasurement) {
+				s.EXPECT().PuppyGet(idPuppy).Return(expectedPuppy, nil, nil, nil)
+				s.EXPECT().PuppyWeightsGet(expectedPuppy.ID).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении взвешиваний щенка",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idPuppy, test.expectedPuppy, test.expectedMeasurements)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/puppies/{id}/weights", handler.AdminPuppyWeightsHandler)
+
+				req, err := http.NewRequest("GET", "/puppies/"+test.idPuppy+"/weights", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					assert.Contains(t, body, "08.05.2024")
+					assert.Contains(t, body, "<polyline")
+					assert.Contains(t, body, "01.05.2024: 350 г")
+					assert.Contains(t, body, `name="puppy" value="5"`)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminPuppyPricesHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedChanges []domain.PriceChange)
+
+	puppy := &domain.Puppy{ID: 5, Name: "PuppyTest", Price: domain.Money{Amount: 15000000, Currency: domain.CurrencyRUB}, Status: domain.PuppyStatusAvailable}
+
+	tests := []struct {
+		name            string
+		idPuppy         string
+		expectedPuppy   *domain.Puppy
+		expectedChanges []domain.PriceChange
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    string
+	}{
+		{
+			name:          "Correct 200",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			expectedChanges: []domain.PriceChange{
+				{
+					ID:        2,
+					PuppyID:   5,
+					OldPrice:  domain.Money{Amount: 12000000, Currency: domain.CurrencyRUB},
+					NewPrice:  domain.Money{Amount: 15000000, Currency: domain.CurrencyRUB},
+					ChangedAt: time.Date(2024, 6, 3, 14, 30, 0, 0, time.UTC),
+				}, {
+					ID:        1,
+					PuppyID:   5,
+					NewPrice:  domain.Money{Amount: 12000000, Currency: domain.CurrencyRUB},
+					ChangedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+				},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedChanges []domain.PriceChange) {
+				s.EXPECT().PuppyGet(idPuppy).Return(expectedPuppy, nil, nil, nil)
+				s.EXPECT().PriceHistoryGet(expectedPuppy.ID).Return(expectedChanges, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "История цены: PuppyTest",
+		}, {
+			name:    "Failure validate id 404",
+			idPuppy: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedChanges []domain.PriceChange) {
+				s.EXPECT().PuppyGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор щенка",
+		}, {
+			name:    "Not found 404",
+			idPuppy: "9",
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedChanges []domain.PriceChange) {
+				s.EXPECT().PuppyGet(idPuppy).Return(nil, nil, nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Щенок не найден",
+		}, {
+			name:          "Failure service PriceHistoryGet 500",
+			idPuppy:       "5",
+			expectedPuppy: puppy,
+			mockBehavior: func(s *mock_service.MockServices, idPuppy string, expectedPuppy *domain.Puppy, expectedChanges []domain.PriceChange) {
+				s.EXPECT().PuppyGet(idPuppy).Return(expectedPuppy, nil, nil, nil)
+				s.EXPECT().PriceHistoryGet(expectedPuppy.ID).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении истории цены щенка",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idPuppy, test.expectedPuppy, test.expectedChanges)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/puppies/{id}/prices", handler.AdminPuppyPricesHandler)
+
+				req, err := http.NewRequest("GET", "/puppies/"+test.idPuppy+"/prices", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					assert.Contains(t, body, "Текущая цена: 150\u00a0000\u00a0₽")
+					assert.Contains(t, body, "03.06.2024 14:30")
+					assert.Contains(t, body, "120\u00a0000\u00a0₽")
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminDogShowsHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idDog string, expectedDog *domain.Dog, expectedResults []domain.ShowResult)
+
+	dog := &domain.Dog{ID: 3, Name: "DogTest", Gender: "Сука", EarnedTitles: []string{"JCAC", "JCh"}}
+
+	tests := []struct {
+		name            string
+		idDog           string
+		expectedDog     *domain.Dog
+		expectedResults []domain.ShowResult
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    string
+	}{
+		{
+			name:        "Correct 200",
+			idDog:       "3",
+			expectedDog: dog,
+			expectedResults: []domain.ShowResult{
+				{
+					ID: 7, DogID: 3, Event: "Кубок Урала", Date: time.Date(2023, 9, 2, 0, 0, 0, 0, time.UTC),
+					Judge: "Петров П.П.", Grade: "Очень перспективный", Titles: []string{"JCAC", "JCh"},
+					CertificateURL: "http://certificate.com/jch.pdf",
+				},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idDog string, expectedDog *domain.Dog, expectedResults []domain.ShowResult) {
+				s.EXPECT().DogGet(idDog).Return(expectedDog, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return(expectedResults, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Выставки: DogTest",
+		}, {
+			name:  "Failure validate id 404",
+			idDog: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idDog string, expectedDog *domain.Dog, expectedResults []domain.ShowResult) {
+				s.EXPECT().DogGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор собаки",
+		}, {
+			name:  "Not found 404",
+			idDog: "9",
+			mockBehavior: func(s *mock_service.MockServices, idDog string, expectedDog *domain.Dog, expectedResults []domain.ShowResult) {
+				s.EXPECT().DogGet(idDog).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Собака не найдена",
+		}, {
+			name:        "Failure service ShowResultsGet 500",
+			idDog:       "3",
+			expectedDog: dog,
+			mockBehavior: func(s *mock_service.MockServices, idDog string, expectedDog *domain.Dog, expectedResults []domain.ShowResult) {
+				s.EXPECT().DogGet(idDog).Return(expectedDog, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при получении результатов выставок",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idDog, test.expectedDog, test.expectedResults)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/dogs/{id}/shows", handler.AdminDogShowsHandler)
+
+				req, err := http.NewRequest("GET", "/dogs/"+test.idDog+"/shows", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					assert.Contains(t, body, "JCh, JCAC")
+					assert.Contains(t, body, "Кубок Урала")
+					assert.Contains(t, body, "http://certificate.com/jch.pdf")
+					assert.Contains(t, body, `value="JCh" id="title2_7" checked`)
+					assert.Contains(t, body, `name="dog" value="3"`)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminArticlesHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, page int, expectedArticles []domain.Article)
+
+	tests := []struct {
+		name             string
+		url              string
+		page             int
+		expectedArticles []domain.Article
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedBody     []string
+	}{
+		{
+			name: "Correct 200",
+			url:  "/articles?page=2",
+			page: 2,
+			expectedArticles: []domain.Article{
+				{ID: 1, Title: "Уход за щенком", Slug: "uhod-za-schenkom", Published: true, PublishedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+				{ID: 2, Title: "Черновик статьи", Slug: "chernovik"},
+			},
+			mockBehavior: func(s *mock_service.MockServices, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet("", true, domain.Page{Number: page}).Return(expectedArticles, 2, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{"/admin/articles/1", "/admin/articles/2", "Черновик статьи", "Опубликована", "/admin/articles/new"},
+		}, {
+			name: "Failure service ArticlesGet 500",
+			url:  "/articles",
+			page: 1,
+			mockBehavior: func(s *mock_service.MockServices, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet("", true, domain.Page{Number: page}).Return(nil, 0, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка при получении статей"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.page, test.expectedArticles)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/articles", handler.AdminArticlesHandler)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminArticleHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article)
+
+	tests := []struct {
+		name            string
+		url             string
+		idArticle       string
+		expectedArticle *domain.Article
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    []string
+	}{
+		{
+			name: "Correct 200 (New article)",
+			url:  "/articles/new",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{"Новая статья", "/admin/articles/add"},
+		}, {
+			name:      "Correct 200 (Existing article)",
+			url:       "/articles/1",
+			idArticle: "1",
+			expectedArticle: &domain.Article{
+				ID: 1, Title: "Уход за щенком", Slug: "uhod-za-schenkom", Body: "## Кормление", Tags: []string{"щенки", "уход"},
+			},
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(expectedArticle, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{"/admin/articles/update", "uhod-za-schenkom", "щенки, уход", "## Кормление", "/admin/articles/1/preview"},
+		}, {
+			name:      "Failure validate id 404",
+			url:       "/articles/abc",
+			idArticle: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{"Неверный идентификатор статьи"},
+		}, {
+			name:      "Not found 404",
+			url:       "/articles/7",
+			idArticle: "7",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{"Статья не найдена"},
+		}, {
+			name:      "Failure service ArticleGet 500",
+			url:       "/articles/1",
+			idArticle: "1",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка при получении статьи"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idArticle, test.expectedArticle)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/articles/new", handler.AdminArticleHandler)
+				router.Get("/articles/{id}", handler.AdminArticleHandler)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminArticlePreviewHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article)
+
+	tests := []struct {
+		name            string
+		idArticle       string
+		expectedArticle *domain.Article
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    []string
+		unexpectedBody  []string
+	}{
+		{
+			name:      "Correct 200 (Draft)",
+			idArticle: "2",
+			expectedArticle: &domain.Article{
+				ID: 2, Title: "Черновик статьи", Slug: "chernovik", Body: "Текст *черновика*\n\n<script>alert(1)</script>",
+			},
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(expectedArticle, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"Черновик статьи", "Черновик", "Предпросмотр", "<em>черновика</em>", "/admin/articles/2"},
+			unexpectedBody: []string{"<script>alert(1)</script>"},
+		}, {
+			name:      "Failure validate id 404",
+			idArticle: "abc",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{"Неверный идентификатор статьи"},
+		}, {
+			name:      "Not found 404",
+			idArticle: "7",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{"Статья не найдена"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idArticle, test.expectedArticle)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/articles/{id}/preview", handler.AdminArticlePreviewHandler)
+
+				req, err := http.NewRequest("GET", "/articles/"+test.idArticle+"/preview", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminSettingsHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings)
+
+	tests := []struct {
+		name             string
+		expectedSettings *domain.SiteSettings
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedBody     []string
+	}{
+		{
+			name: "Correct 200",
+			expectedSettings: &domain.SiteSettings{
+				Phone:      "+7 900 111 22-33",
+				Telegram:   "https://t.me/kennel",
+				MapLat:     55.7963,
+				MapLng:     49.1088,
+				FooterText: "© 2026 Kennel",
+				UpdatedAt:  time.Date(2026, 10, 1, 12, 30, 0, 0, time.UTC),
+			},
+			mockBehavior: func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings) {
+				s.EXPECT().SiteSettingsGet().Return(expectedSettings, nil).MinTimes(1)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				"/admin/settings/update", `value="&#43;7 900 111 22-33"`, `value="https://t.me/kennel"`,
+				`value="55.7963"`, `value="49.1088"`, "Изменены 01.10.2026 12:30",
+			},
+		}, {
+			name: "Failure service SiteSettingsGet 500",
+			mockBehavior: func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings) {
+				s.EXPECT().SiteSettingsGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка при получении настроек сайта"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices, test.expectedSettings)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/settings", handler.AdminSettingsHandler)
+
+				req, err := http.NewRequest("GET", "/settings", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_AdminAPITokensHandler(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices)
+
+	tokens := []domain.APIToken{
+		{
+			ID: 1, Name: "Загрузка щенков", Prefix: "ebt_AbCdEfGh",
+			CreatedAt:  time.Date(2026, 9, 1, 10, 0, 0, 0, time.UTC),
+			LastUsedAt: time.Date(2026, 10, 2, 8, 15, 0, 0, time.UTC),
+		}, {
+			ID: 2, Name: "Старый скрипт", Prefix: "ebt_ZyXwVuTs",
+			CreatedAt: time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC),
+			RevokedAt: time.Date(2026, 8, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []struct {
+		name             string
+		method           string
+		path             string
+		body             string
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedLocation string
+		expectedBody     []string
+		unexpectedBody   []string
+	}{
+		{
+			name:   "Correct 200",
+			method: "GET",
+			path:   "/tokens",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				a.EXPECT().APITokensGet().Return(tokens, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				"Загрузка щенков", "ebt_AbCdEfGh…", "02.10.2026 08:15", "Старый скрипт", "01.08.2026 10:00",
+				`<input type="hidden" name="id" value="1">`, "/admin/tokens/add",
+			},
+			unexpectedBody: []string{`<input type="hidden" name="id" value="2">`, "Скопируйте его сейчас"},
+		}, {
+			name:   "Correct 200 (Token created)",
+			method: "POST",
+			path:   "/tokens/add",
+			body:   "name=+Загрузка щенков+",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				a.EXPECT().APITokenCreate("Загрузка щенков").Return("ebt_AbCdEfGhsecret", &tokens[0], nil)
+				a.EXPECT().APITokensGet().Return(tokens, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{"Токен «Загрузка щенков» выпущен", "ebt_AbCdEfGhsecret"},
+		}, {
+			name:         "Bad Request 400 (Empty token name)",
+			method:       "POST",
+			path:         "/tokens/add",
+			body:         "name=+",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"Invalid API token name"},
+		}, {
+			name:   "See Other 303 (Token revoked)",
+			method: "POST",
+			path:   "/tokens/revoke",
+			body:   "id=1",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				a.EXPECT().APITokenRevoke("1").Return(nil)
+			},
+			expectedCode:     http.StatusSeeOther,
+			expectedLocation: "/admin/tokens",
+		}, {
+			name:         "Bad Request 400 (Invalid token ID)",
+			method:       "POST",
+			path:         "/tokens/revoke",
+			body:         "id=abc",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"Invalid API token ID"},
+		}, {
+			name:   "See Other 303 (Admin form still redirects)",
+			method: "POST",
+			path:   "/puppies/delete",
+			body:   "id=7",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				s.EXPECT().PuppyDelete("7").Return(nil)
+			},
+			expectedCode:     http.StatusSeeOther,
+			expectedLocation: "/admin/puppies",
+		}, {
+			name:   "Failure service APITokensGet 500",
+			method: "GET",
+			path:   "/tokens",
+			mockBehavior: func(s *mock_service.MockServices, a *mock_service.MockAuthorizationServices) {
+				a.EXPECT().APITokensGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка при получении токенов API"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				mockAuth := mock_service.NewMockAuthorizationServices(ctrl)
+				test.mockBehavior(mockServices, mockAuth)
+
+				services := &service.Service{Services: mockServices, AuthorizationServices: mockAuth}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/tokens", handler.AdminAPITokensHandler)
+				router.Post("/tokens/add", handler.AddAPIToken)
+				router.Post("/tokens/revoke", handler.RevokeAPIToken)
+				router.Post("/puppies/delete", handler.DeletePuppy)
+
+				req, err := http.NewRequest(test.method, test.path, strings.NewReader(test.body))
+				assert.NoError(t, err)
+				req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+				assert.Equal(t, test.expectedLocation, rr.Header().Get("Location"))
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
@@ -10,16 +10,41 @@ import (
 	"github.com/egosha7/site-go/internal/service/mock_service"
 	"github.com/go-chi/chi"
 	"github.com/golang/mock/gomock"
+	"github.com/jackc/pgx/v4"
 	"github.com/stretchr/testify/assert"
 	"html/template"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
+// testDictionaries — справочники окрасов и пола в том виде, в каком они заведены миграцией.
+var testDictionaries = &domain.Dictionaries{
+	Breeds: []domain.DictionaryEntry{
+		{ID: 1, Kind: domain.DictionaryBreeds, Value: "yorkshire-terrier", LabelRu: "Йоркширский терьер", LabelEn: "Yorkshire Terrier", SortOrder: 10},
+	},
+	Colors: []domain.DictionaryEntry{
+		{ID: 1, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Классический", LabelRu: "Классический", LabelEn: "Classic", SortOrder: 10},
+		{ID: 2, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Шоколадный", LabelRu: "Шоколадный", LabelEn: "Chocolate", SortOrder: 20},
+		{ID: 3, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Черный", LabelRu: "Черный", LabelEn: "Black", SortOrder: 30},
+		{ID: 4, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Биро", LabelRu: "Биро", LabelEn: "Biro", SortOrder: 40},
+		{ID: 5, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Бивер", LabelRu: "Бивер", LabelEn: "Biewer", SortOrder: 50},
+		{ID: 6, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Голддаст", LabelRu: "Голддаст", LabelEn: "Golddust", SortOrder: 60},
+		{ID: 7, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Черный мерле", LabelRu: "Черный мерле", LabelEn: "Black merle", SortOrder: 70},
+		{ID: 8, Kind: domain.DictionaryColors, Breed: "yorkshire-terrier", Value: "Шоколадный мерле", LabelRu: "Шоколадный мерле", LabelEn: "Chocolate merle", SortOrder: 80},
+	},
+	Sexes: []domain.DictionaryEntry{
+		{ID: 1, Kind: domain.DictionarySexes, Value: "Кобель", LabelRu: "Кобель (мальчик)", LabelEn: "Male", SortOrder: 10},
+		{ID: 2, Kind: domain.DictionarySexes, Value: "Сука", LabelRu: "Сука (девочка)", LabelEn: "Female", SortOrder: 20},
+	},
+}
+
 func TestHandler_PuppiesView(t *testing.T) {
-	type mockBehavior func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int)
+	type mockBehavior func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int)
 
 	tests := []struct {
 		name            string
@@ -28,7 +53,12 @@ func TestHandler_PuppiesView(t *testing.T) {
 		genders         []string
 		idPuppy         string
 		readyToMove     string
+		breed           string
+		filter          domain.PuppyFilter
+		facets          *domain.PuppyFacets
+		facetsErr       error
 		archived        bool
+		statuses        []string
 		page            int
 		totalpages      int
 		expectedPuppies []domain.Puppy
@@ -45,6 +75,7 @@ func TestHandler_PuppiesView(t *testing.T) {
 			genders:     []string{},
 			readyToMove: "",
 			archived:    false,
+			statuses:    []string{"available", "reserved"},
 			page:        1,
 			totalpages:  1,
 			expectedPuppies: []domain.Puppy{
@@ -53,13 +84,13 @@ func TestHandler_PuppiesView(t *testing.T) {
 					Name:      "PuppyTestGo",
 					Title:     "Example Puppy",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  false,
+					Status:    domain.PuppyStatusAvailable,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"http://Puppy.com", "http://Puppy2.com", "http://Puppy3.com"},
 				}, {
@@ -67,20 +98,20 @@ func TestHandler_PuppiesView(t *testing.T) {
 					Name:      "PuppyTestArchivedTrue",
 					Title:     "Archived True",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  true,
+					Status:    domain.PuppyStatusSold,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"http://Puppy.com", "http://Puppy2.com", "http://Puppy3.com"},
 				},
 			},
 			expectedReviews: map[int]int{},
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, page, totalpages int) {
-				s.EXPECT().PuppiesGet(chocolates, genders, idPuppy, readyToMove, page, archived).Return(
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, page, totalpages int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
 					expectedPuppies, expectedReviews, totalpages, nil,
 				)
 			},
@@ -92,6 +123,7 @@ func TestHandler_PuppiesView(t *testing.T) {
 			genders:     []string{},
 			readyToMove: "",
 			archived:    true,
+			statuses:    []string{"sold"},
 			page:        1,
 			totalpages:  1,
 			expectedPuppies: []domain.Puppy{
@@ -100,13 +132,13 @@ func TestHandler_PuppiesView(t *testing.T) {
 					Name:      "PuppyTestGo",
 					Title:     "Example Puppy",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  true,
+					Status:    domain.PuppyStatusSold,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"http://PuppyTestGo.com", "http://PuppyTestGo2.com", "http://PuppyTestGo3.com"},
 				}, {
@@ -114,13 +146,13 @@ func TestHandler_PuppiesView(t *testing.T) {
 					Name:      "PuppyTestTrue",
 					Title:     "PuppyTestTrue",
 					Sex:       "Кобель",
-					Price:     "30000",
+					Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 					ReadyOut:  true,
-					Archived:  true,
+					Status:    domain.PuppyStatusSold,
 					City:      "Уфа",
 					MotherID:  1,
 					FatherID:  2,
-					DateBirth: "20.08.2002",
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 					Color:     "Черный",
 					Urls:      []string{"PuppyTestTrue", "PuppyTestTrue2", "PuppyTestTrue3"},
 				},
@@ -130,8 +162,8 @@ func TestHandler_PuppiesView(t *testing.T) {
 				3: 45,
 				4: 67,
 			},
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
-				s.EXPECT().PuppiesGet(chocolates, genders, idPuppy, readyToMove, page, archived).Return(
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
 					expectedPuppies, expectedReviews, totalpages, nil,
 				)
 			},
@@ -142,9 +174,9 @@ func TestHandler_PuppiesView(t *testing.T) {
 			expectedPuppies: []domain.Puppy{},
 			expectedReviews: map[int]int{},
 			totalpages:      1,
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Return(expectedPuppies, expectedReviews, totalpages, errors.New("service error"))
 			},
 			expectedCode: http.StatusInternalServerError,
@@ -152,9 +184,9 @@ func TestHandler_PuppiesView(t *testing.T) {
 		}, {
 			name: "Failure validate page 400",
 			url:  "/puppy?page=abc",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
 			expectedCode: http.StatusBadRequest,
@@ -162,10 +194,10 @@ func TestHandler_PuppiesView(t *testing.T) {
 		}, {
 			name: "Failure validate chocolates 400",
 			url:  "/puppy?chocolate=<script>alert(XSS)</script>&chocolate=Бивер",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
 				// Ожидаем, что метод PuppiesGet не будет вызываться
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
 			expectedCode: http.StatusBadRequest,
@@ -173,10 +205,10 @@ func TestHandler_PuppiesView(t *testing.T) {
 		}, {
 			name: "Failure validate gender 400",
 			url:  "/puppy?gender=SELECT",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
 				// Ожидаем, что метод PuppiesGet не будет вызываться
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
 			expectedCode: http.StatusBadRequest,
@@ -184,14 +216,271 @@ func TestHandler_PuppiesView(t *testing.T) {
 		}, {
 			name: "Failure validate readyToMove 400",
 			url:  "/puppy?readyToMove=Да",
-			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, idPuppy, readyToMove string, archived bool, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
 				// Ожидаем, что метод PuppiesGet не будет вызываться
 				s.EXPECT().PuppiesGet(
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 				).Times(0)
 			},
 			expectedCode: http.StatusBadRequest,
 			expectedBody: "Ошибка при обработке параметра readyToMove",
+		}, {
+			name:        "Correct 200 (readyToMove filter)",
+			url:         "/puppy?readyToMove=true",
+			chocolates:  []string{},
+			genders:     []string{},
+			statuses:    []string{"available", "reserved"},
+			readyToMove: "true",
+			page:        1,
+			totalpages:  1,
+			expectedPuppies: []domain.Puppy{
+				{
+					ID:           4,
+					Name:         "PuppyTestReady",
+					Title:        "Ready",
+					Sex:          "Кобель",
+					Status:       domain.PuppyStatusAvailable,
+					ReadyOut:     true,
+					ReadyOutDate: time.Now().AddDate(0, 0, -3),
+					DateBirth:    time.Now().AddDate(0, 0, -63),
+					Color:        "Черный",
+				},
+			},
+			expectedReviews: map[int]int{},
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
+					expectedPuppies, expectedReviews, totalpages, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "9 нед.",
+		}, {
+			name:        "Correct 200 (reserved filter)",
+			url:         "/puppy?status=reserved",
+			chocolates:  []string{},
+			genders:     []string{},
+			statuses:    []string{"reserved"},
+			readyToMove: "",
+			page:        1,
+			totalpages:  1,
+			expectedPuppies: []domain.Puppy{
+				{
+					ID:            3,
+					Name:          "PuppyTestReserved",
+					Title:         "Reserved",
+					Sex:           "Сука",
+					Status:        domain.PuppyStatusReserved,
+					ReservedUntil: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+					DateBirth:     time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
+					Color:         "Черный",
+				},
+			},
+			expectedReviews: map[int]int{},
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
+					expectedPuppies, expectedReviews, totalpages, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Забронирован",
+		}, {
+			name: "Failure validate status 400",
+			url:  "/puppy?status=sold",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				// Проданные щенки показываются только в архиве
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке параметра status",
+		}, {
+			name:        "Correct 200 (breed page)",
+			url:         "/breeds/yorkshire-terrier/puppies?chocolate=Черный",
+			chocolates:  []string{"Черный"},
+			genders:     []string{},
+			statuses:    []string{"available", "reserved"},
+			readyToMove: "",
+			breed:       "yorkshire-terrier",
+			page:        1,
+			totalpages:  2,
+			expectedPuppies: []domain.Puppy{
+				{
+					ID:        5,
+					Name:      "PuppyTestBreed",
+					Title:     "Yorkie",
+					Sex:       "Сука",
+					Breed:     "yorkshire-terrier",
+					Status:    domain.PuppyStatusAvailable,
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
+					Color:     "Черный",
+				},
+			},
+			expectedReviews: map[int]int{},
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
+					expectedPuppies, expectedReviews, totalpages, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "/breeds/yorkshire-terrier/puppies?page=2",
+		}, {
+			name:        "Correct 200 (sort and ranges)",
+			url:         "/puppy?sort=price_asc&priceFrom=50000&priceTo=120000&ageFrom=8&ageTo=16&city=%D0%A3%D1%84%D0%B0",
+			chocolates:  []string{},
+			genders:     []string{},
+			statuses:    []string{"available", "reserved"},
+			readyToMove: "",
+			filter: domain.PuppyFilter{
+				Sort:      domain.PuppySortPriceAsc,
+				PriceFrom: 50000,
+				PriceTo:   120000,
+				AgeFrom:   8,
+				AgeTo:     16,
+				City:      "Уфа",
+			},
+			page:       1,
+			totalpages: 2,
+			expectedPuppies: []domain.Puppy{
+				{
+					ID:        6,
+					Name:      "PuppyTestSorted",
+					Title:     "Sorted",
+					Sex:       "Кобель",
+					Price:     domain.Money{Amount: 8000000, Currency: domain.CurrencyRUB},
+					Status:    domain.PuppyStatusAvailable,
+					City:      "Уфа",
+					DateBirth: time.Now().AddDate(0, 0, -70),
+					Color:     "Черный",
+				},
+			},
+			expectedReviews: map[int]int{},
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
+					expectedPuppies, expectedReviews, totalpages, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "/puppies?page=2&amp;status=available&amp;status=reserved&amp;sort=price_asc&amp;priceFrom=50000&amp;priceTo=120000&amp;ageFrom=8&amp;ageTo=16&amp;city=%D0%A3%D1%84%D0%B0",
+		}, {
+			name: "Failure validate sort 400",
+			url:  "/puppy?sort=cheapest",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке параметра sort",
+		}, {
+			name: "Failure validate price range 400",
+			url:  "/puppy?priceFrom=100000&priceTo=50000",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке диапазона цены",
+		}, {
+			name: "Failure validate age range 400",
+			url:  "/puppy?ageTo=500",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке диапазона возраста",
+		}, {
+			name: "Failure validate city 400",
+			url:  "/puppy?city=%3Cscript%3E",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке параметра city",
+		}, {
+			name: "Failure validate after 400",
+			url:  "/puppy?page=2&after=-1",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке параметра after",
+		}, {
+			name:        "Correct 200 (facet counts)",
+			url:         "/puppy?gender=Кобель",
+			chocolates:  []string{},
+			genders:     []string{"Кобель"},
+			statuses:    []string{"available", "reserved"},
+			readyToMove: "",
+			page:        1,
+			totalpages:  1,
+			facets: &domain.PuppyFacets{
+				Colors: map[string]int{"Черный": 3},
+				Sexes:  map[string]int{"Кобель": 3, "Сука": 0},
+			},
+			expectedPuppies: []domain.Puppy{
+				{
+					ID:        7,
+					Name:      "PuppyTestFacets",
+					Title:     "Facets",
+					Sex:       "Кобель",
+					Status:    domain.PuppyStatusAvailable,
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
+					Color:     "Черный",
+				},
+			},
+			expectedReviews: map[int]int{},
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
+					expectedPuppies, expectedReviews, totalpages, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: `Черный <span class="text-muted">(3)</span>`,
+		}, {
+			name:        "Correct 200 (facets failure)",
+			url:         "/puppy",
+			chocolates:  []string{},
+			genders:     []string{},
+			statuses:    []string{"available", "reserved"},
+			readyToMove: "",
+			page:        1,
+			totalpages:  1,
+			facetsErr:   errors.New("service error"),
+			expectedPuppies: []domain.Puppy{
+				{
+					ID:        7,
+					Name:      "PuppyTestFacets",
+					Title:     "Facets",
+					Sex:       "Кобель",
+					Status:    domain.PuppyStatusAvailable,
+					DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
+					Color:     "Черный",
+				},
+			},
+			expectedReviews: map[int]int{},
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, domain.Page{Number: page}).Return(
+					expectedPuppies, expectedReviews, totalpages, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+		}, {
+			name: "Unknown breed 404",
+			url:  "/breeds/poodle/puppies",
+			mockBehavior: func(s *mock_service.MockServices, chocolates, genders []string, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, expectedPuppies []domain.Puppy, expectedReviews map[int]int, totalpages, page int) {
+				s.EXPECT().PuppiesGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
 		},
 	}
 	for _, test := range tests {
@@ -201,9 +490,19 @@ func TestHandler_PuppiesView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				mockServices.EXPECT().DictionariesGet().Return(testDictionaries, nil).AnyTimes()
+				mockServices.EXPECT().PuppyFacetsGet(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return(test.facets, test.facetsErr).AnyTimes()
+				// Без параметра sort каталог выводится сначала новыми
+				filter := test.filter
+				if filter.Sort == "" {
+					filter.Sort = domain.PuppySortNewest
+				}
 				test.mockBehavior(
-					mockServices, test.chocolates, test.genders, test.idPuppy, test.readyToMove, test.archived,
-					test.expectedPuppies, test.expectedReviews, test.totalpages, test.page,
+					mockServices, test.chocolates, test.genders, test.statuses, test.idPuppy, test.readyToMove, test.breed,
+					filter, test.expectedPuppies, test.expectedReviews, test.totalpages, test.page,
 				)
 
 				services := &service.Service{Services: mockServices}
@@ -217,12 +516,17 @@ func TestHandler_PuppiesView(t *testing.T) {
 				router := chi.NewRouter()
 				router.Get(
 					"/puppy", func(w http.ResponseWriter, r *http.Request) {
-						handler.PuppiesView(w, r, test.archived)
+						handler.PuppiesView(w, r, test.archived, "")
 					},
 				)
 				router.Get(
 					"/archive", func(w http.ResponseWriter, r *http.Request) {
-						handler.PuppiesView(w, r, test.archived)
+						handler.PuppiesView(w, r, test.archived, "")
+					},
+				)
+				router.Get(
+					"/breeds/{slug}/puppies", func(w http.ResponseWriter, r *http.Request) {
+						handler.PuppiesView(w, r, test.archived, chi.URLParam(r, "slug"))
 					},
 				)
 
@@ -245,6 +549,9 @@ func TestHandler_PuppiesView(t *testing.T) {
 				for _, puppy := range test.expectedPuppies {
 					assert.Contains(t, body, puppy.Name)
 					assert.Contains(t, body, puppy.Title)
+					if !test.archived {
+						assert.Contains(t, body, puppy.DateBirth.Format("02.01.2006"))
+					}
 					for _, url := range puppy.Urls {
 						assert.Contains(t, body, url)
 					}
@@ -265,6 +572,7 @@ func TestHandler_PuppyView(t *testing.T) {
 		name             string
 		inputIdPuppy     string
 		inputVerify      string
+		acceptLanguage   string
 		expectedPuppy    *domain.Puppy
 		expectedMother   *domain.Dog
 		expectedFather   *domain.Dog
@@ -284,13 +592,13 @@ func TestHandler_PuppyView(t *testing.T) {
 				Name:      "PuppyTestGo",
 				Title:     "Example Puppy",
 				Sex:       "Кобель",
-				Price:     "30000",
+				Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
 				ReadyOut:  true,
-				Archived:  false,
+				Status:    domain.PuppyStatusAvailable,
 				City:      "Уфа",
 				MotherID:  1,
 				FatherID:  2,
-				DateBirth: "20.08.2002",
+				DateBirth: time.Date(2002, 8, 20, 0, 0, 0, 0, time.UTC),
 				Color:     "Черный",
 				Urls:      []string{"http://Puppy.com", "http://Puppy2.com", "http://Puppy3.com"},
 			},
@@ -319,8 +627,102 @@ func TestHandler_PuppyView(t *testing.T) {
 				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(
 					&domain.Feedback{}, nil,
 				)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(
+					&domain.PedigreeNode{
+						Sire: &domain.PedigreeNode{
+							Dog: *expectedFather,
+							Dam: &domain.PedigreeNode{Dog: domain.Dog{ID: 7, Name: "GrandMother", External: true, Kennel: "Kennel"}},
+						},
+						Dam: &domain.PedigreeNode{Dog: *expectedMother},
+					}, nil,
+				)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().PuppyGrowthGet(inputIdPuppy).Return([]domain.GrowthSeries{}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "GrandMother",
+		}, {
+			name:           "Correct 200 (Health summary)",
+			inputIdPuppy:   "12",
+			inputVerify:    "true",
+			expectedPuppy:  &domain.Puppy{ID: 12, Name: "PuppyTestGo", Status: domain.PuppyStatusAvailable},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdPuppy string, inputVerify string, expectedPuppy *domain.Puppy, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().PuppyGet(inputIdPuppy).Return(expectedPuppy, expectedMother, expectedFather, nil)
+				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(nil, pgx.ErrNoRows)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(&domain.PedigreeNode{}, nil)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(
+					&domain.HealthSummary{
+						Chip: &domain.HealthEvent{
+							Type:   domain.HealthEventChip,
+							Date:   time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC),
+							Result: "643094100123456",
+						},
+						Vaccinations: []domain.HealthEvent{
+							{Type: domain.HealthEventVaccination, Date: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), Title: "Нобивак DHPPi"},
+						},
+					}, nil,
+				)
+				s.EXPECT().PuppyGrowthGet(inputIdPuppy).Return([]domain.GrowthSeries{}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "643094100123456",
+		}, {
+			name:           "Correct 200 (Growth chart)",
+			inputIdPuppy:   "12",
+			inputVerify:    "true",
+			expectedPuppy:  &domain.Puppy{ID: 12, Name: "PuppyTestGo", Status: domain.PuppyStatusAvailable},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdPuppy string, inputVerify string, expectedPuppy *domain.Puppy, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().PuppyGet(inputIdPuppy).Return(expectedPuppy, expectedMother, expectedFather, nil)
+				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(nil, pgx.ErrNoRows)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(&domain.PedigreeNode{}, nil)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().PuppyGrowthGet(inputIdPuppy).Return(
+					[]domain.GrowthSeries{
+						{
+							PuppyID: 12,
+							Name:    "PuppyTestGo",
+							Measurements: []domain.WeightMeasurement{
+								{PuppyID: 12, Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Grams: 350},
+								{PuppyID: 12, Date: time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC), Grams: 1250},
+							},
+						}, {
+							PuppyID: 13,
+							Name:    "LittermateTestGo",
+							Measurements: []domain.WeightMeasurement{
+								{PuppyID: 13, Date: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Grams: 320},
+							},
+						},
+					}, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "LittermateTestGo",
+		}, {
+			name:           "Correct 200 (Price in visitor locale)",
+			inputIdPuppy:   "12",
+			inputVerify:    "true",
+			acceptLanguage: "en-US,en;q=0.9,ru;q=0.8",
+			expectedPuppy: &domain.Puppy{
+				ID:     12,
+				Name:   "PuppyTestGo",
+				Price:  domain.Money{Amount: 125050, Currency: domain.CurrencyEUR},
+				Status: domain.PuppyStatusAvailable,
+			},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdPuppy string, inputVerify string, expectedPuppy *domain.Puppy, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().PuppyGet(inputIdPuppy).Return(expectedPuppy, expectedMother, expectedFather, nil)
+				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(nil, pgx.ErrNoRows)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(&domain.PedigreeNode{}, nil)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().PuppyGrowthGet(inputIdPuppy).Return([]domain.GrowthSeries{}, nil)
 			},
 			expectedCode: http.StatusOK,
+			expectedBody: "Цена: €1,250.50",
 		}, {
 			name:           "Not Found 404 (Puppy ID > 1000)",
 			inputIdPuppy:   "1234",
@@ -382,6 +784,57 @@ func TestHandler_PuppyView(t *testing.T) {
 			},
 			expectedCode: http.StatusInternalServerError,
 			expectedBody: "Ошибка сервиса: не удалось получить информацию об отзыве",
+		}, {
+			name:           "Bad Request 500 (Service PuppyPedigreeGet failure)",
+			inputIdPuppy:   "123",
+			inputVerify:    "true",
+			expectedPuppy:  &domain.Puppy{},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdPuppy string, inputVerify string, expectedPuppy *domain.Puppy, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().PuppyGet(inputIdPuppy).Return(expectedPuppy, expectedMother, expectedFather, nil)
+				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(
+					&domain.Feedback{}, nil,
+				)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить родословную щенка",
+		}, {
+			name:           "Bad Request 500 (Service PuppyHealthGet failure)",
+			inputIdPuppy:   "123",
+			inputVerify:    "true",
+			expectedPuppy:  &domain.Puppy{},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdPuppy string, inputVerify string, expectedPuppy *domain.Puppy, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().PuppyGet(inputIdPuppy).Return(expectedPuppy, expectedMother, expectedFather, nil)
+				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(
+					&domain.Feedback{}, nil,
+				)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(&domain.PedigreeNode{}, nil)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить сведения о здоровье щенка",
+		}, {
+			name:           "Bad Request 500 (Service PuppyGrowthGet failure)",
+			inputIdPuppy:   "123",
+			inputVerify:    "true",
+			expectedPuppy:  &domain.Puppy{},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdPuppy string, inputVerify string, expectedPuppy *domain.Puppy, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().PuppyGet(inputIdPuppy).Return(expectedPuppy, expectedMother, expectedFather, nil)
+				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(
+					&domain.Feedback{}, nil,
+				)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(&domain.PedigreeNode{}, nil)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().PuppyGrowthGet(inputIdPuppy).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить график роста щенка",
 		}, {
 			name:           "Bad Request 500 (Template execute failure)",
 			inputIdPuppy:   "123",
@@ -399,6 +852,9 @@ func TestHandler_PuppyView(t *testing.T) {
 				s.EXPECT().FeedbackGet(inputIdPuppy, inputVerify).Return(
 					&domain.Feedback{}, nil,
 				)
+				s.EXPECT().PuppyPedigreeGet(expectedPuppy).Return(&domain.PedigreeNode{}, nil)
+				s.EXPECT().PuppyHealthGet(inputIdPuppy).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().PuppyGrowthGet(inputIdPuppy).Return([]domain.GrowthSeries{}, nil)
 			},
 			expectedCode: http.StatusInternalServerError,
 			expectedBody: "Ошибка сервера: не удалось отобразить страницу",
@@ -411,6 +867,7 @@ func TestHandler_PuppyView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
 				test.mockBehavior(
 					mockServices, test.inputIdPuppy, test.inputVerify, test.expectedPuppy, test.expectedMother,
 					test.expectedFather,
@@ -434,6 +891,9 @@ func TestHandler_PuppyView(t *testing.T) {
 
 				req, err := http.NewRequest("GET", "/puppy/"+test.inputIdPuppy, nil)
 				assert.NoError(t, err)
+				if test.acceptLanguage != "" {
+					req.Header.Set("Accept-Language", test.acceptLanguage)
+				}
 
 				rr := httptest.NewRecorder()
 				router.ServeHTTP(rr, req)
@@ -470,65 +930,1120 @@ func TestHandler_PuppyView(t *testing.T) {
 	}
 }
 
-func TestHandler_ReviewsView(t *testing.T) {
-	type mockBehavior func(s *mock_service.MockServices, idReview string, checked bool, expectedReviews []domain.Feedback, expectedPuppyNames map[int]string)
+func TestHandler_DogView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode)
 
 	tests := []struct {
-		name               string
-		url                string
-		page               string
-		checked            bool
-		idReview           string
-		expectedReviews    []domain.Feedback
-		expectedPuppyNames map[int]string
-		mockBehavior       mockBehavior
-		expectedCode       int
-		expectedError      error
-		expectedBody       string
+		name             string
+		inputIdDog       string
+		expectedDog      *domain.Dog
+		expectedPedigree *domain.PedigreeNode
+		setup            func(h *handlers.Handler)
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedBody     string
 	}{
 		{
-			name:    "Correct 200",
-			url:     "/feedback",
-			page:    "1",
-			checked: true,
-			expectedReviews: []domain.Feedback{
-				{ID: 1, Name: "Review 1"},
-				{ID: 2, Name: "Review 2"},
+			name:       "Correct 200",
+			inputIdDog: "5",
+			expectedDog: &domain.Dog{
+				ID:     5,
+				Name:   "DogTestGo",
+				Title:  "Example Dog",
+				Gender: "Сука",
+				Color:  "Черный",
+				SireID: 8,
+				DamID:  9,
+				Urls:   []string{"http://Dog.com", "http://Dog2.com"},
 			},
-			expectedPuppyNames: map[int]string{
-				1: "Puppy 1",
-				2: "Puppy 2",
+			expectedPedigree: &domain.PedigreeNode{
+				Dog: domain.Dog{ID: 5, Name: "DogTestGo"},
+				Sire: &domain.PedigreeNode{
+					Dog: domain.Dog{ID: 8, Name: "SireTest", External: true, Kennel: "Other Kennel"},
+				},
+				Dam: &domain.PedigreeNode{
+					Dog: domain.Dog{ID: 9, Name: "DamTest"},
+					Sire: &domain.PedigreeNode{
+						Dog: domain.Dog{ID: 10, Name: "GrandSireTest"},
+					},
+				},
 			},
-			mockBehavior: func(s *mock_service.MockServices, idReview string, checked bool, expectedReviews []domain.Feedback, expectedPuppyNames map[int]string) {
-				s.EXPECT().ReviewsGet(idReview, checked).Return(expectedReviews, expectedPuppyNames, nil)
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return([]domain.ShowResult{}, nil)
+				s.EXPECT().DogHealthGet(expectedDog.ID).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().DogOffspringGet(expectedDog.ID).Return([]domain.Puppy{}, nil)
 			},
 			expectedCode: http.StatusOK,
-		},
-	}
-
-	for _, test := range tests {
-		t.Run(
-			test.name, func(t *testing.T) {
-				ctrl := gomock.NewController(t)
-				defer ctrl.Finish()
-
-				mockServices := mock_service.NewMockServices(ctrl)
-				test.mockBehavior(
-					mockServices, test.idReview, test.checked, test.expectedReviews, test.expectedPuppyNames,
+			expectedBody: "/dogs/10",
+		}, {
+			name:       "Correct 200 (Show results)",
+			inputIdDog: "6",
+			expectedDog: &domain.Dog{
+				ID:           6,
+				Name:         "ShowDogTestGo",
+				Gender:       "Кобель",
+				EarnedTitles: []string{"CAC", "CACIB", "JCh", "CACIB"},
+			},
+			expectedPedigree: &domain.PedigreeNode{Dog: domain.Dog{ID: 6, Name: "ShowDogTestGo"}},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return(
+					[]domain.ShowResult{
+						{
+							ID: 1, DogID: 6, Event: "Евразия 2024", Date: time.Date(2024, 3, 16, 0, 0, 0, 0, time.UTC),
+							Judge: "Иванова И.И.", Grade: "Отлично", Titles: []string{"CACIB", "CAC"},
+						},
+					}, nil,
 				)
-
-				services := &service.Service{Services: mockServices}
-				logger2, err := logger.SetupLogger()
-				if err != nil {
+				s.EXPECT().DogHealthGet(expectedDog.ID).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().DogOffspringGet(expectedDog.ID).Return([]domain.Puppy{}, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "JCh, 2×CACIB, CAC",
+		}, {
+			name:       "Correct 200 (Retired dog with health tests and offspring)",
+			inputIdDog: "7",
+			expectedDog: &domain.Dog{
+				ID:       7,
+				Name:     "RetiredDogTestGo",
+				Gender:   "Сука",
+				Archived: true,
+			},
+			expectedPedigree: &domain.PedigreeNode{Dog: domain.Dog{ID: 7, Name: "RetiredDogTestGo"}},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return([]domain.ShowResult{}, nil)
+				s.EXPECT().DogHealthGet(expectedDog.ID).Return(
+					&domain.HealthSummary{
+						GeneticTests: []domain.HealthEvent{
+							{
+								ID: 1, DogID: 7, Type: domain.HealthEventGeneticTest, Date: time.Date(2023, 5, 2, 0, 0, 0, 0, time.UTC),
+								Title: "PRA-prcd", Result: "N/N",
+							},
+						},
+					}, nil,
+				)
+				s.EXPECT().DogOffspringGet(expectedDog.ID).Return(
+					[]domain.Puppy{
+						{ID: 31, Name: "OffspringTestGo", Sex: "Кобель", MotherID: 7, Status: domain.PuppyStatusSold},
+					}, nil,
+				)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "На пенсии",
+		}, {
+			name:             "Not Found 404 (Dog ID with string)",
+			inputIdDog:       "abc",
+			expectedDog:      &domain.Dog{},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(gomock.Any()).Times(0)
+				s.EXPECT().DogPedigreeGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор собаки",
+		}, {
+			name:             "Not Found page (Dog does not exist)",
+			inputIdDog:       "15",
+			expectedDog:      &domain.Dog{},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(nil, pgx.ErrNoRows)
+				s.EXPECT().DogPedigreeGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Данной страницы не существует",
+		}, {
+			name:             "Bad Request 500 (Service DogGet failure)",
+			inputIdDog:       "5",
+			expectedDog:      &domain.Dog{},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить информацию о собаке",
+		}, {
+			name:             "Bad Request 500 (Service DogPedigreeGet failure)",
+			inputIdDog:       "5",
+			expectedDog:      &domain.Dog{},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить родословную собаки",
+		}, {
+			name:             "Bad Request 500 (Service ShowResultsGet failure)",
+			inputIdDog:       "5",
+			expectedDog:      &domain.Dog{ID: 5},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить результаты выставок собаки",
+		}, {
+			name:             "Bad Request 500 (Service DogHealthGet failure)",
+			inputIdDog:       "5",
+			expectedDog:      &domain.Dog{ID: 5},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return([]domain.ShowResult{}, nil)
+				s.EXPECT().DogHealthGet(expectedDog.ID).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить сведения о здоровье собаки",
+		}, {
+			name:             "Bad Request 500 (Service DogOffspringGet failure)",
+			inputIdDog:       "5",
+			expectedDog:      &domain.Dog{ID: 5},
+			expectedPedigree: &domain.PedigreeNode{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return([]domain.ShowResult{}, nil)
+				s.EXPECT().DogHealthGet(expectedDog.ID).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().DogOffspringGet(expectedDog.ID).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить потомков собаки",
+		}, {
+			name:             "Bad Request 500 (Template execute failure)",
+			inputIdDog:       "5",
+			expectedDog:      &domain.Dog{},
+			expectedPedigree: &domain.PedigreeNode{},
+			setup: func(h *handlers.Handler) {
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			mockBehavior: func(s *mock_service.MockServices, inputIdDog string, expectedDog *domain.Dog, expectedPedigree *domain.PedigreeNode) {
+				s.EXPECT().DogGet(inputIdDog).Return(expectedDog, nil)
+				s.EXPECT().DogPedigreeGet(inputIdDog).Return(expectedPedigree, nil)
+				s.EXPECT().ShowResultsGet(expectedDog.ID).Return([]domain.ShowResult{}, nil)
+				s.EXPECT().DogHealthGet(expectedDog.ID).Return(&domain.HealthSummary{}, nil)
+				s.EXPECT().DogOffspringGet(expectedDog.ID).Return([]domain.Puppy{}, nil)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервера: не удалось отобразить страницу",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.inputIdDog, test.expectedDog, test.expectedPedigree)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/dogs/{id}", handler.DogView)
+
+				req, err := http.NewRequest("GET", "/dogs/"+test.inputIdDog, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				assert.Contains(t, body, test.expectedDog.Name)
+				assert.Contains(t, body, test.expectedDog.Title)
+				for _, url := range test.expectedDog.Urls {
+					assert.Contains(t, body, url)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_DogsView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, dogs, retired []domain.Dog)
+
+	tests := []struct {
+		name           string
+		dogs           []domain.Dog
+		retired        []domain.Dog
+		setup          func(h *handlers.Handler)
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   []string
+		unexpectedBody []string
+	}{
+		{
+			name: "Correct 200",
+			dogs: []domain.Dog{
+				{ID: 5, Name: "DogTestGo", Gender: "Сука", Color: "Черный", Urls: []string{"http://Dog.com"}},
+				{ID: 8, Name: "ExternalDogTestGo", Gender: "Кобель", External: true, Kennel: "Other Kennel"},
+			},
+			retired: []domain.Dog{
+				{ID: 6, Name: "RetiredDogTestGo", Gender: "Кобель", Archived: true},
+			},
+			mockBehavior: func(s *mock_service.MockServices, dogs, retired []domain.Dog) {
+				s.EXPECT().DogsGet(nil, nil, "", false).Return(dogs, nil)
+				s.EXPECT().DogsGet(nil, nil, "", true).Return(retired, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"/dogs/5", "http://Dog.com", "/dogs/6", "RetiredDogTestGo", "На пенсии"},
+			unexpectedBody: []string{"ExternalDogTestGo"},
+		}, {
+			name: "Correct 200 (No retired dogs)",
+			dogs: []domain.Dog{
+				{ID: 5, Name: "DogTestGo", Gender: "Сука"},
+			},
+			retired: []domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, dogs, retired []domain.Dog) {
+				s.EXPECT().DogsGet(nil, nil, "", false).Return(dogs, nil)
+				s.EXPECT().DogsGet(nil, nil, "", true).Return(retired, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"/dogs/5"},
+			unexpectedBody: []string{"На пенсии"},
+		}, {
+			name: "Bad Request 500 (Service DogsGet failure)",
+			mockBehavior: func(s *mock_service.MockServices, dogs, retired []domain.Dog) {
+				s.EXPECT().DogsGet(nil, nil, "", false).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка сервиса: не удалось получить список собак"},
+		}, {
+			name: "Bad Request 500 (Service DogsGet retired failure)",
+			mockBehavior: func(s *mock_service.MockServices, dogs, retired []domain.Dog) {
+				s.EXPECT().DogsGet(nil, nil, "", false).Return([]domain.Dog{}, nil)
+				s.EXPECT().DogsGet(nil, nil, "", true).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка сервиса: не удалось получить список собак на пенсии"},
+		}, {
+			name: "Bad Request 500 (Template execute failure)",
+			setup: func(h *handlers.Handler) {
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			mockBehavior: func(s *mock_service.MockServices, dogs, retired []domain.Dog) {
+				s.EXPECT().DogsGet(nil, nil, "", false).Return([]domain.Dog{}, nil)
+				s.EXPECT().DogsGet(nil, nil, "", true).Return([]domain.Dog{}, nil)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка сервера: не удалось отобразить страницу"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.dogs, test.retired)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/dogs", handler.DogsView)
+
+				req, err := http.NewRequest("GET", "/dogs", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_LitterView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, inputIdLitter string, expectedLitter *domain.Litter, expectedMother *domain.Dog, expectedFather *domain.Dog)
+
+	tests := []struct {
+		name           string
+		inputIdLitter  string
+		expectedLitter *domain.Litter
+		expectedMother *domain.Dog
+		expectedFather *domain.Dog
+		setup          func(h *handlers.Handler)
+		mockBehavior   mockBehavior
+		expectedCode   int
+		expectedBody   string
+	}{
+		{
+			name:          "Correct 200",
+			inputIdLitter: "3",
+			expectedLitter: &domain.Litter{
+				ID:           3,
+				MotherID:     1,
+				FatherID:     2,
+				DateBirth:    time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+				ReadyOutDate: time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC),
+				Description:  "Весенний помёт",
+				Puppies: []domain.Puppy{
+					{
+						ID:        12,
+						Name:      "PuppyTestGo",
+						Title:     "Example Puppy",
+						Sex:       "Кобель",
+						Price:     domain.Money{Amount: 3000000, Currency: domain.CurrencyRUB},
+						MotherID:  1,
+						FatherID:  2,
+						DateBirth: time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+						Color:     "Черный",
+						LitterID:  3,
+						Urls:      []string{"http://Puppy.com"},
+					},
+				},
+			},
+			expectedMother: &domain.Dog{
+				ID:     1,
+				Name:   "Mother",
+				Title:  "Example Mother",
+				Gender: "Сука",
+				Color:  "Черный",
+				Urls:   []string{"http://Mother.com"},
+			},
+			expectedFather: &domain.Dog{
+				ID:     2,
+				Name:   "Father",
+				Title:  "Example Father",
+				Gender: "Кобель",
+				Color:  "Черный",
+				Urls:   []string{"http://Father.com"},
+			},
+			mockBehavior: func(s *mock_service.MockServices, inputIdLitter string, expectedLitter *domain.Litter, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().LitterGet(inputIdLitter).Return(expectedLitter, expectedMother, expectedFather, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Весенний помёт",
+		}, {
+			name:           "Not Found 404 (Litter ID with string)",
+			inputIdLitter:  "abc",
+			expectedLitter: &domain.Litter{},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdLitter string, expectedLitter *domain.Litter, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().LitterGet(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Неверный идентификатор помёта",
+		}, {
+			name:           "Bad Request 500 (Service LitterGet failure)",
+			inputIdLitter:  "3",
+			expectedLitter: &domain.Litter{},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			mockBehavior: func(s *mock_service.MockServices, inputIdLitter string, expectedLitter *domain.Litter, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().LitterGet(inputIdLitter).Return(nil, nil, nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить информацию о помёте",
+		}, {
+			name:           "Bad Request 500 (Template execute failure)",
+			inputIdLitter:  "3",
+			expectedLitter: &domain.Litter{},
+			expectedMother: &domain.Dog{},
+			expectedFather: &domain.Dog{},
+			setup: func(h *handlers.Handler) {
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			mockBehavior: func(s *mock_service.MockServices, inputIdLitter string, expectedLitter *domain.Litter, expectedMother *domain.Dog, expectedFather *domain.Dog) {
+				s.EXPECT().LitterGet(inputIdLitter).Return(expectedLitter, expectedMother, expectedFather, nil)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервера: не удалось отобразить страницу",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(
+					mockServices, test.inputIdLitter, test.expectedLitter, test.expectedMother, test.expectedFather,
+				)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/litters/{id}", handler.LitterView)
+
+				req, err := http.NewRequest("GET", "/litters/"+test.inputIdLitter, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				for _, puppy := range test.expectedLitter.Puppies {
+					assert.Contains(t, body, puppy.Name)
+				}
+				assert.Contains(t, body, test.expectedMother.Name)
+				assert.Contains(t, body, test.expectedFather.Name)
+			},
+		)
+	}
+}
+
+func TestHandler_UpcomingLittersView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, expectedLitters []domain.UpcomingLitter)
+
+	tests := []struct {
+		name            string
+		expectedLitters []domain.UpcomingLitter
+		setup           func(h *handlers.Handler)
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    string
+	}{
+		{
+			name: "Correct 200",
+			expectedLitters: []domain.UpcomingLitter{
+				{
+					Mating: domain.PlannedMating{
+						ID:           4,
+						MotherID:     1,
+						FatherID:     2,
+						ExpectedDate: time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC),
+						Status:       domain.MatingStatusPregnant,
+						Description:  "Осенний помёт",
+					},
+					Mother: domain.Dog{ID: 1, Name: "Mother", Gender: "Сука", Urls: []string{"http://Mother.com"}},
+					Father: domain.Dog{ID: 2, Name: "Father", Gender: "Кобель", Urls: []string{"http://Father.com"}},
+				},
+			},
+			mockBehavior: func(s *mock_service.MockServices, expectedLitters []domain.UpcomingLitter) {
+				s.EXPECT().UpcomingLittersGet().Return(expectedLitters, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Беременность подтверждена",
+		}, {
+			name:            "Correct 200 (empty)",
+			expectedLitters: []domain.UpcomingLitter{},
+			mockBehavior: func(s *mock_service.MockServices, expectedLitters []domain.UpcomingLitter) {
+				s.EXPECT().UpcomingLittersGet().Return(expectedLitters, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "В данный момент здесь пусто",
+		}, {
+			name: "Bad Request 500 (Service UpcomingLittersGet failure)",
+			mockBehavior: func(s *mock_service.MockServices, expectedLitters []domain.UpcomingLitter) {
+				s.EXPECT().UpcomingLittersGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервиса: не удалось получить планируемые помёты",
+		}, {
+			name:            "Bad Request 500 (Template execute failure)",
+			expectedLitters: []domain.UpcomingLitter{},
+			setup: func(h *handlers.Handler) {
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			mockBehavior: func(s *mock_service.MockServices, expectedLitters []domain.UpcomingLitter) {
+				s.EXPECT().UpcomingLittersGet().Return(expectedLitters, nil)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка сервера: не удалось отобразить страницу",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.expectedLitters)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/litters/upcoming", handler.UpcomingLittersView)
+
+				req, err := http.NewRequest("GET", "/litters/upcoming", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					for _, litter := range test.expectedLitters {
+						assert.Contains(t, body, litter.Mother.Name)
+						assert.Contains(t, body, litter.Father.Name)
+						assert.Contains(t, body, litter.Mating.Description)
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_SearchView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult)
+
+	tests := []struct {
+		name            string
+		query           string
+		expectedQuery   string
+		expectedResults []domain.SearchResult
+		setup           func(h *handlers.Handler)
+		mockBehavior    mockBehavior
+		expectedCode    int
+		expectedBody    string
+	}{
+		{
+			name:          "Correct 200",
+			query:         "  Бусинка  ",
+			expectedQuery: "Бусинка",
+			expectedResults: []domain.SearchResult{
+				{Kind: domain.SearchKindPuppy, ID: 7, Title: "Бусинка", Snippet: "⟦Бусинка⟧. Шоколадная девочка", Rank: 1.2},
+				{Kind: domain.SearchKindDog, ID: 2, Title: "Буся", Snippet: "Буся. Мама ⟦Бусинки⟧", Rank: 0.4},
+			},
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(query).Return(expectedResults, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "<mark>Бусинка</mark>. Шоколадная девочка",
+		}, {
+			name:          "Snippet is escaped 200",
+			query:         "скрипт",
+			expectedQuery: "скрипт",
+			expectedResults: []domain.SearchResult{
+				{Kind: domain.SearchKindReview, ID: 7, Title: "Бусинка", Snippet: "<script>alert(1)</script> ⟦скрипт⟧"},
+			},
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(query).Return(expectedResults, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "&lt;script&gt;alert(1)&lt;/script&gt; <mark>скрипт</mark>",
+		}, {
+			name:            "Nothing found 200",
+			query:           "пудель",
+			expectedQuery:   "пудель",
+			expectedResults: []domain.SearchResult{},
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(query).Return(expectedResults, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "ничего не нашлось",
+		}, {
+			name: "Empty query 200",
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "Поиск по сайту",
+		}, {
+			name:  "Failure validate query 400",
+			query: strings.Repeat("щенок ", 20),
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: "Ошибка при обработке поискового запроса",
+		}, {
+			name:          "Failure service 500",
+			query:         "Бусинка",
+			expectedQuery: "Бусинка",
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(query).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка при поиске",
+		}, {
+			name:            "Template failure execute 500",
+			query:           "Бусинка",
+			expectedQuery:   "Бусинка",
+			expectedResults: []domain.SearchResult{},
+			setup: func(h *handlers.Handler) {
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			mockBehavior: func(s *mock_service.MockServices, query string, expectedResults []domain.SearchResult) {
+				s.EXPECT().Search(query).Return(expectedResults, nil)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка вывода страницы поиска",
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.expectedQuery, test.expectedResults)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/search", handler.SearchView)
+
+				req, err := http.NewRequest("GET", "/search?"+url.Values{"q": {test.query}}.Encode(), nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				// Выводим тело ответа для отладки
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+
+				if test.expectedCode == http.StatusOK {
+					assert.NotContains(t, body, "<script>alert")
+					for _, result := range test.expectedResults {
+						assert.Contains(t, body, result.Title)
+						assert.Contains(t, body, result.URL())
+					}
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_ReviewsView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idReview string, checked bool, page domain.Page, totalPages int, expectedReviews []domain.Feedback, expectedPuppyNames map[int]string)
+
+	tests := []struct {
+		name               string
+		url                string
+		page               string
+		checked            bool
+		idReview           string
+		expectedPage       domain.Page
+		totalPages         int
+		expectedReviews    []domain.Feedback
+		expectedPuppyNames map[int]string
+		mockBehavior       mockBehavior
+		expectedCode       int
+		expectedError      error
+		expectedBody       string
+	}{
+		{
+			name:         "Correct 200",
+			url:          "/feedback",
+			page:         "1",
+			checked:      true,
+			expectedPage: domain.Page{Number: 1},
+			totalPages:   1,
+			expectedReviews: []domain.Feedback{
+				{ID: 1, Name: "Review 1"},
+				{ID: 2, Name: "Review 2"},
+			},
+			expectedPuppyNames: map[int]string{
+				1: "Puppy 1",
+				2: "Puppy 2",
+			},
+			mockBehavior: func(s *mock_service.MockServices, idReview string, checked bool, page domain.Page, totalPages int, expectedReviews []domain.Feedback, expectedPuppyNames map[int]string) {
+				s.EXPECT().ReviewsGet(idReview, checked, page).Return(expectedReviews, expectedPuppyNames, totalPages, nil)
+			},
+			expectedCode: http.StatusOK,
+		}, {
+			name:         "Correct 200 (keyset page)",
+			url:          "/feedback?page=2&after=5",
+			checked:      true,
+			expectedPage: domain.Page{Number: 2, After: 5},
+			totalPages:   3,
+			expectedReviews: []domain.Feedback{
+				{ID: 4, Name: "Review 4"},
+				{ID: 3, Name: "Review 3"},
+			},
+			expectedPuppyNames: map[int]string{},
+			mockBehavior: func(s *mock_service.MockServices, idReview string, checked bool, page domain.Page, totalPages int, expectedReviews []domain.Feedback, expectedPuppyNames map[int]string) {
+				s.EXPECT().ReviewsGet(idReview, checked, page).Return(expectedReviews, expectedPuppyNames, totalPages, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: "/reviews?page=3&amp;after=3",
+		}, {
+			name:         "Invalid after falls back to page number",
+			url:          "/feedback?page=2&after=abc",
+			checked:      true,
+			expectedPage: domain.Page{Number: 2},
+			totalPages:   2,
+			expectedReviews: []domain.Feedback{
+				{ID: 2, Name: "Review 2"},
+			},
+			expectedPuppyNames: map[int]string{},
+			mockBehavior: func(s *mock_service.MockServices, idReview string, checked bool, page domain.Page, totalPages int, expectedReviews []domain.Feedback, expectedPuppyNames map[int]string) {
+				s.EXPECT().ReviewsGet(idReview, checked, page).Return(expectedReviews, expectedPuppyNames, totalPages, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(
+					mockServices, test.idReview, test.checked, test.expectedPage, test.totalPages, test.expectedReviews,
+					test.expectedPuppyNames,
+				)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				router := chi.NewRouter()
+				router.Get("/feedback", handler.ReviewsView)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+				for _, review := range test.expectedReviews {
+					assert.Contains(t, body, review.Name)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_MainView(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(h *handlers.Handler)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Correct 200",
+			expectedCode: http.StatusOK,
+			expectedBody: "html",
+		},
+		{
+			name: "Template failure parse 500",
+			setup: func(h *handlers.Handler) {
+				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
+					return template.New(""), errors.New("template parse error")
+				}
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка вывода главной страницы",
+		}, {
+			name: "Template failure execute 500",
+			setup: func(h *handlers.Handler) {
+				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
+					return template.New("index"), nil
+				}
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка вывода главной страницы",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/", handler.MainView)
+
+				req, err := http.NewRequest("GET", "/", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+			},
+		)
+	}
+}
+
+func TestHandler_AuthView(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(h *handlers.Handler)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Correct 200",
+			expectedCode: http.StatusOK,
+			expectedBody: "html",
+		},
+		{
+			name: "Template failure parse 500",
+			setup: func(h *handlers.Handler) {
+				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
+					return template.New(""), errors.New("template parse error")
+				}
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка вывода страницы авторизации",
+		}, {
+			name: "Template failure execute 500",
+			setup: func(h *handlers.Handler) {
+				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
+					return template.New("index"), nil
+				}
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка вывода страницы авторизации",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/auth", handler.AuthView)
+
+				req, err := http.NewRequest("GET", "/auth", nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				if rr.Code != test.expectedCode {
+					fmt.Printf("Response body: %s\n", body)
+				}
+
+				assert.Contains(t, body, test.expectedBody)
+			},
+		)
+	}
+}
+
+func TestHandler_NewFeedbackView(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(h *handlers.Handler)
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Correct 200",
+			expectedCode: http.StatusOK,
+			expectedBody: "html",
+		}, {
+			name: "Template failure execute 500",
+			setup: func(h *handlers.Handler) {
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
+				}
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: "Ошибка вывода страницы для добавления отзыва",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
 					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
 				}
 
 				handler := handlers.NewHandler(services, logger2)
 
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
 				router := chi.NewRouter()
-				router.Get("/feedback", handler.ReviewsView)
+				router.Get("/feedback/new", handler.NewFeedbackView)
 
-				req, err := http.NewRequest("GET", test.url, nil)
+				req, err := http.NewRequest("GET", "/feedback/new", nil)
 				assert.NoError(t, err)
 
 				rr := httptest.NewRecorder()
@@ -542,50 +2057,70 @@ func TestHandler_ReviewsView(t *testing.T) {
 				}
 
 				assert.Contains(t, body, test.expectedBody)
-				for _, review := range test.expectedReviews {
-					assert.Contains(t, body, review.Name)
-				}
 			},
 		)
 	}
 }
 
-func TestHandler_MainView(t *testing.T) {
+func TestHandler_ContactsView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings)
+
 	tests := []struct {
-		name         string
-		setup        func(h *handlers.Handler)
-		expectedCode int
-		expectedBody string
+		name             string
+		expectedSettings *domain.SiteSettings
+		setup            func(h *handlers.Handler)
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedBody     []string
+		unexpectedBody   []string
 	}{
 		{
-			name:         "Correct 200",
+			name: "Correct 200",
+			expectedSettings: &domain.SiteSettings{
+				Phone:          "+7 900 111 22-33",
+				Email:          "kennel@example.com",
+				Address:        "Россия, г. Казань",
+				OpeningHours:   "Ежедневно с 10:00 до 20:00",
+				Telegram:       "https://t.me/kennel",
+				VK:             "https://vk.com/kennel",
+				MapLat:         55.7963,
+				MapLng:         49.1088,
+				FooterText:     "© 2026 Kennel",
+				SEOTitle:       "Питомник Kennel",
+				SEODescription: "Йоркширские терьеры из питомника",
+			},
+			mockBehavior: func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings) {
+				s.EXPECT().SiteSettingsGet().Return(expectedSettings, nil).Times(1)
+			},
 			expectedCode: http.StatusOK,
-			expectedBody: "html",
-		},
-		{
-			name: "Template failure parse 500",
-			setup: func(h *handlers.Handler) {
-				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
-					return template.New(""), errors.New("template parse error")
-				}
+			expectedBody: []string{
+				"tel:&#43;79001112233", "7 900 111 22-33", "mailto:kennel@example.com", "Ежедневно с 10:00 до 20:00",
+				"https://t.me/kennel", "https://vk.com/kennel", "Россия, г. Казань", "pt=49.1088,55.7963",
+				"© 2026 Kennel", "<title>Питомник Kennel</title>", `content="Йоркширские терьеры из питомника"`,
 			},
-			expectedCode: http.StatusInternalServerError,
-			expectedBody: "Ошибка вывода главной страницы",
+			unexpectedBody: []string{"Instagram", "What's App", "79613678583"},
 		}, {
-			name: "Template failure execute 500",
+			name: "Correct 200 (Default settings on service failure)",
+			mockBehavior: func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings) {
+				s.EXPECT().SiteSettingsGet().Return(nil, errors.New("service error"))
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"7 961 367 85-83", "Россия, пгт. Иглино (г.Уфа)", "<title>Elza Breeder</title>"},
+			unexpectedBody: []string{"yandex.ru/map-widget"},
+		}, {
+			name: "Bad Request 500 (Template execute failure)",
 			setup: func(h *handlers.Handler) {
-				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
-					return template.New("index"), nil
-				}
 				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
 					return errors.New("template execute error")
 				}
 			},
+			mockBehavior: func(s *mock_service.MockServices, expectedSettings *domain.SiteSettings) {
+				s.EXPECT().SiteSettingsGet().Times(0)
+			},
 			expectedCode: http.StatusInternalServerError,
-			expectedBody: "Ошибка вывода главной страницы",
+			expectedBody: []string{"Ошибка вывода страницы контактов"},
 		},
 	}
-
 	for _, test := range tests {
 		t.Run(
 			test.name, func(t *testing.T) {
@@ -593,6 +2128,8 @@ func TestHandler_MainView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				test.mockBehavior(mockServices, test.expectedSettings)
+
 				services := &service.Service{Services: mockServices}
 				logger2, err := logger.SetupLogger()
 				if err != nil {
@@ -607,9 +2144,9 @@ func TestHandler_MainView(t *testing.T) {
 				}
 
 				router := chi.NewRouter()
-				router.Get("/", handler.MainView)
+				router.Get("/contacts", handler.ContactsView)
 
-				req, err := http.NewRequest("GET", "/", nil)
+				req, err := http.NewRequest("GET", "/contacts", nil)
 				assert.NoError(t, err)
 
 				rr := httptest.NewRecorder()
@@ -618,49 +2155,234 @@ func TestHandler_MainView(t *testing.T) {
 				assert.Equal(t, test.expectedCode, rr.Code)
 
 				body := rr.Body.String()
-				if rr.Code != test.expectedCode {
-					fmt.Printf("Response body: %s\n", body)
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
 				}
-
-				assert.Contains(t, body, test.expectedBody)
 			},
 		)
 	}
 }
 
-func TestHandler_AuthView(t *testing.T) {
+func TestHandler_ArticlesView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, tag string, page int, expectedArticles []domain.Article)
+
+	articles := []domain.Article{
+		{ID: 1, Title: "Уход за щенком", Slug: "uhod-za-schenkom", Tags: []string{"щенки"}, Published: true, PublishedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, Title: "Первая выставка", Slug: "pervaya-vystavka", Tags: []string{"выставки"}, Published: true, PublishedAt: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
 	tests := []struct {
-		name         string
-		setup        func(h *handlers.Handler)
-		expectedCode int
-		expectedBody string
+		name             string
+		url              string
+		tag              string
+		page             int
+		expectedArticles []domain.Article
+		setup            func(h *handlers.Handler)
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedBody     []string
 	}{
 		{
-			name:         "Correct 200",
+			name:             "Correct 200",
+			url:              "/articles",
+			page:             1,
+			expectedArticles: articles,
+			mockBehavior: func(s *mock_service.MockServices, tag string, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet(tag, false, domain.Page{Number: page}).Return(expectedArticles, 1, nil)
+			},
 			expectedCode: http.StatusOK,
-			expectedBody: "html",
-		},
-		{
-			name: "Template failure parse 500",
+			expectedBody: []string{"/article/uhod-za-schenkom", "Уход за щенком", "/article/pervaya-vystavka"},
+		}, {
+			name:             "Correct 200 (Tag and page)",
+			url:              "/articles?tag=%D1%89%D0%B5%D0%BD%D0%BA%D0%B8&page=2",
+			tag:              "щенки",
+			page:             2,
+			expectedArticles: articles[:1],
+			mockBehavior: func(s *mock_service.MockServices, tag string, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet(tag, false, domain.Page{Number: page}).Return(expectedArticles, 3, nil)
+			},
+			expectedCode: http.StatusOK,
+			expectedBody: []string{"/article/uhod-za-schenkom", "page=3"},
+		}, {
+			name: "Bad Request 400 (Invalid tag)",
+			url:  "/articles?tag=%3Cscript%3E",
+			mockBehavior: func(s *mock_service.MockServices, tag string, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedBody: []string{"Неверный тег статьи"},
+		}, {
+			name: "Bad Request 500 (Service ArticlesGet failure)",
+			url:  "/articles",
+			page: 1,
+			mockBehavior: func(s *mock_service.MockServices, tag string, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet(tag, false, domain.Page{Number: page}).Return(nil, 0, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка сервиса: не удалось получить статьи"},
+		}, {
+			name: "Bad Request 500 (Template execute failure)",
+			url:  "/articles",
+			page: 1,
 			setup: func(h *handlers.Handler) {
-				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
-					return template.New(""), errors.New("template parse error")
+				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
+					return errors.New("template execute error")
 				}
 			},
+			expectedArticles: articles,
+			mockBehavior: func(s *mock_service.MockServices, tag string, page int, expectedArticles []domain.Article) {
+				s.EXPECT().ArticlesGet(tag, false, domain.Page{Number: page}).Return(expectedArticles, 1, nil)
+			},
 			expectedCode: http.StatusInternalServerError,
-			expectedBody: "Ошибка вывода страницы авторизации",
+			expectedBody: []string{"Ошибка вывода страницы со статьями"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+
+				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.tag, test.page, test.expectedArticles)
+
+				services := &service.Service{Services: mockServices}
+				logger2, err := logger.SetupLogger()
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Ошибка создания логгера: %v\n", err)
+				}
+
+				handler := handlers.NewHandler(services, logger2)
+
+				// Настройка перед каждым тестом
+				if test.setup != nil {
+					test.setup(handler)
+				}
+
+				router := chi.NewRouter()
+				router.Get("/articles", handler.ArticlesView)
+
+				req, err := http.NewRequest("GET", test.url, nil)
+				assert.NoError(t, err)
+
+				rr := httptest.NewRecorder()
+				router.ServeHTTP(rr, req)
+
+				assert.Equal(t, test.expectedCode, rr.Code)
+
+				body := rr.Body.String()
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+			},
+		)
+	}
+}
+
+func TestHandler_ArticleView(t *testing.T) {
+	type mockBehavior func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article)
+
+	article := &domain.Article{
+		ID:          1,
+		Title:       "Уход за щенком",
+		Slug:        "uhod-za-schenkom",
+		Body:        "## Кормление\n\nКормите **четыре раза** в день.\n\n<script>alert(1)</script>\n\n[ссылка](javascript:alert(1))",
+		Tags:        []string{"щенки"},
+		Published:   true,
+		PublishedAt: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+	draft := &domain.Article{ID: 2, Title: "Черновик", Slug: "chernovik", Body: "Текст"}
+
+	tests := []struct {
+		name             string
+		idArticle        string
+		expectedArticle  *domain.Article
+		setup            func(h *handlers.Handler)
+		mockBehavior     mockBehavior
+		expectedCode     int
+		expectedLocation string
+		expectedBody     []string
+		unexpectedBody   []string
+	}{
+		{
+			name:            "Correct 200",
+			idArticle:       "uhod-za-schenkom",
+			expectedArticle: article,
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGetBySlug(idArticle).Return(expectedArticle, nil)
+			},
+			expectedCode:   http.StatusOK,
+			expectedBody:   []string{"Уход за щенком", "<h2", "<strong>четыре раза</strong>", "/articles?tag="},
+			unexpectedBody: []string{"<script>alert(1)</script>", "javascript:"},
 		}, {
-			name: "Template failure execute 500",
+			name:            "Moved 301 (Numeric id)",
+			idArticle:       "1",
+			expectedArticle: article,
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(expectedArticle, nil)
+			},
+			expectedCode:     http.StatusMovedPermanently,
+			expectedLocation: "/article/uhod-za-schenkom",
+		}, {
+			name:      "Not Found 404 (Unknown slug)",
+			idArticle: "net-takoy-stati",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGetBySlug(idArticle).Return(nil, nil)
+			},
+			expectedCode: http.StatusNotFound,
+		}, {
+			name:      "Not Found 404 (Unknown id)",
+			idArticle: "7",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGet(idArticle).Return(nil, pgx.ErrNoRows)
+			},
+			expectedCode: http.StatusNotFound,
+		}, {
+			name:            "Not Found 404 (Draft)",
+			idArticle:       "chernovik",
+			expectedArticle: draft,
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGetBySlug(idArticle).Return(expectedArticle, nil)
+			},
+			expectedCode:   http.StatusNotFound,
+			unexpectedBody: []string{"Черновик"},
+		}, {
+			name:      "Not Found 404 (Invalid slug)",
+			idArticle: "Uhod_Za",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGetBySlug(gomock.Any()).Times(0)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedBody: []string{"Неверный адрес статьи"},
+		}, {
+			name:      "Bad Request 500 (Service ArticleGetBySlug failure)",
+			idArticle: "uhod-za-schenkom",
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGetBySlug(idArticle).Return(nil, errors.New("service error"))
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка сервиса: не удалось получить статью"},
+		}, {
+			name:            "Bad Request 500 (Template execute failure)",
+			idArticle:       "uhod-za-schenkom",
+			expectedArticle: article,
 			setup: func(h *handlers.Handler) {
-				h.ParseTemplate = func(filenames ...string) (*template.Template, error) {
-					return template.New("index"), nil
-				}
 				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
-					return errors.New("template execute error")
+					if name == "article" {
+						return errors.New("template execute error")
+					}
+					return t.ExecuteTemplate(w, name, data)
 				}
 			},
+			mockBehavior: func(s *mock_service.MockServices, idArticle string, expectedArticle *domain.Article) {
+				s.EXPECT().ArticleGetBySlug(idArticle).Return(expectedArticle, nil)
+			},
 			expectedCode: http.StatusInternalServerError,
-			expectedBody: "Ошибка вывода страницы авторизации",
+			expectedBody: []string{"Ошибка вывода страницы статьи"},
 		},
 	}
 
@@ -671,6 +2393,9 @@ func TestHandler_AuthView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				test.mockBehavior(mockServices, test.idArticle, test.expectedArticle)
+
 				services := &service.Service{Services: mockServices}
 				logger2, err := logger.SetupLogger()
 				if err != nil {
@@ -685,28 +2410,36 @@ func TestHandler_AuthView(t *testing.T) {
 				}
 
 				router := chi.NewRouter()
-				router.Get("/auth", handler.AuthView)
+				router.Get("/article/{id}", handler.ArticleView)
 
-				req, err := http.NewRequest("GET", "/auth", nil)
+				req, err := http.NewRequest("GET", "/article/"+test.idArticle, nil)
 				assert.NoError(t, err)
 
 				rr := httptest.NewRecorder()
 				router.ServeHTTP(rr, req)
 
 				assert.Equal(t, test.expectedCode, rr.Code)
+				if test.expectedLocation != "" {
+					assert.Equal(t, test.expectedLocation, rr.Header().Get("Location"))
+				}
 
 				body := rr.Body.String()
 				if rr.Code != test.expectedCode {
 					fmt.Printf("Response body: %s\n", body)
 				}
 
-				assert.Contains(t, body, test.expectedBody)
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
+				}
+				for _, unexpected := range test.unexpectedBody {
+					assert.NotContains(t, body, unexpected)
+				}
 			},
 		)
 	}
 }
 
-func TestHandler_NewFeedbackView(t *testing.T) {
+func TestHandler_NotFoundView(t *testing.T) {
 	tests := []struct {
 		name         string
 		setup        func(h *handlers.Handler)
@@ -714,8 +2447,8 @@ func TestHandler_NewFeedbackView(t *testing.T) {
 		expectedBody string
 	}{
 		{
-			name:         "Correct 200",
-			expectedCode: http.StatusOK,
+			name:         "Correct 404",
+			expectedCode: http.StatusNotFound,
 			expectedBody: "html",
 		}, {
 			name: "Template failure execute 500",
@@ -724,8 +2457,8 @@ func TestHandler_NewFeedbackView(t *testing.T) {
 					return errors.New("template execute error")
 				}
 			},
-			expectedCode: http.StatusInternalServerError,
-			expectedBody: "Ошибка вывода страницы для добавления отзыва",
+			expectedCode: http.StatusNotFound,
+			expectedBody: "Ошибка вывода страницы для кода 404",
 		},
 	}
 
@@ -736,6 +2469,7 @@ func TestHandler_NewFeedbackView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
 				services := &service.Service{Services: mockServices}
 				logger2, err := logger.SetupLogger()
 				if err != nil {
@@ -750,9 +2484,17 @@ func TestHandler_NewFeedbackView(t *testing.T) {
 				}
 
 				router := chi.NewRouter()
-				router.Get("/feedback/new", handler.NewFeedbackView)
+				router.Get(
+					"/test", func(w http.ResponseWriter, r *http.Request) {},
+				)
+				// Обработчик для 404
+				router.NotFound(
+					func(w http.ResponseWriter, r *http.Request) {
+						handler.NotFoundView(w, r)
+					},
+				)
 
-				req, err := http.NewRequest("GET", "/feedback/new", nil)
+				req, err := http.NewRequest("GET", "/article", nil)
 				assert.NoError(t, err)
 
 				rr := httptest.NewRecorder()
@@ -771,9 +2513,10 @@ func TestHandler_NewFeedbackView(t *testing.T) {
 	}
 }
 
-func TestHandler_ArticleView(t *testing.T) {
+func TestHandler_WaitlistView(t *testing.T) {
 	tests := []struct {
 		name         string
+		query        string
 		setup        func(h *handlers.Handler)
 		expectedCode int
 		expectedBody string
@@ -781,7 +2524,21 @@ func TestHandler_ArticleView(t *testing.T) {
 		{
 			name:         "Correct 200",
 			expectedCode: http.StatusOK,
-			expectedBody: "html",
+			expectedBody: "Шоколадный мерле",
+		}, {
+			name:         "Joined 200",
+			query:        "?joined=true",
+			expectedCode: http.StatusOK,
+			expectedBody: "Вы в листе ожидания",
+		}, {
+			name:         "Breed 200",
+			query:        "?breed=yorkshire-terrier",
+			expectedCode: http.StatusOK,
+			expectedBody: `<option value="yorkshire-terrier" selected>Йоркширский терьер</option>`,
+		}, {
+			name:         "Unknown breed 404",
+			query:        "?breed=cane-corso",
+			expectedCode: http.StatusNotFound,
 		}, {
 			name: "Template failure execute 500",
 			setup: func(h *handlers.Handler) {
@@ -790,7 +2547,7 @@ func TestHandler_ArticleView(t *testing.T) {
 				}
 			},
 			expectedCode: http.StatusInternalServerError,
-			expectedBody: "Ошибка вывода страницы статьи",
+			expectedBody: "Ошибка вывода страницы листа ожидания",
 		},
 	}
 
@@ -801,6 +2558,8 @@ func TestHandler_ArticleView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+				mockServices.EXPECT().DictionariesGet().Return(testDictionaries, nil).AnyTimes()
 				services := &service.Service{Services: mockServices}
 				logger2, err := logger.SetupLogger()
 				if err != nil {
@@ -815,9 +2574,9 @@ func TestHandler_ArticleView(t *testing.T) {
 				}
 
 				router := chi.NewRouter()
-				router.Get("/article", handler.ArticleView)
+				router.Get("/waitlist", handler.WaitlistView)
 
-				req, err := http.NewRequest("GET", "/article", nil)
+				req, err := http.NewRequest("GET", "/waitlist"+test.query, nil)
 				assert.NoError(t, err)
 
 				rr := httptest.NewRecorder()
@@ -836,29 +2595,31 @@ func TestHandler_ArticleView(t *testing.T) {
 	}
 }
 
-func TestHandler_NotFoundView(t *testing.T) {
+func TestHandler_APIDocsView(t *testing.T) {
 	tests := []struct {
 		name         string
 		setup        func(h *handlers.Handler)
 		expectedCode int
-		expectedBody string
+		expectedBody []string
 	}{
 		{
-			name:         "Correct 404",
-			expectedCode: http.StatusNotFound,
-			expectedBody: "html",
+			name:         "Correct 200",
+			expectedCode: http.StatusOK,
+			expectedBody: []string{
+				"/api/openapi.json", "/api/v1/puppies/{id}", "Каталог щенков", "chocolate",
+				"newest, price_asc, price_desc, age_asc, age_desc", `id="schema-PuppyList"`, "Puppy[]",
+			},
 		}, {
-			name: "Template failure execute 500",
+			name: "Bad Request 500 (Template execute failure)",
 			setup: func(h *handlers.Handler) {
 				h.ExecuteTemplate = func(t *template.Template, w http.ResponseWriter, name string, data interface{}) error {
 					return errors.New("template execute error")
 				}
 			},
-			expectedCode: http.StatusNotFound,
-			expectedBody: "Ошибка вывода страницы для кода 404",
+			expectedCode: http.StatusInternalServerError,
+			expectedBody: []string{"Ошибка сервера: не удалось отобразить страницу"},
 		},
 	}
-
 	for _, test := range tests {
 		t.Run(
 			test.name, func(t *testing.T) {
@@ -866,6 +2627,8 @@ func TestHandler_NotFoundView(t *testing.T) {
 				defer ctrl.Finish()
 
 				mockServices := mock_service.NewMockServices(ctrl)
+				mockServices.EXPECT().SiteSettingsGet().Return(domain.DefaultSiteSettings(), nil).AnyTimes()
+
 				services := &service.Service{Services: mockServices}
 				logger2, err := logger.SetupLogger()
 				if err != nil {
@@ -873,24 +2636,14 @@ func TestHandler_NotFoundView(t *testing.T) {
 				}
 
 				handler := handlers.NewHandler(services, logger2)
-
-				// Настройка перед каждым тестом
 				if test.setup != nil {
 					test.setup(handler)
 				}
 
 				router := chi.NewRouter()
-				router.Get(
-					"/test", func(w http.ResponseWriter, r *http.Request) {},
-				)
-				// Обработчик для 404
-				router.NotFound(
-					func(w http.ResponseWriter, r *http.Request) {
-						handler.NotFoundView(w, r)
-					},
-				)
+				router.Get("/api/docs", handler.APIDocsView)
 
-				req, err := http.NewRequest("GET", "/article", nil)
+				req, err := http.NewRequest("GET", "/api/docs", nil)
 				assert.NoError(t, err)
 
 				rr := httptest.NewRecorder()
@@ -899,11 +2652,9 @@ func TestHandler_NotFoundView(t *testing.T) {
 				assert.Equal(t, test.expectedCode, rr.Code)
 
 				body := rr.Body.String()
-				if rr.Code != test.expectedCode {
-					fmt.Printf("Response body: %s\n", body)
+				for _, expected := range test.expectedBody {
+					assert.Contains(t, body, expected)
 				}
-
-				assert.Contains(t, body, test.expectedBody)
 			},
 		)
 	}
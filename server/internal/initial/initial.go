@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/egosha7/site-go/internal/config"
 	"github.com/egosha7/site-go/internal/db"
+	"github.com/egosha7/site-go/internal/domain"
 	"github.com/egosha7/site-go/internal/handlers"
 	mailer2 "github.com/egosha7/site-go/internal/mailer"
 	"github.com/egosha7/site-go/internal/repository"
@@ -86,8 +87,15 @@ func Initial(logger *zap.Logger) (*config.Config, *handlers.Handler) {
 	}
 
 	// Создание хранилища
-	repo := repository.NewRepository(pool, clientMongo, clientRedis, logger, s3Client, db.BucketName)
-	services := service.NewUserService(repo, logger)
+	repo := repository.NewRepository(pool, clientMongo, clientRedis, logger, s3Client, db.BucketName, cfg.ReadyOutDays)
+	services := service.NewUserService(
+		repo, logger, domain.PageSizes{
+			Puppies:  cfg.PuppiesPageSize,
+			Reviews:  cfg.ReviewsPageSize,
+			Dogs:     cfg.DogsPageSize,
+			Articles: cfg.ArticlesPageSize,
+		},
+	)
 	h := handlers.NewHandler(services, logger)
 	mailer := mailer2.NewMailer(
 		smtpHost, smtpPort, smtpUser, smtpPass, smtpFromEmail,
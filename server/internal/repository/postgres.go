@@ -12,24 +12,83 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // PostgresRepository представляет интерфейс для работы с данными пользователей.
 type PostgresRepository interface {
-	PuppiesGet(chocolates, genders []string, idPuppy, readyToMove string, archived bool) ([]domain.Puppy, error)
+	PuppiesGet(chocolates, genders, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, page domain.Page) ([]domain.Puppy, int, error)
 	PuppyGet(idPuppy string) (*domain.Puppy, error)
 	PuppyUpdate(puppy *domain.Puppy) (map[string]struct{}, map[string]struct{}, error)
 	PuppyAdd(puppy *domain.Puppy) error
 	PuppyDelete(puppyID string) ([]string, error)
-	PuppyChangeArchived(puppyID, archived, city, phone string) error
+	PuppyChangeStatus(change *domain.PuppyStatusChange) error
+	PuppyFacetsGet(chocolates, genders, statuses []string, readyToMove, breed string, filter domain.PuppyFilter) (*domain.PuppyFacets, error)
 	PuppiesWithReviewsGet() (map[int]int, error)
+	LittersGet() ([]domain.Litter, error)
+	LitterGet(idLitter string) (*domain.Litter, error)
+	LitterPuppiesGet(idLitter string) ([]domain.Puppy, error)
+	DogOffspringGet(dogID int) ([]domain.Puppy, error)
+	LitterAdd(litter *domain.Litter) error
+	LitterUpdate(litter *domain.Litter) error
+	LitterDelete(litterID string) error
+	PlannedMatingsGet(statuses []string) ([]domain.PlannedMating, error)
+	PlannedMatingGet(idMating string) (*domain.PlannedMating, error)
+	PlannedMatingAdd(mating *domain.PlannedMating) error
+	PlannedMatingUpdate(mating *domain.PlannedMating) error
+	PlannedMatingDelete(matingID string) error
+	PlannedMatingWhelp(matingID int, litter *domain.Litter) error
+	BuyersGet(search string) ([]domain.Buyer, error)
+	BuyerGet(idBuyer string) (*domain.Buyer, error)
+	BuyerGetByPhone(phone string) (*domain.Buyer, error)
+	BuyerPuppiesGet(idBuyer string) ([]domain.Puppy, error)
+	BuyerAdd(buyer *domain.Buyer) error
+	BuyerUpdate(buyer *domain.Buyer) error
+	BuyerDelete(buyerID string) error
+	WaitlistEntriesGet(activeOnly bool) ([]domain.WaitlistEntry, error)
+	WaitlistEntryAdd(entry *domain.WaitlistEntry) error
+	WaitlistEntryChangeActive(entryID string, active bool) error
+	WaitlistEntryDelete(entryID string) error
+	WaitlistNotificationsAdd(puppyID int, entryIDs []int) error
+	WaitlistNotificationSent(notificationID string) error
+	WaitlistCandidatesGet(idPuppy string) ([]domain.WaitlistCandidate, error)
+	HealthEventsGet(puppyID, dogID int) ([]domain.HealthEvent, error)
+	HealthEventGet(idEvent string) (*domain.HealthEvent, error)
+	HealthEventAdd(event *domain.HealthEvent) error
+	HealthEventUpdate(event *domain.HealthEvent) error
+	HealthEventDelete(eventID string) error
+	PuppyWeightsGet(puppyID int) ([]domain.WeightMeasurement, error)
+	LitterGrowthGet(puppyID int) ([]domain.GrowthSeries, error)
+	WeightAdd(measurement *domain.WeightMeasurement) error
+	WeightDelete(measurementID string) error
+	ShowResultsGet(dogID int) ([]domain.ShowResult, error)
+	ShowResultGet(idResult string) (*domain.ShowResult, error)
+	ShowResultAdd(result *domain.ShowResult) error
+	ShowResultUpdate(result *domain.ShowResult) error
+	ShowResultDelete(resultID string) error
+	PriceHistoryGet(puppyID int) ([]domain.PriceChange, error)
+	DictionaryGet(kind string) ([]domain.DictionaryEntry, error)
+	DictionaryEntryAdd(entry *domain.DictionaryEntry) error
+	DictionaryEntryUpdate(entry *domain.DictionaryEntry) error
+	DictionaryEntryDelete(kind string, entryID string) (bool, error)
+	Search(query string, limit int) ([]domain.SearchResult, error)
+	ArticlesGet(tag string, drafts bool, page domain.Page) ([]domain.Article, int, error)
+	ArticleGet(idArticle string) (*domain.Article, error)
+	ArticleGetBySlug(slug string) (*domain.Article, error)
+	ArticleAdd(article *domain.Article) error
+	ArticleUpdate(article *domain.Article) error
+	SiteSettingsGet() (*domain.SiteSettings, error)
+	SiteSettingsUpdate(settings *domain.SiteSettings) error
 	DogsGet(chocolates, genders []string, id string, archived bool) ([]domain.Dog, error)
+	DogsPageGet(chocolates, genders []string, archived bool, page domain.Page) ([]domain.Dog, int, error)
 	DogGet(idDog string) (*domain.Dog, error)
+	PedigreeGet(dogIDs []int, generations int) (map[int]domain.Dog, error)
+	DogsAllGet() ([]domain.Dog, error)
 	DogChangeArchived(puppyID string, archived string) error
 	DogAdd(puppy *domain.Dog) error
 	DogUpdate(dog *domain.Dog) (map[string]struct{}, map[string]struct{}, error)
 	ReviewsPuppyNameGet() (map[int]string, error)
-	ReviewsGet(idReview string, checked bool) ([]domain.Feedback, error)
+	ReviewsGet(idReview string, checked bool, page domain.Page) ([]domain.Feedback, int, error)
 	FeedbackGet(idPuppy, verified string) (*domain.Feedback, error)
 	FeedbackUpdate(feedback *domain.Feedback) (map[string]struct{}, map[string]struct{}, error)
 	FeedbackAdd(feedback *domain.Feedback) error
@@ -40,12 +99,139 @@ type PostgresRepository interface {
 	CheckUniqUser(login string) (bool, error)
 	CheckValidUser(login string) (int, string, error)
 	SaveUser(login string, hash string) error
+	APITokensGet() ([]domain.APIToken, error)
+	APITokenGetByHash(hash string) (*domain.APIToken, error)
+	APITokenAdd(token *domain.APIToken) error
+	APITokenRevoke(tokenID string) error
+	APITokenUsed(tokenID int) error
 }
 
 // PostgresRepo представляет репозиторий для работы с PostgresSQL.
 type PostgresRepo struct {
-	logger *zap.Logger
-	pool   *pgxpool.Pool
+	logger       *zap.Logger
+	pool         *pgxpool.Pool
+	readyOutDays int
+}
+
+// puppyStatus вычисляет статус щенка: истёкшая бронь считается снятой.
+const puppyStatus = "CASE WHEN p.status = 'reserved' AND p.reserved_until < CURRENT_DATE " +
+	"THEN 'available' ELSE p.status END"
+
+// puppyReadyOutDate вычисляет дату готовности щенка к переезду: дату из помёта,
+// а для щенка без помёта — дату, когда ему исполнится readyOutDays дней.
+func (r *PostgresRepo) puppyReadyOutDate() string {
+	return fmt.Sprintf(
+		"COALESCE((SELECT l.ready_out_date FROM litters l WHERE l.id = p.litter_id), p.date_birth + %d)",
+		r.readyOutDays,
+	)
+}
+
+// puppyReadyOut вычисляет готовность щенка к переезду: ручная отметка админа важнее даты готовности.
+func (r *PostgresRepo) puppyReadyOut() string {
+	return "COALESCE(p.ready_out_override, " + r.puppyReadyOutDate() + " <= CURRENT_DATE, false)"
+}
+
+// puppyColumns перечисляет поля щенка в порядке, ожидаемом scanPuppy.
+func (r *PostgresRepo) puppyColumns() string {
+	return "p.id, p.name, p.title, p.gender, p.breed, p.price_amount, p.price_currency, " +
+		r.puppyReadyOut() + ", p.ready_out_override, COALESCE(" + r.puppyReadyOutDate() + ", '0001-01-01'::date), " +
+		puppyStatus + ", " +
+		"CASE WHEN " + puppyStatus + " = 'reserved' THEN p.reserved_until ELSE '0001-01-01'::date END, " +
+		"p.status_changed_at, p.city, p.mother_id, p.father_id, COALESCE(p.date_birth, '0001-01-01'::date), p.color, " +
+		"COALESCE(p.litter_id, 0), COALESCE(p.buyer_id, 0)"
+}
+
+// scanner объединяет pgx.Row и pgx.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// dogColumns перечисляет поля собаки в порядке, ожидаемом scanDog.
+const dogColumns = "d.id, d.name, d.title, d.gender, d.breed, d.color, d.archived, " +
+	"COALESCE(d.sire_id, 0), COALESCE(d.dam_id, 0), d.external, d.kennel, " +
+	"d.genotype_b, d.genotype_d, d.genotype_m, " +
+	"ARRAY(SELECT unnest(sr.titles) FROM show_results sr WHERE sr.dog_id = d.id)"
+
+// scanDog сканирует ряд, выбранный через dogColumns и array_agg(i.url), в структуру собаки.
+func scanDog(row scanner, dog *domain.Dog) error {
+	return row.Scan(
+		&dog.ID, &dog.Name, &dog.Title, &dog.Gender, &dog.Breed, &dog.Color, &dog.Archived,
+		&dog.SireID, &dog.DamID, &dog.External, &dog.Kennel,
+		&dog.Genotype.B, &dog.Genotype.D, &dog.Genotype.M, pq.Array(&dog.EarnedTitles), pq.Array(&dog.Urls),
+	)
+}
+
+// showResultColumns перечисляет поля результата выставки в порядке, ожидаемом scanShowResult.
+const showResultColumns = "sr.id, sr.dog_id, sr.event, sr.date, sr.judge, sr.grade, sr.titles, sr.certificate_url"
+
+// scanShowResult сканирует ряд, выбранный через showResultColumns, в структуру результата выставки.
+func scanShowResult(row scanner, result *domain.ShowResult) error {
+	return row.Scan(
+		&result.ID, &result.DogID, &result.Event, &result.Date, &result.Judge, &result.Grade,
+		pq.Array(&result.Titles), &result.CertificateURL,
+	)
+}
+
+// buyerColumns перечисляет поля покупателя в порядке, ожидаемом scanBuyer.
+const buyerColumns = "b.id, b.name, b.phones, b.email, b.city, b.notes"
+
+// scanBuyer сканирует ряд, выбранный через buyerColumns, в структуру покупателя.
+func scanBuyer(row scanner, buyer *domain.Buyer) error {
+	return row.Scan(&buyer.ID, &buyer.Name, pq.Array(&buyer.Phones), &buyer.Email, &buyer.City, &buyer.Notes)
+}
+
+// articleColumns перечисляет поля статьи в порядке, ожидаемом scanArticle.
+const articleColumns = "a.id, a.title, a.slug, a.body, a.cover_url, a.tags, a.published, " +
+	"COALESCE(a.published_at, '0001-01-01'::timestamptz), a.updated_at"
+
+// scanArticle сканирует ряд, выбранный через articleColumns, в структуру статьи.
+func scanArticle(row scanner, article *domain.Article) error {
+	return row.Scan(
+		&article.ID, &article.Title, &article.Slug, &article.Body, &article.CoverURL, pq.Array(&article.Tags),
+		&article.Published, &article.PublishedAt, &article.UpdatedAt,
+	)
+}
+
+// waitlistEntryColumns перечисляет поля заявки в лист ожидания в порядке, ожидаемом scanWaitlistEntry.
+const waitlistEntryColumns = "e.id, e.name, e.phone, e.email, e.breed, e.colors, e.gender, e.budget, " +
+	"COALESCE(e.wanted_from, '0001-01-01'::date), COALESCE(e.wanted_until, '0001-01-01'::date), " +
+	"e.comment, e.active, e.created_at"
+
+// scanWaitlistEntry сканирует ряд, выбранный через waitlistEntryColumns, в структуру заявки.
+func scanWaitlistEntry(row scanner, entry *domain.WaitlistEntry) error {
+	return row.Scan(
+		&entry.ID, &entry.Name, &entry.Phone, &entry.Email, &entry.Breed, pq.Array(&entry.Colors), &entry.Gender,
+		&entry.Budget, &entry.WantedFrom, &entry.WantedUntil, &entry.Comment, &entry.Active, &entry.CreatedAt,
+	)
+}
+
+// healthEventColumns перечисляет поля события здоровья в порядке, ожидаемом scanHealthEvent.
+const healthEventColumns = "h.id, COALESCE(h.puppy_id, 0), COALESCE(h.dog_id, 0), h.type, h.date, h.title, " +
+	"h.result, h.document_url"
+
+// scanHealthEvent сканирует ряд, выбранный через healthEventColumns, в структуру события здоровья.
+func scanHealthEvent(row scanner, event *domain.HealthEvent) error {
+	return row.Scan(
+		&event.ID, &event.PuppyID, &event.DogID, &event.Type, &event.Date, &event.Title, &event.Result,
+		&event.DocumentURL,
+	)
+}
+
+// weightColumns перечисляет поля взвешивания в порядке, ожидаемом scanWeight.
+const weightColumns = "w.id, w.puppy_id, w.date, w.grams"
+
+// scanWeight сканирует ряд, выбранный через weightColumns, в структуру взвешивания.
+func scanWeight(row scanner, measurement *domain.WeightMeasurement) error {
+	return row.Scan(&measurement.ID, &measurement.PuppyID, &measurement.Date, &measurement.Grams)
+}
+
+// scanPuppy сканирует ряд, выбранный через puppyColumns() и array_agg(i.url), в структуру щенка.
+func scanPuppy(row scanner, puppy *domain.Puppy) error {
+	return row.Scan(
+		&puppy.ID, &puppy.Name, &puppy.Title, &puppy.Sex, &puppy.Breed, &puppy.Price.Amount, &puppy.Price.Currency, &puppy.ReadyOut,
+		&puppy.ReadyOutOverride, &puppy.ReadyOutDate, &puppy.Status, &puppy.ReservedUntil, &puppy.StatusChangedAt, &puppy.City, &puppy.MotherID, &puppy.FatherID, &puppy.DateBirth, &puppy.Color,
+		&puppy.LitterID, &puppy.BuyerID, pq.Array(&puppy.Urls),
+	)
 }
 
 // FeedbackChangeChecked меняет состояние у отзыва о щенке в базе данных
@@ -229,8 +415,13 @@ func (r *PostgresRepo) FeedbackAdd(feedback *domain.Feedback) error {
 	}
 	defer tx.Rollback(context.Background())
 
+	// Отзыв привязывается к последнему щенку, проданному покупателю с этим номером
 	err = tx.QueryRow(
-		context.Background(), `SELECT puppy_id FROM puppies_member WHERE "number" = $1`, feedback.Number,
+		context.Background(), `SELECT p.id FROM puppies p
+		JOIN buyers b ON b.id = p.buyer_id
+		WHERE $1 = ANY(b.phones) AND p.status = 'sold'
+		ORDER BY p.status_changed_at DESC
+		LIMIT 1`, feedback.Number,
 	).Scan(&feedback.PuppyID)
 	if err != nil {
 		return err
@@ -368,13 +559,10 @@ func (r *PostgresRepo) ReviewsPuppyNameGet() (map[int]string, error) {
 	return puppyNames, nil
 }
 
-// ReviewsGet получает список отзывов из базы данных
-func (r *PostgresRepo) ReviewsGet(idReview string, checked bool) ([]domain.Feedback, error) {
-	// Подготовка SQL-запроса с условиями
-	query := "SELECT r.*, array_agg(i.url) as urls FROM reviews r"
-	query += " LEFT JOIN reviews_img ri ON r.id = ri.reviews_id"
-	query += " LEFT JOIN img_urls i ON ri.img_url_id = i.id"
-	query += " WHERE 1=1"
+// ReviewsGet получает одну страницу списка отзывов из базы данных и общее количество подходящих отзывов
+func (r *PostgresRepo) ReviewsGet(idReview string, checked bool, page domain.Page) ([]domain.Feedback, int, error) {
+	// Подготовка условий запроса
+	query := " WHERE 1=1"
 
 	// Добавление условий в запрос
 	switch checked {
@@ -390,17 +578,33 @@ func (r *PostgresRepo) ReviewsGet(idReview string, checked bool) ([]domain.Feedb
 		query += " AND r.id = '" + idReview + "'"
 	}
 
+	// Общее количество отзывов считается без условия страницы
+	var total int
+	err := r.pool.QueryRow(context.Background(), "SELECT count(*) FROM reviews r"+query).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Следующая страница выбирается по ключу: отзывы выводятся по убыванию ID
+	if page.After > 0 {
+		query += fmt.Sprintf(" AND r.id < %d", page.After)
+	}
+
+	query = "SELECT r.*, array_agg(i.url) as urls FROM reviews r" +
+		" LEFT JOIN reviews_img ri ON r.id = ri.reviews_id" +
+		" LEFT JOIN img_urls i ON ri.img_url_id = i.id" + query
 	query += " GROUP BY r.id" // Группируем результаты по ID щенка
 
 	// Добавление сортировки по ID
 	query += " ORDER BY r.id DESC"
+	query += pageLimit(page)
 
 	log.Println(query)
 
 	// Выполнение SQL-запроса
 	rows, err := r.pool.Query(context.Background(), query)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -420,7 +624,7 @@ func (r *PostgresRepo) ReviewsGet(idReview string, checked bool) ([]domain.Feedb
 			pq.Array(&feedback.Urls),
 		)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		if puppyID.Valid {
@@ -435,10 +639,10 @@ func (r *PostgresRepo) ReviewsGet(idReview string, checked bool) ([]domain.Feedb
 
 	// Проверка на ошибки во время итерации
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return reviews, nil
+	return reviews, total, nil
 }
 
 // DogUpdate обновляет информацию о собаке в базе данных
@@ -450,13 +654,18 @@ func (r *PostgresRepo) DogUpdate(dog *domain.Dog) (map[string]struct{}, map[stri
 	}
 	defer tx.Rollback(context.Background())
 
-	// Добавляем информацию о щенке в таблицу puppies и получаем созданный ID
+	// Обновляем информацию о собаке в таблице adult_dogs. Архив не трогаем: его меняет DogChangeArchived,
+	// поэтому сохранённый флаг возвращаем в собаку
 	query := `UPDATE adult_dogs
-	SET name=$1, title=$2, gender=$3, color=$4, archived=$5
-	WHERE id=$6 RETURNING id`
+	SET name=$1, title=$2, gender=$3, color=$4,
+	    sire_id=NULLIF($5, 0), dam_id=NULLIF($6, 0), external=$7, kennel=$8,
+	    genotype_b=$9, genotype_d=$10, genotype_m=$11, breed=$12
+	WHERE id=$13 RETURNING id, archived`
 	err = tx.QueryRow(
-		context.Background(), query, dog.Name, dog.Title, dog.Gender, dog.Color, dog.Archived, dog.ID,
-	).Scan(&dog.ID)
+		context.Background(), query, dog.Name, dog.Title, dog.Gender, dog.Color,
+		dog.SireID, dog.DamID, dog.External, dog.Kennel, dog.Genotype.B, dog.Genotype.D, dog.Genotype.M, dog.Breed,
+		dog.ID,
+	).Scan(&dog.ID, &dog.Archived)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -562,10 +771,12 @@ func (r *PostgresRepo) DogAdd(dog *domain.Dog) error {
 	defer tx.Rollback(context.Background())
 
 	// Добавляем информацию о щенке в таблицу puppies и получаем созданный ID
-	query := `INSERT INTO adult_dogs (name, title, gender, color, archived)
-	          VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	query := `INSERT INTO adult_dogs (name, title, gender, color, archived, sire_id, dam_id, external, kennel,
+	                                  genotype_b, genotype_d, genotype_m, breed)
+	          VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0), NULLIF($7, 0), $8, $9, $10, $11, $12, $13) RETURNING id`
 	err = tx.QueryRow(
 		context.Background(), query, dog.Name, dog.Title, dog.Gender, dog.Color, dog.Archived,
+		dog.SireID, dog.DamID, dog.External, dog.Kennel, dog.Genotype.B, dog.Genotype.D, dog.Genotype.M, dog.Breed,
 	).Scan(&dog.ID)
 	if err != nil {
 		return err
@@ -598,8 +809,8 @@ func (r *PostgresRepo) DogAdd(dog *domain.Dog) error {
 	return nil
 }
 
-// PuppyChangeArchived меняет состояние архива у щенка в базе данных
-func (r *PostgresRepo) PuppyChangeArchived(puppyID, archived, city, phone string) error {
+// PuppyChangeStatus меняет статус щенка и записывает переход в историю в базе данных
+func (r *PostgresRepo) PuppyChangeStatus(change *domain.PuppyStatusChange) error {
 	// Начинаем транзакцию
 	tx, err := r.pool.Begin(context.Background())
 	if err != nil {
@@ -607,35 +818,41 @@ func (r *PostgresRepo) PuppyChangeArchived(puppyID, archived, city, phone string
 	}
 	defer tx.Rollback(context.Background())
 
-	// Обновляем поле archived и city в таблице puppies
-	query := `UPDATE puppies SET archived = $1, city = $2 WHERE id = $3`
-	_, err = tx.Exec(context.Background(), query, archived, city, puppyID)
-	if err != nil {
-		return err
+	var reservedUntil *time.Time
+	if !change.ReservedUntil.IsZero() {
+		reservedUntil = &change.ReservedUntil
+	}
+
+	var buyerID *int
+	if change.BuyerID != 0 {
+		buyerID = &change.BuyerID
 	}
 
-	// Проверяем, существует ли запись в таблице puppies_member с данным puppyID
-	var count int
-	query = `SELECT COUNT(*) FROM puppies_member WHERE puppy_id = $1`
-	err = tx.QueryRow(context.Background(), query, puppyID).Scan(&count)
+	// Статус меняется, только если щенок всё ещё в исходном статусе
+	query := `UPDATE puppies p
+	SET status = $1, reserved_until = $2, status_changed_at = $3, city = CASE WHEN $4 <> '' THEN $4 ELSE p.city END,
+	buyer_id = $5
+	WHERE p.id = $6 AND ` + puppyStatus + ` = $7`
+	tag, err := tx.Exec(
+		context.Background(), query, change.To, reservedUntil, change.ChangedAt, change.City, buyerID,
+		change.PuppyID, change.From,
+	)
 	if err != nil {
 		return err
 	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("puppy %d is no longer %s", change.PuppyID, change.From)
+	}
 
-	if count == 0 {
-		// Если записи нет, вставляем новую запись
-		query = `INSERT INTO puppies_member (puppy_id, number) VALUES ($1, $2)`
-		_, err = tx.Exec(context.Background(), query, puppyID, phone)
-		if err != nil {
-			return err
-		}
-	} else {
-		// Если запись есть, обновляем существующую запись
-		query = `UPDATE puppies_member SET number = $1 WHERE puppy_id = $2`
-		_, err = tx.Exec(context.Background(), query, phone, puppyID)
-		if err != nil {
-			return err
-		}
+	// В истории покупатель остаётся и после возврата щенка
+	query = `INSERT INTO puppy_status_history (puppy_id, from_status, to_status, reserved_until, buyer_id, city, phone, changed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	_, err = tx.Exec(
+		context.Background(), query, change.PuppyID, change.From, change.To, reservedUntil, buyerID, change.City,
+		change.Phone, change.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add puppy status history: %w", err)
 	}
 
 	// Коммитим транзакцию
@@ -667,7 +884,11 @@ func (r *PostgresRepo) PuppyDelete(puppyID string) ([]string, error) {
 		FROM img_urls i
 		JOIN reviews_img ri ON ri.img_url_id = i.id
 		JOIN reviews r ON r.id = ri.reviews_id
-		WHERE r.puppy_id = $1`
+		WHERE r.puppy_id = $1
+		UNION
+		SELECT h.document_url
+		FROM health_events h
+		WHERE h.puppy_id = $1 AND h.document_url <> ''`
 
 	rows, err := tx.Query(context.Background(), query, puppyID)
 	if err != nil {
@@ -711,7 +932,7 @@ func (r *PostgresRepo) PuppyDelete(puppyID string) ([]string, error) {
 	}
 
 	// Удаляем щенка из других таблиц
-	tables := []string{"puppies_member", "reviews"}
+	tables := []string{"reviews"}
 	for _, table := range tables {
 		query = fmt.Sprintf("DELETE FROM %s WHERE puppy_id = $1", table)
 		_, err = tx.Exec(context.Background(), query, puppyID)
@@ -745,12 +966,18 @@ func (r *PostgresRepo) PuppyAdd(puppy *domain.Puppy) error {
 	}
 	defer tx.Rollback(context.Background())
 
+	var dateBirth *time.Time
+	if !puppy.DateBirth.IsZero() {
+		dateBirth = &puppy.DateBirth
+	}
+
 	// Добавляем информацию о щенке в таблицу puppies и получаем созданный ID
-	query := `INSERT INTO puppies (name, title, gender, price, ready_out, archived, city, mother_id, father_id, date_birth, color) 
-	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id`
+	query := `INSERT INTO puppies (name, title, gender, price_amount, price_currency, ready_out_override, status, city, mother_id, father_id, date_birth, color, litter_id, breed) 
+	          VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, NULLIF($13, 0), $14) RETURNING id`
 	err = tx.QueryRow(
-		context.Background(), query, puppy.Name, puppy.Title, puppy.Sex, puppy.Price, puppy.ReadyOut, puppy.Archived,
-		puppy.City, puppy.MotherID, puppy.FatherID, puppy.DateBirth, puppy.Color,
+		context.Background(), query, puppy.Name, puppy.Title, puppy.Sex, puppy.Price.Amount, puppy.Price.Currency,
+		puppy.ReadyOutOverride, puppy.Status, puppy.City, puppy.MotherID, puppy.FatherID, dateBirth, puppy.Color,
+		puppy.LitterID, puppy.Breed,
 	).Scan(&puppy.ID)
 	if err != nil {
 		return err
@@ -792,14 +1019,30 @@ func (r *PostgresRepo) PuppyUpdate(puppy *domain.Puppy) (map[string]struct{}, ma
 	}
 	defer tx.Rollback(context.Background())
 
-	// Добавляем информацию о щенке в таблицу puppies и получаем созданный ID
-	query := `UPDATE puppies
-	SET name=$1, title=$2, gender=$3, price=$4, ready_out=$5, archived=$6, city=$7, mother_id=$8, father_id=$9, date_birth=$10, color=$11
-	WHERE id=$12 RETURNING id`
+	// Записываем изменение цены в историю до обновления щенка, пока в таблице старая цена
+	query := `INSERT INTO puppy_price_history (puppy_id, old_amount, old_currency, new_amount, new_currency)
+	          SELECT id, price_amount, price_currency, $2, $3 FROM puppies
+	          WHERE id = $1 AND (price_amount, price_currency) <> ($2, $3)`
+	_, err = tx.Exec(context.Background(), query, puppy.ID, puppy.Price.Amount, puppy.Price.Currency)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to record price change: %w", err)
+	}
+
+	var dateBirth *time.Time
+	if !puppy.DateBirth.IsZero() {
+		dateBirth = &puppy.DateBirth
+	}
+
+	// Обновляем информацию о щенке в таблице puppies. Пустой город из формы не затирает сохранённый:
+	// его записывает и смена статуса вместе с покупателем, поэтому итоговый город возвращаем в щенка
+	query = `UPDATE puppies
+	SET name=$1, title=$2, gender=$3, price_amount=$4, price_currency=$5, ready_out_override=$6, mother_id=$7, father_id=$8, date_birth=$9, color=$10, litter_id=NULLIF($11, 0), breed=$12, city=COALESCE(NULLIF($13, ''), city)
+	WHERE id=$14 RETURNING id, city`
 	err = tx.QueryRow(
-		context.Background(), query, puppy.Name, puppy.Title, puppy.Sex, puppy.Price, puppy.ReadyOut, puppy.Archived,
-		puppy.City, puppy.MotherID, puppy.FatherID, puppy.DateBirth, puppy.Color, puppy.ID,
-	).Scan(&puppy.ID)
+		context.Background(), query, puppy.Name, puppy.Title, puppy.Sex, puppy.Price.Amount, puppy.Price.Currency,
+		puppy.ReadyOutOverride, puppy.MotherID, puppy.FatherID, dateBirth, puppy.Color, puppy.LitterID,
+		puppy.Breed, puppy.City, puppy.ID,
+	).Scan(&puppy.ID, &puppy.City)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -924,56 +1167,133 @@ func (r *PostgresRepo) PuppiesWithReviewsGet() (map[int]int, error) {
 	return puppyReviews, nil
 }
 
-// PuppiesGet получает список щенков в базе данных
-func (r *PostgresRepo) PuppiesGet(chocolates, genders []string, idPuppy, readyToMove string, archived bool) ([]domain.Puppy, error) {
-	// Подготовка SQL-запроса с условиями
-	query := "SELECT p.*, array_agg(i.url) as urls FROM puppies p"
-	query += " LEFT JOIN puppies_img pi ON p.id = pi.puppy_id"
-	query += " LEFT JOIN img_urls i ON pi.img_url_id = i.id"
-	query += " WHERE 1=1"
+// pageLimit ограничивает выборку одной страницей. Страница без размера — весь список.
+func pageLimit(page domain.Page) string {
+	if page.Size <= 0 {
+		return ""
+	}
+	return fmt.Sprintf(" LIMIT %d OFFSET %d", page.Size, page.Offset())
+}
+
+// puppyOrders — сортировки каталога щенков. Щенки без цены и без даты рождения выводятся в конце.
+var puppyOrders = map[string]string{
+	domain.PuppySortNewest:    "p.id DESC",
+	domain.PuppySortPriceAsc:  "p.price_amount = 0, p.price_amount ASC, p.id DESC",
+	domain.PuppySortPriceDesc: "p.price_amount = 0, p.price_amount DESC, p.id DESC",
+	domain.PuppySortAgeAsc:    "p.date_birth DESC NULLS LAST, p.id DESC",
+	domain.PuppySortAgeDesc:   "p.date_birth ASC NULLS LAST, p.id DESC",
+}
+
+// puppyConditions собирает условия WHERE для списка щенков и параметры запроса к ним
+func (r *PostgresRepo) puppyConditions(
+	chocolates, genders, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter,
+) (string, []interface{}) {
+	// Окрасы, пол и порода берутся из справочников, которые правит админ, поэтому все значения
+	// передаются параметрами запроса
+	query := " WHERE 1=1"
+	var args []interface{}
 
-	// Добавление условий в запрос
 	if len(chocolates) > 0 {
-		query += " AND p.color IN ("
-		for _, c := range chocolates {
-			query += "'" + c + "',"
-		}
-		query = strings.TrimSuffix(query, ",") + ")"
+		args = append(args, pq.Array(chocolates))
+		query += fmt.Sprintf(" AND p.color = ANY($%d)", len(args))
 	}
 
 	if len(genders) > 0 {
-		query += " AND p.gender IN ("
-		for _, g := range genders {
-			query += "'" + g + "',"
-		}
-		query = strings.TrimSuffix(query, ",") + ")"
+		args = append(args, pq.Array(genders))
+		query += fmt.Sprintf(" AND p.gender = ANY($%d)", len(args))
 	}
 
 	if readyToMove != "" {
-		query += " AND p.ready_out = '" + readyToMove + "'"
+		args = append(args, readyToMove == "true")
+		query += " AND " + r.puppyReadyOut() + fmt.Sprintf(" = $%d", len(args))
 	}
 
-	if archived {
-		query += " AND p.archived = 'true'"
-	} else {
-		query += " AND p.archived = 'false'"
+	if len(statuses) > 0 {
+		args = append(args, pq.Array(statuses))
+		query += " AND " + puppyStatus + fmt.Sprintf(" = ANY($%d)", len(args))
 	}
 
 	if idPuppy != "" {
-		query += " AND p.id = '" + idPuppy + "'"
+		args = append(args, idPuppy)
+		query += fmt.Sprintf(" AND p.id = $%d", len(args))
 	}
 
+	if breed != "" {
+		args = append(args, breed)
+		query += fmt.Sprintf(" AND p.breed = $%d", len(args))
+	}
+
+	// Диапазон цены задан в рублях, цены в других валютах в него не попадают
+	if filter.PriceFrom > 0 || filter.PriceTo > 0 {
+		query += " AND p.price_currency = '" + domain.CurrencyRUB + "'"
+	}
+	if filter.PriceFrom > 0 {
+		query += fmt.Sprintf(" AND p.price_amount >= %d", int64(filter.PriceFrom)*100)
+	}
+	if filter.PriceTo > 0 {
+		query += fmt.Sprintf(" AND p.price_amount > 0 AND p.price_amount <= %d", int64(filter.PriceTo)*100)
+	}
+
+	// Возраст считается в полных неделях от даты рождения
+	if filter.AgeFrom > 0 {
+		query += fmt.Sprintf(" AND p.date_birth <= CURRENT_DATE - %d", filter.AgeFrom*7)
+	}
+	if filter.AgeTo > 0 {
+		query += fmt.Sprintf(" AND p.date_birth > CURRENT_DATE - %d", (filter.AgeTo+1)*7)
+	}
+
+	// Город вводит посетитель, поэтому он тоже передаётся параметром запроса
+	if filter.City != "" {
+		args = append(args, filter.City)
+		query += fmt.Sprintf(" AND lower(p.city) = lower($%d)", len(args))
+	}
+
+	return query, args
+}
+
+// PuppiesGet получает одну страницу списка щенков в базе данных и общее количество подходящих щенков
+func (r *PostgresRepo) PuppiesGet(
+	chocolates, genders, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, page domain.Page,
+) ([]domain.Puppy, int, error) {
+	// Подготовка условий запроса
+	query, args := r.puppyConditions(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter)
+
+	// Общее количество щенков считается без условия страницы
+	var total int
+	err := r.pool.QueryRow(context.Background(), "SELECT count(*) FROM puppies p"+query, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Следующая страница выбирается по ключу, только когда щенки выводятся по убыванию ID.
+	// При другой сортировке страница выбирается по номеру, поэтому after сбрасывается и для смещения
+	if !filter.Keyset() {
+		page.After = 0
+	}
+	if page.After > 0 {
+		args = append(args, page.After)
+		query += fmt.Sprintf(" AND p.id < $%d", len(args))
+	}
+
+	query = "SELECT " + r.puppyColumns() + ", array_agg(i.url) as urls FROM puppies p" +
+		" LEFT JOIN puppies_img pi ON p.id = pi.puppy_id" +
+		" LEFT JOIN img_urls i ON pi.img_url_id = i.id" + query
 	query += " GROUP BY p.id" // Группируем результаты по ID щенка
 
-	// Добавление сортировки по ID
-	query += " ORDER BY p.id DESC"
+	// Добавление сортировки
+	order, ok := puppyOrders[filter.Sort]
+	if !ok {
+		order = puppyOrders[domain.PuppySortNewest]
+	}
+	query += " ORDER BY " + order
+	query += pageLimit(page)
 
 	log.Println(query)
 
 	// Выполнение SQL-запроса
-	rows, err := r.pool.Query(context.Background(), query)
+	rows, err := r.pool.Query(context.Background(), query, args...)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer rows.Close()
 
@@ -986,13 +1306,9 @@ func (r *PostgresRepo) PuppiesGet(chocolates, genders []string, idPuppy, readyTo
 		var puppy domain.Puppy
 
 		// Сканирование значений из текущего ряда в поля структуры
-		err := rows.Scan(
-			&puppy.ID, &puppy.Name, &puppy.Title, &puppy.Sex, &puppy.Price, &puppy.ReadyOut,
-			&puppy.Archived, &puppy.City, &puppy.MotherID, &puppy.FatherID, &puppy.DateBirth, &puppy.Color,
-			pq.Array(&puppy.Urls),
-		)
+		err := scanPuppy(rows, &puppy)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		// Добавление структуры к слайсу
@@ -1001,11 +1317,11 @@ func (r *PostgresRepo) PuppiesGet(chocolates, genders []string, idPuppy, readyTo
 
 	// Проверка на ошибки во время итерации
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
 	// Возвращение слайса структур
-	return puppies, nil
+	return puppies, total, nil
 }
 
 // GetReviews получает отзывы и id щенка в базе данных
@@ -1044,7 +1360,7 @@ func (r *PostgresRepo) GetReviews(ctx context.Context, puppies []domain.Puppy) (
 // PuppyGet получает информацию о щенке в базе данных
 func (r *PostgresRepo) PuppyGet(idPuppy string) (*domain.Puppy, error) {
 	// Подготовка SQL-запроса с условиями
-	query := "SELECT p.*, array_agg(i.url) as urls FROM puppies p"
+	query := "SELECT " + r.puppyColumns() + ", array_agg(i.url) as urls FROM puppies p"
 	query += " LEFT JOIN puppies_img pi ON p.id = pi.puppy_id"
 	query += " LEFT JOIN img_urls i ON pi.img_url_id = i.id"
 	query += " WHERE 1=1"
@@ -1061,11 +1377,7 @@ func (r *PostgresRepo) PuppyGet(idPuppy string) (*domain.Puppy, error) {
 
 	row := r.pool.QueryRow(context.Background(), query)
 
-	err := row.Scan(
-		&puppy.ID, &puppy.Name, &puppy.Title, &puppy.Sex, &puppy.Price, &puppy.ReadyOut,
-		&puppy.Archived, &puppy.City, &puppy.MotherID, &puppy.FatherID, &puppy.DateBirth, &puppy.Color,
-		pq.Array(&puppy.Urls),
-	)
+	err := scanPuppy(row, puppy)
 	if err != nil {
 		return nil, err
 	}
@@ -1079,7 +1391,7 @@ func (r *PostgresRepo) PuppyGet(idPuppy string) (*domain.Puppy, error) {
 // DogGet получает информацию о собаке в базе данных
 func (r *PostgresRepo) DogGet(idDog string) (*domain.Dog, error) {
 	// Подготовка SQL-запроса с условиями
-	query := "SELECT d.*, array_agg(i.url) as urls FROM adult_dogs d"
+	query := "SELECT " + dogColumns + ", array_agg(i.url) as urls FROM adult_dogs d"
 	query += " LEFT JOIN adult_dogs_img di ON d.id = di.adult_dogs_id"
 	query += " LEFT JOIN img_urls i ON di.img_url_id = i.id"
 	query += " WHERE 1=1"
@@ -1096,10 +1408,7 @@ func (r *PostgresRepo) DogGet(idDog string) (*domain.Dog, error) {
 
 	row := r.pool.QueryRow(context.Background(), query)
 
-	err := row.Scan(
-		&dog.ID, &dog.Name, &dog.Title, &dog.Gender, &dog.Color, &dog.Archived,
-		pq.Array(&dog.Urls),
-	)
+	err := scanDog(row, dog)
 
 	log.Println(dog.ID, dog.Name)
 
@@ -1113,26 +1422,21 @@ func (r *PostgresRepo) DogGet(idDog string) (*domain.Dog, error) {
 // DogsGet получает список собак в базе данных
 func (r *PostgresRepo) DogsGet(chocolates, genders []string, idDog string, archived bool) ([]domain.Dog, error) {
 	// Подготовка SQL-запроса с условиями
-	query := "SELECT d.*, array_agg(i.url) as urls FROM adult_dogs d"
+	query := "SELECT " + dogColumns + ", array_agg(i.url) as urls FROM adult_dogs d"
 	query += " LEFT JOIN adult_dogs_img di ON d.id = di.adult_dogs_id"
 	query += " LEFT JOIN img_urls i ON di.img_url_id = i.id"
 	query += " WHERE 1=1"
 
-	// Добавление условий в запрос
+	// Добавление условий в запрос. Окрасы и пол берутся из справочников, поэтому передаются параметрами
+	var args []interface{}
 	if len(chocolates) > 0 {
-		query += " AND d.color IN ("
-		for _, c := range chocolates {
-			query += "'" + c + "',"
-		}
-		query = strings.TrimSuffix(query, ",") + ")"
+		args = append(args, pq.Array(chocolates))
+		query += fmt.Sprintf(" AND d.color = ANY($%d)", len(args))
 	}
 
 	if len(genders) > 0 {
-		query += " AND d.gender IN ("
-		for _, g := range genders {
-			query += "'" + g + "',"
-		}
-		query = strings.TrimSuffix(query, ",") + ")"
+		args = append(args, pq.Array(genders))
+		query += fmt.Sprintf(" AND d.gender = ANY($%d)", len(args))
 	}
 
 	if archived {
@@ -1150,7 +1454,7 @@ func (r *PostgresRepo) DogsGet(chocolates, genders []string, idDog string, archi
 	log.Println(query)
 
 	// Выполнение SQL-запроса
-	rows, err := r.pool.Query(context.Background(), query)
+	rows, err := r.pool.Query(context.Background(), query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -1165,10 +1469,7 @@ func (r *PostgresRepo) DogsGet(chocolates, genders []string, idDog string, archi
 		var dog domain.Dog
 
 		// Сканирование значений из текущего ряда в поля структуры
-		err := rows.Scan(
-			&dog.ID, &dog.Name, &dog.Title, &dog.Gender, &dog.Color, &dog.Archived,
-			pq.Array(&dog.Urls),
-		)
+		err := scanDog(rows, &dog)
 		if err != nil {
 			return nil, err
 		}
@@ -1242,3 +1543,1323 @@ func (r *PostgresRepo) SaveUser(login string, hash string) error {
 
 	return nil
 }
+
+// LittersGet получает список помётов в базе данных
+func (r *PostgresRepo) LittersGet() ([]domain.Litter, error) {
+	query := `SELECT id, breed, mother_id, father_id, date_birth, ready_out_date, description
+		FROM litters
+		ORDER BY date_birth DESC, id DESC`
+
+	rows, err := r.pool.Query(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	litters := make([]domain.Litter, 0)
+	for rows.Next() {
+		var litter domain.Litter
+		err := rows.Scan(
+			&litter.ID, &litter.Breed, &litter.MotherID, &litter.FatherID, &litter.DateBirth, &litter.ReadyOutDate,
+			&litter.Description,
+		)
+		if err != nil {
+			return nil, err
+		}
+		litters = append(litters, litter)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return litters, nil
+}
+
+// LitterGet получает информацию о помёте в базе данных
+func (r *PostgresRepo) LitterGet(idLitter string) (*domain.Litter, error) {
+	query := `SELECT id, breed, mother_id, father_id, date_birth, ready_out_date, description
+		FROM litters
+		WHERE id = $1`
+
+	litter := &domain.Litter{}
+	err := r.pool.QueryRow(context.Background(), query, idLitter).Scan(
+		&litter.ID, &litter.Breed, &litter.MotherID, &litter.FatherID, &litter.DateBirth, &litter.ReadyOutDate,
+		&litter.Description,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return litter, nil
+}
+
+// LitterPuppiesGet получает список щенков помёта в базе данных
+func (r *PostgresRepo) LitterPuppiesGet(idLitter string) ([]domain.Puppy, error) {
+	query := "SELECT " + r.puppyColumns() + ", array_agg(i.url) as urls FROM puppies p"
+	query += " LEFT JOIN puppies_img pi ON p.id = pi.puppy_id"
+	query += " LEFT JOIN img_urls i ON pi.img_url_id = i.id"
+	query += " WHERE p.litter_id = $1"
+	query += " GROUP BY p.id"
+	query += " ORDER BY p.id"
+
+	rows, err := r.pool.Query(context.Background(), query, idLitter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	puppies := make([]domain.Puppy, 0)
+	for rows.Next() {
+		var puppy domain.Puppy
+		if err := scanPuppy(rows, &puppy); err != nil {
+			return nil, err
+		}
+		puppies = append(puppies, puppy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return puppies, nil
+}
+
+// litterBreed — порода помёта по матери, мать передаётся первым параметром запроса.
+const litterBreed = "(SELECT breed FROM adult_dogs WHERE id = $1)"
+
+// LitterAdd добавляет помёт в базу данных. Порода помёта берётся у матери.
+func (r *PostgresRepo) LitterAdd(litter *domain.Litter) error {
+	query := `INSERT INTO litters (mother_id, father_id, date_birth, ready_out_date, description, breed)
+	          VALUES ($1, $2, $3, $4, $5, ` + litterBreed + `) RETURNING id, breed`
+	return r.pool.QueryRow(
+		context.Background(), query, litter.MotherID, litter.FatherID, litter.DateBirth, litter.ReadyOutDate,
+		litter.Description,
+	).Scan(&litter.ID, &litter.Breed)
+}
+
+// LitterUpdate обновляет помёт и переносит родителей, породу и дату рождения на его щенков
+func (r *PostgresRepo) LitterUpdate(litter *domain.Litter) error {
+	// Начинаем транзакцию
+	tx, err := r.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	query := `UPDATE litters
+	SET mother_id=$1, father_id=$2, date_birth=$3, ready_out_date=$4, description=$5, breed=` + litterBreed + `
+	WHERE id=$6 RETURNING id, breed`
+	err = tx.QueryRow(
+		context.Background(), query, litter.MotherID, litter.FatherID, litter.DateBirth, litter.ReadyOutDate,
+		litter.Description, litter.ID,
+	).Scan(&litter.ID, &litter.Breed)
+	if err != nil {
+		return err
+	}
+
+	// Щенки помёта наследуют родителей, породу и дату рождения
+	query = `UPDATE puppies SET mother_id=$1, father_id=$2, date_birth=$3, breed=$4 WHERE litter_id=$5`
+	_, err = tx.Exec(
+		context.Background(), query, litter.MotherID, litter.FatherID, litter.DateBirth, litter.Breed,
+		litter.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to update litter puppies: %w", err)
+	}
+
+	// Коммитим транзакцию
+	return tx.Commit(context.Background())
+}
+
+// LitterDelete удаляет помёт из базы данных, щенки остаются без помёта
+func (r *PostgresRepo) LitterDelete(litterID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM litters WHERE id = $1", litterID)
+	return err
+}
+
+// DogsAllGet получает всех собак, включая архивных и сторонних, без фотографий
+func (r *PostgresRepo) DogsAllGet() ([]domain.Dog, error) {
+	query := "SELECT " + dogColumns + ", '{}'::text[] as urls FROM adult_dogs d ORDER BY d.name"
+
+	rows, err := r.pool.Query(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dogs := make([]domain.Dog, 0)
+	for rows.Next() {
+		var dog domain.Dog
+		err := scanDog(rows, &dog)
+		if err != nil {
+			return nil, err
+		}
+		dogs = append(dogs, dog)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return dogs, nil
+}
+
+// PedigreeGet получает собак dogIDs и всех их предков не далее generations поколений.
+// Дерево не строится: результат возвращается плоским списком по ID.
+func (r *PostgresRepo) PedigreeGet(dogIDs []int, generations int) (map[int]domain.Dog, error) {
+	query := `WITH RECURSIVE pedigree (id, generation) AS (
+	              SELECT id, 0 FROM adult_dogs WHERE id = ANY($1)
+	              UNION
+	              SELECT parent.id, p.generation + 1
+	              FROM pedigree p
+	              JOIN adult_dogs child ON child.id = p.id
+	              JOIN adult_dogs parent ON parent.id IN (child.sire_id, child.dam_id)
+	              WHERE p.generation < $2
+	          )
+	          SELECT ` + dogColumns + `, array_agg(i.url) as urls FROM adult_dogs d
+	          LEFT JOIN adult_dogs_img di ON d.id = di.adult_dogs_id
+	          LEFT JOIN img_urls i ON di.img_url_id = i.id
+	          WHERE d.id IN (SELECT id FROM pedigree)
+	          GROUP BY d.id`
+
+	rows, err := r.pool.Query(context.Background(), query, dogIDs, generations)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dogs := make(map[int]domain.Dog)
+	for rows.Next() {
+		var dog domain.Dog
+		err := scanDog(rows, &dog)
+		if err != nil {
+			return nil, err
+		}
+		dogs[dog.ID] = dog
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return dogs, nil
+}
+
+// plannedMatingColumns — столбцы запланированной вязки в порядке, ожидаемом scanPlannedMating.
+const plannedMatingColumns = "id, mother_id, father_id, expected_date, status, description, COALESCE(litter_id, 0)"
+
+// scanPlannedMating считывает строку запланированной вязки.
+func scanPlannedMating(row scanner, mating *domain.PlannedMating) error {
+	return row.Scan(
+		&mating.ID, &mating.MotherID, &mating.FatherID, &mating.ExpectedDate, &mating.Status,
+		&mating.Description, &mating.LitterID,
+	)
+}
+
+// PlannedMatingsGet получает список запланированных вязок в базе данных.
+// Пустой список статусов означает вязки в любом статусе.
+func (r *PostgresRepo) PlannedMatingsGet(statuses []string) ([]domain.PlannedMating, error) {
+	query := "SELECT " + plannedMatingColumns + ` FROM planned_matings
+		WHERE cardinality($1::text[]) = 0 OR status = ANY($1::text[])
+		ORDER BY expected_date, id`
+
+	if statuses == nil {
+		statuses = []string{}
+	}
+	rows, err := r.pool.Query(context.Background(), query, statuses)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	matings := make([]domain.PlannedMating, 0)
+	for rows.Next() {
+		var mating domain.PlannedMating
+		if err := scanPlannedMating(rows, &mating); err != nil {
+			return nil, err
+		}
+		matings = append(matings, mating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return matings, nil
+}
+
+// PlannedMatingGet получает информацию о запланированной вязке в базе данных
+func (r *PostgresRepo) PlannedMatingGet(idMating string) (*domain.PlannedMating, error) {
+	query := "SELECT " + plannedMatingColumns + " FROM planned_matings WHERE id = $1"
+
+	mating := &domain.PlannedMating{}
+	err := scanPlannedMating(r.pool.QueryRow(context.Background(), query, idMating), mating)
+	if err != nil {
+		return nil, err
+	}
+
+	return mating, nil
+}
+
+// PlannedMatingAdd добавляет запланированную вязку в базу данных
+func (r *PostgresRepo) PlannedMatingAdd(mating *domain.PlannedMating) error {
+	query := `INSERT INTO planned_matings (mother_id, father_id, expected_date, status, description)
+	          VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, mating.MotherID, mating.FatherID, mating.ExpectedDate, mating.Status,
+		mating.Description,
+	).Scan(&mating.ID)
+}
+
+// PlannedMatingUpdate обновляет запланированную вязку в базе данных. Помёт вязки не меняется.
+func (r *PostgresRepo) PlannedMatingUpdate(mating *domain.PlannedMating) error {
+	query := `UPDATE planned_matings
+	SET mother_id=$1, father_id=$2, expected_date=$3, status=$4, description=$5
+	WHERE id=$6 RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, mating.MotherID, mating.FatherID, mating.ExpectedDate, mating.Status,
+		mating.Description, mating.ID,
+	).Scan(&mating.ID)
+}
+
+// PlannedMatingDelete удаляет запланированную вязку из базы данных, помёт остаётся
+func (r *PostgresRepo) PlannedMatingDelete(matingID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM planned_matings WHERE id = $1", matingID)
+	return err
+}
+
+// PlannedMatingWhelp создаёт помёт по вязке и отмечает вязку как завершившуюся помётом
+func (r *PostgresRepo) PlannedMatingWhelp(matingID int, litter *domain.Litter) error {
+	// Начинаем транзакцию
+	tx, err := r.pool.Begin(context.Background())
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.Background())
+
+	query := `INSERT INTO litters (mother_id, father_id, date_birth, ready_out_date, description, breed)
+	          VALUES ($1, $2, $3, $4, $5, ` + litterBreed + `) RETURNING id, breed`
+	err = tx.QueryRow(
+		context.Background(), query, litter.MotherID, litter.FatherID, litter.DateBirth, litter.ReadyOutDate,
+		litter.Description,
+	).Scan(&litter.ID, &litter.Breed)
+	if err != nil {
+		return fmt.Errorf("unable to add litter: %w", err)
+	}
+
+	// Вязка, уже завершившаяся помётом, повторно не закрывается
+	query = `UPDATE planned_matings SET status=$1, litter_id=$2 WHERE id=$3 AND status <> $1`
+	tag, err := tx.Exec(context.Background(), query, domain.MatingStatusWhelped, litter.ID, matingID)
+	if err != nil {
+		return fmt.Errorf("unable to update planned mating: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("planned mating %d not found or already whelped", matingID)
+	}
+
+	// Коммитим транзакцию
+	return tx.Commit(context.Background())
+}
+
+// BuyersGet получает список покупателей в базе данных. Поиск идёт по имени, почте, городу,
+// кличкам щенков и по цифрам телефона, поэтому номер можно вводить в любом формате.
+func (r *PostgresRepo) BuyersGet(search string) ([]domain.Buyer, error) {
+	query := "SELECT " + buyerColumns + " FROM buyers b"
+	query += ` WHERE $1 = ''
+		OR b.name ILIKE '%' || $1 || '%'
+		OR b.email ILIKE '%' || $1 || '%'
+		OR b.city ILIKE '%' || $1 || '%'
+		OR EXISTS (SELECT 1 FROM puppies p WHERE p.buyer_id = b.id AND p.name ILIKE '%' || $1 || '%')
+		OR (regexp_replace($1, '\D', '', 'g') <> ''
+			AND regexp_replace(array_to_string(b.phones, ' '), '\D', '', 'g')
+				LIKE '%' || regexp_replace($1, '\D', '', 'g') || '%')`
+	query += " ORDER BY b.name, b.id"
+
+	rows, err := r.pool.Query(context.Background(), query, search)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	buyers := make([]domain.Buyer, 0)
+	for rows.Next() {
+		var buyer domain.Buyer
+		if err := scanBuyer(rows, &buyer); err != nil {
+			return nil, err
+		}
+		buyers = append(buyers, buyer)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return buyers, nil
+}
+
+// BuyerGet получает информацию о покупателе в базе данных
+func (r *PostgresRepo) BuyerGet(idBuyer string) (*domain.Buyer, error) {
+	query := "SELECT " + buyerColumns + " FROM buyers b WHERE b.id = $1"
+
+	buyer := &domain.Buyer{}
+	err := scanBuyer(r.pool.QueryRow(context.Background(), query, idBuyer), buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	return buyer, nil
+}
+
+// BuyerGetByPhone ищет покупателя по одному из его телефонов в базе данных.
+// Если покупателя с таким номером нет, возвращает nil без ошибки.
+func (r *PostgresRepo) BuyerGetByPhone(phone string) (*domain.Buyer, error) {
+	query := "SELECT " + buyerColumns + " FROM buyers b WHERE $1 = ANY(b.phones) ORDER BY b.id LIMIT 1"
+
+	buyer := &domain.Buyer{}
+	err := scanBuyer(r.pool.QueryRow(context.Background(), query, phone), buyer)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return buyer, nil
+}
+
+// BuyerPuppiesGet получает щенков покупателя в базе данных, включая забронированных
+// когда-то и возвращённых: они берутся из истории статусов.
+func (r *PostgresRepo) BuyerPuppiesGet(idBuyer string) ([]domain.Puppy, error) {
+	query := "SELECT " + r.puppyColumns() + ", array_agg(i.url) as urls FROM puppies p"
+	query += " LEFT JOIN puppies_img pi ON p.id = pi.puppy_id"
+	query += " LEFT JOIN img_urls i ON pi.img_url_id = i.id"
+	query += " WHERE p.buyer_id = $1"
+	query += " OR p.id IN (SELECT h.puppy_id FROM puppy_status_history h WHERE h.buyer_id = $1)"
+	query += " GROUP BY p.id"
+	query += " ORDER BY p.status_changed_at DESC"
+
+	rows, err := r.pool.Query(context.Background(), query, idBuyer)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	puppies := make([]domain.Puppy, 0)
+	for rows.Next() {
+		var puppy domain.Puppy
+		if err := scanPuppy(rows, &puppy); err != nil {
+			return nil, err
+		}
+		puppies = append(puppies, puppy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return puppies, nil
+}
+
+// BuyerAdd добавляет покупателя в базу данных
+func (r *PostgresRepo) BuyerAdd(buyer *domain.Buyer) error {
+	query := `INSERT INTO buyers (name, phones, email, city, notes)
+	          VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, buyer.Name, pq.Array(buyer.Phones), buyer.Email, buyer.City, buyer.Notes,
+	).Scan(&buyer.ID)
+}
+
+// BuyerUpdate обновляет покупателя в базе данных
+func (r *PostgresRepo) BuyerUpdate(buyer *domain.Buyer) error {
+	query := `UPDATE buyers SET name=$1, phones=$2, email=$3, city=$4, notes=$5 WHERE id=$6 RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, buyer.Name, pq.Array(buyer.Phones), buyer.Email, buyer.City, buyer.Notes,
+		buyer.ID,
+	).Scan(&buyer.ID)
+}
+
+// BuyerDelete удаляет покупателя из базы данных, его щенки остаются без покупателя
+func (r *PostgresRepo) BuyerDelete(buyerID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM buyers WHERE id = $1", buyerID)
+	return err
+}
+
+// WaitlistEntriesGet получает заявки в лист ожидания в порядке очереди в базе данных
+func (r *PostgresRepo) WaitlistEntriesGet(activeOnly bool) ([]domain.WaitlistEntry, error) {
+	query := "SELECT " + waitlistEntryColumns + " FROM waitlist_entries e"
+	query += " WHERE NOT $1 OR e.active"
+	query += " ORDER BY e.created_at, e.id"
+
+	rows, err := r.pool.Query(context.Background(), query, activeOnly)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.WaitlistEntry, 0)
+	for rows.Next() {
+		var entry domain.WaitlistEntry
+		if err := scanWaitlistEntry(rows, &entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// WaitlistEntryAdd добавляет заявку в лист ожидания в базу данных
+func (r *PostgresRepo) WaitlistEntryAdd(entry *domain.WaitlistEntry) error {
+	var wantedFrom, wantedUntil *time.Time
+	if !entry.WantedFrom.IsZero() {
+		wantedFrom = &entry.WantedFrom
+	}
+	if !entry.WantedUntil.IsZero() {
+		wantedUntil = &entry.WantedUntil
+	}
+
+	query := `INSERT INTO waitlist_entries
+	(name, phone, email, breed, colors, gender, budget, wanted_from, wanted_until, comment, active)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING id, created_at`
+	return r.pool.QueryRow(
+		context.Background(), query, entry.Name, entry.Phone, entry.Email, entry.Breed, pq.Array(entry.Colors),
+		entry.Gender, entry.Budget, wantedFrom, wantedUntil, entry.Comment, entry.Active,
+	).Scan(&entry.ID, &entry.CreatedAt)
+}
+
+// WaitlistEntryChangeActive включает или снимает заявку из листа ожидания в базе данных
+func (r *PostgresRepo) WaitlistEntryChangeActive(entryID string, active bool) error {
+	_, err := r.pool.Exec(context.Background(), "UPDATE waitlist_entries SET active = $1 WHERE id = $2", active, entryID)
+	return err
+}
+
+// WaitlistEntryDelete удаляет заявку из листа ожидания в базе данных
+func (r *PostgresRepo) WaitlistEntryDelete(entryID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM waitlist_entries WHERE id = $1", entryID)
+	return err
+}
+
+// WaitlistNotificationsAdd ставит в очередь уведомления о щенке для заявок в базе данных.
+// Повторная постановка той же пары заявка — щенок ничего не меняет.
+func (r *PostgresRepo) WaitlistNotificationsAdd(puppyID int, entryIDs []int) error {
+	query := `INSERT INTO waitlist_notifications (entry_id, puppy_id)
+	SELECT unnest($1::int[]), $2
+	ON CONFLICT (entry_id, puppy_id) DO NOTHING`
+	_, err := r.pool.Exec(context.Background(), query, pq.Array(entryIDs), puppyID)
+	return err
+}
+
+// WaitlistNotificationSent отмечает, что щенка предложили по уведомлению, в базе данных
+func (r *PostgresRepo) WaitlistNotificationSent(notificationID string) error {
+	query := `UPDATE waitlist_notifications SET sent_at = now() WHERE id = $1 AND sent_at IS NULL`
+	_, err := r.pool.Exec(context.Background(), query, notificationID)
+	return err
+}
+
+// WaitlistCandidatesGet получает активные заявки, подходящие щенку, в порядке очереди в базе данных
+func (r *PostgresRepo) WaitlistCandidatesGet(idPuppy string) ([]domain.WaitlistCandidate, error) {
+	query := "SELECT n.id, n.entry_id, n.puppy_id, n.created_at, COALESCE(n.sent_at, '0001-01-01'::timestamptz), " +
+		waitlistEntryColumns
+	query += " FROM waitlist_notifications n"
+	query += " JOIN waitlist_entries e ON e.id = n.entry_id"
+	query += " WHERE n.puppy_id = $1 AND e.active"
+	query += " ORDER BY e.created_at, e.id"
+
+	rows, err := r.pool.Query(context.Background(), query, idPuppy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	candidates := make([]domain.WaitlistCandidate, 0)
+	for rows.Next() {
+		var candidate domain.WaitlistCandidate
+		n, e := &candidate.Notification, &candidate.Entry
+		err := rows.Scan(
+			&n.ID, &n.EntryID, &n.PuppyID, &n.CreatedAt, &n.SentAt,
+			&e.ID, &e.Name, &e.Phone, &e.Email, &e.Breed, pq.Array(&e.Colors), &e.Gender,
+			&e.Budget, &e.WantedFrom, &e.WantedUntil, &e.Comment, &e.Active, &e.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		candidate.Rank = len(candidates) + 1
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// HealthEventsGet получает ветеринарную историю щенка или собаки в хронологическом порядке в базе данных
+func (r *PostgresRepo) HealthEventsGet(puppyID, dogID int) ([]domain.HealthEvent, error) {
+	query := "SELECT " + healthEventColumns + " FROM health_events h"
+	query += " WHERE ($1 > 0 AND h.puppy_id = $1) OR ($2 > 0 AND h.dog_id = $2)"
+	query += " ORDER BY h.date, h.id"
+
+	rows, err := r.pool.Query(context.Background(), query, puppyID, dogID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]domain.HealthEvent, 0)
+	for rows.Next() {
+		var event domain.HealthEvent
+		if err := scanHealthEvent(rows, &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// HealthEventGet получает событие здоровья в базе данных
+func (r *PostgresRepo) HealthEventGet(idEvent string) (*domain.HealthEvent, error) {
+	query := "SELECT " + healthEventColumns + " FROM health_events h WHERE h.id = $1"
+
+	event := &domain.HealthEvent{}
+	err := scanHealthEvent(r.pool.QueryRow(context.Background(), query, idEvent), event)
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// HealthEventAdd добавляет событие здоровья в базу данных
+func (r *PostgresRepo) HealthEventAdd(event *domain.HealthEvent) error {
+	query := `INSERT INTO health_events (puppy_id, dog_id, type, date, title, result, document_url)
+	          VALUES (NULLIF($1, 0), NULLIF($2, 0), $3, $4, $5, $6, $7) RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, event.PuppyID, event.DogID, event.Type, event.Date, event.Title, event.Result,
+		event.DocumentURL,
+	).Scan(&event.ID)
+}
+
+// HealthEventUpdate обновляет событие здоровья в базе данных, владелец события не меняется
+func (r *PostgresRepo) HealthEventUpdate(event *domain.HealthEvent) error {
+	query := `UPDATE health_events SET type=$1, date=$2, title=$3, result=$4, document_url=$5
+	WHERE id=$6 RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, event.Type, event.Date, event.Title, event.Result, event.DocumentURL, event.ID,
+	).Scan(&event.ID)
+}
+
+// HealthEventDelete удаляет событие здоровья из базы данных
+func (r *PostgresRepo) HealthEventDelete(eventID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM health_events WHERE id = $1", eventID)
+	return err
+}
+
+// PuppyWeightsGet получает взвешивания щенка в хронологическом порядке в базе данных
+func (r *PostgresRepo) PuppyWeightsGet(puppyID int) ([]domain.WeightMeasurement, error) {
+	query := "SELECT " + weightColumns + " FROM puppy_weights w WHERE w.puppy_id = $1 ORDER BY w.date"
+
+	rows, err := r.pool.Query(context.Background(), query, puppyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	measurements := make([]domain.WeightMeasurement, 0)
+	for rows.Next() {
+		var measurement domain.WeightMeasurement
+		if err := scanWeight(rows, &measurement); err != nil {
+			return nil, err
+		}
+		measurements = append(measurements, measurement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return measurements, nil
+}
+
+// LitterGrowthGet получает кривые роста щенка и его однопомётников в базе данных.
+// Щенки без взвешиваний в результат не попадают.
+func (r *PostgresRepo) LitterGrowthGet(puppyID int) ([]domain.GrowthSeries, error) {
+	query := "SELECT p.name, " + weightColumns + " FROM puppies p"
+	query += " JOIN puppy_weights w ON w.puppy_id = p.id"
+	query += " WHERE p.id = $1 OR p.litter_id = (SELECT litter_id FROM puppies WHERE id = $1)"
+	query += " ORDER BY p.id, w.date"
+
+	rows, err := r.pool.Query(context.Background(), query, puppyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	series := make([]domain.GrowthSeries, 0)
+	for rows.Next() {
+		var name string
+		var measurement domain.WeightMeasurement
+		err := rows.Scan(&name, &measurement.ID, &measurement.PuppyID, &measurement.Date, &measurement.Grams)
+		if err != nil {
+			return nil, err
+		}
+		if len(series) == 0 || series[len(series)-1].PuppyID != measurement.PuppyID {
+			series = append(series, domain.GrowthSeries{PuppyID: measurement.PuppyID, Name: name})
+		}
+		last := &series[len(series)-1]
+		last.Measurements = append(last.Measurements, measurement)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// WeightAdd добавляет взвешивание щенка в базу данных. Повторное взвешивание в тот же день заменяет прежнее
+func (r *PostgresRepo) WeightAdd(measurement *domain.WeightMeasurement) error {
+	query := `INSERT INTO puppy_weights (puppy_id, date, grams) VALUES ($1, $2, $3)
+	          ON CONFLICT (puppy_id, date) DO UPDATE SET grams = EXCLUDED.grams RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, measurement.PuppyID, measurement.Date, measurement.Grams,
+	).Scan(&measurement.ID)
+}
+
+// WeightDelete удаляет взвешивание щенка из базы данных
+func (r *PostgresRepo) WeightDelete(measurementID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM puppy_weights WHERE id = $1", measurementID)
+	return err
+}
+
+// ShowResultsGet получает результаты выставок собаки, начиная с последних, в базе данных
+func (r *PostgresRepo) ShowResultsGet(dogID int) ([]domain.ShowResult, error) {
+	query := "SELECT " + showResultColumns + " FROM show_results sr WHERE sr.dog_id = $1"
+	query += " ORDER BY sr.date DESC, sr.id DESC"
+
+	rows, err := r.pool.Query(context.Background(), query, dogID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]domain.ShowResult, 0)
+	for rows.Next() {
+		var result domain.ShowResult
+		if err := scanShowResult(rows, &result); err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ShowResultGet получает результат выставки в базе данных
+func (r *PostgresRepo) ShowResultGet(idResult string) (*domain.ShowResult, error) {
+	query := "SELECT " + showResultColumns + " FROM show_results sr WHERE sr.id = $1"
+
+	result := &domain.ShowResult{}
+	err := scanShowResult(r.pool.QueryRow(context.Background(), query, idResult), result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ShowResultAdd добавляет результат выставки в базу данных
+func (r *PostgresRepo) ShowResultAdd(result *domain.ShowResult) error {
+	query := `INSERT INTO show_results (dog_id, event, date, judge, grade, titles, certificate_url)
+	          VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, result.DogID, result.Event, result.Date, result.Judge, result.Grade,
+		pq.Array(result.Titles), result.CertificateURL,
+	).Scan(&result.ID)
+}
+
+// ShowResultUpdate обновляет результат выставки в базе данных, собака не меняется
+func (r *PostgresRepo) ShowResultUpdate(result *domain.ShowResult) error {
+	query := `UPDATE show_results SET event=$1, date=$2, judge=$3, grade=$4, titles=$5, certificate_url=$6
+	WHERE id=$7 RETURNING id`
+	return r.pool.QueryRow(
+		context.Background(), query, result.Event, result.Date, result.Judge, result.Grade, pq.Array(result.Titles),
+		result.CertificateURL, result.ID,
+	).Scan(&result.ID)
+}
+
+// ShowResultDelete удаляет результат выставки из базы данных
+func (r *PostgresRepo) ShowResultDelete(resultID string) error {
+	_, err := r.pool.Exec(context.Background(), "DELETE FROM show_results WHERE id = $1", resultID)
+	return err
+}
+
+// PriceHistoryGet получает историю изменения цены щенка, начиная с последних изменений, в базе данных
+func (r *PostgresRepo) PriceHistoryGet(puppyID int) ([]domain.PriceChange, error) {
+	query := `SELECT id, puppy_id, old_amount, old_currency, new_amount, new_currency, changed_at
+	          FROM puppy_price_history WHERE puppy_id = $1 ORDER BY changed_at DESC, id DESC`
+
+	rows, err := r.pool.Query(context.Background(), query, puppyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	changes := make([]domain.PriceChange, 0)
+	for rows.Next() {
+		var change domain.PriceChange
+		err := rows.Scan(
+			&change.ID, &change.PuppyID, &change.OldPrice.Amount, &change.OldPrice.Currency, &change.NewPrice.Amount,
+			&change.NewPrice.Currency, &change.ChangedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// dictionaryTables сопоставляет справочнику его таблицу. Имя таблицы нельзя передать
+// параметром запроса, поэтому справочник проверяется по этому списку.
+var dictionaryTables = map[string]string{
+	domain.DictionaryBreeds: "breeds",
+	domain.DictionaryColors: "colors",
+	domain.DictionarySexes:  "sexes",
+}
+
+// dictionaryUsage — условия, по которым значение справочника уже указано у щенка, собаки
+// или помёта. Окрас занят только у щенков и собак своей породы.
+var dictionaryUsage = map[string]string{
+	domain.DictionaryBreeds: "EXISTS (SELECT 1 FROM puppies WHERE breed = t.value) OR " +
+		"EXISTS (SELECT 1 FROM adult_dogs WHERE breed = t.value) OR " +
+		"EXISTS (SELECT 1 FROM litters WHERE breed = t.value) OR " +
+		"EXISTS (SELECT 1 FROM colors WHERE breed = t.value)",
+	domain.DictionaryColors: "EXISTS (SELECT 1 FROM puppies WHERE color = t.value AND breed = t.breed) OR " +
+		"EXISTS (SELECT 1 FROM adult_dogs WHERE color = t.value AND breed = t.breed)",
+	domain.DictionarySexes: "EXISTS (SELECT 1 FROM puppies WHERE gender = t.value) OR " +
+		"EXISTS (SELECT 1 FROM adult_dogs WHERE gender = t.value)",
+}
+
+// dictionaryTable возвращает таблицу справочника.
+func dictionaryTable(kind string) (string, error) {
+	table, ok := dictionaryTables[kind]
+	if !ok {
+		return "", fmt.Errorf("unknown dictionary: %s", kind)
+	}
+	return table, nil
+}
+
+// dictionaryBreed возвращает столбец породы справочника: порода есть только у окрасов.
+func dictionaryBreed(kind string) string {
+	if kind == domain.DictionaryColors {
+		return "breed"
+	}
+	return "''"
+}
+
+// DictionaryGet получает записи справочника в порядке сортировки из базы данных
+func (r *PostgresRepo) DictionaryGet(kind string) ([]domain.DictionaryEntry, error) {
+	table, err := dictionaryTable(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "SELECT id, " + dictionaryBreed(kind) + ", value, label_ru, label_en, sort_order FROM " + table +
+		" ORDER BY sort_order, id"
+	rows, err := r.pool.Query(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]domain.DictionaryEntry, 0)
+	for rows.Next() {
+		entry := domain.DictionaryEntry{Kind: kind}
+		err := rows.Scan(&entry.ID, &entry.Breed, &entry.Value, &entry.LabelRu, &entry.LabelEn, &entry.SortOrder)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// DictionaryEntryAdd добавляет запись справочника в базу данных
+func (r *PostgresRepo) DictionaryEntryAdd(entry *domain.DictionaryEntry) error {
+	table, err := dictionaryTable(entry.Kind)
+	if err != nil {
+		return err
+	}
+
+	// Окрас заводится для конкретной породы
+	if entry.Kind == domain.DictionaryColors {
+		query := "INSERT INTO " + table + " (breed, value, label_ru, label_en, sort_order) VALUES ($1, $2, $3, $4, $5) RETURNING id"
+		return r.pool.QueryRow(
+			context.Background(), query, entry.Breed, entry.Value, entry.LabelRu, entry.LabelEn, entry.SortOrder,
+		).Scan(&entry.ID)
+	}
+
+	query := "INSERT INTO " + table + " (value, label_ru, label_en, sort_order) VALUES ($1, $2, $3, $4) RETURNING id"
+	return r.pool.QueryRow(
+		context.Background(), query, entry.Value, entry.LabelRu, entry.LabelEn, entry.SortOrder,
+	).Scan(&entry.ID)
+}
+
+// DictionaryEntryUpdate обновляет подписи и порядок записи справочника в базе данных. Значение не меняется.
+func (r *PostgresRepo) DictionaryEntryUpdate(entry *domain.DictionaryEntry) error {
+	table, err := dictionaryTable(entry.Kind)
+	if err != nil {
+		return err
+	}
+
+	query := "UPDATE " + table + " SET label_ru = $1, label_en = $2, sort_order = $3 WHERE id = $4 RETURNING value"
+	return r.pool.QueryRow(
+		context.Background(), query, entry.LabelRu, entry.LabelEn, entry.SortOrder, entry.ID,
+	).Scan(&entry.Value)
+}
+
+// DictionaryEntryDelete удаляет запись справочника из базы данных, если её значение ещё не указано
+// ни у одного щенка или собаки. Возвращает false, если запись не удалена.
+func (r *PostgresRepo) DictionaryEntryDelete(kind string, entryID string) (bool, error) {
+	table, err := dictionaryTable(kind)
+	if err != nil {
+		return false, err
+	}
+
+	query := "DELETE FROM " + table + " t WHERE t.id = $1"
+	if usage, ok := dictionaryUsage[kind]; ok {
+		query += " AND NOT (" + usage + ")"
+	}
+	tag, err := r.pool.Exec(context.Background(), query, entryID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// searchQuery ищет щенков, собак питомника, проверенные отзывы и опубликованные статьи. Совпадения ищутся по
+// поисковым векторам, а опечатки — по триграммам клички и текста. Результаты всех разделов
+// ранжируются вместе: вес полнотекстового совпадения плюс триграммная похожесть.
+const searchQuery = `WITH q AS (SELECT websearch_to_tsquery('russian', $1) AS query,
+                   'StartSel=' || $3 || ', StopSel=' || $4 || ', MaxFragments=2, MaxWords=25, MinWords=8' AS options)
+SELECT 'puppy', p.id, p.name,
+       ts_headline('russian', p.name || '. ' || p.title, q.query, q.options),
+       ts_rank(p.search_vector, q.query) + GREATEST(similarity(p.name, $1), word_similarity($1, p.title)) AS rank
+FROM puppies p, q
+WHERE p.search_vector @@ q.query OR p.name % $1 OR $1 <% p.title
+UNION ALL
+SELECT 'dog', d.id, d.name,
+       ts_headline('russian', d.name || '. ' || d.title, q.query, q.options),
+       ts_rank(d.search_vector, q.query) + GREATEST(similarity(d.name, $1), word_similarity($1, d.title))
+FROM adult_dogs d, q
+WHERE NOT d.external AND (d.search_vector @@ q.query OR d.name % $1 OR $1 <% d.title)
+UNION ALL
+SELECT 'review', r.puppy_id, COALESCE(p.name, r.name),
+       ts_headline('russian', r.title, q.query, q.options),
+       ts_rank(r.search_vector, q.query) + word_similarity($1, r.title)
+FROM reviews r LEFT JOIN puppies p ON p.id = r.puppy_id, q
+WHERE r.verified AND r.puppy_id IS NOT NULL AND (r.search_vector @@ q.query OR $1 <% r.title)
+UNION ALL
+SELECT 'article', a.id, a.title,
+       ts_headline('russian', a.title || '. ' || a.body, q.query, q.options),
+       ts_rank(a.search_vector, q.query) + word_similarity($1, a.title)
+FROM articles a, q
+WHERE a.published AND (a.search_vector @@ q.query OR $1 <% a.title)
+ORDER BY rank DESC
+LIMIT $2`
+
+// Search выполняет полнотекстовый поиск по сайту в базе данных
+func (r *PostgresRepo) Search(query string, limit int) ([]domain.SearchResult, error) {
+	rows, err := r.pool.Query(
+		context.Background(), searchQuery, query, limit, domain.SearchHighlightStart, domain.SearchHighlightStop,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]domain.SearchResult, 0)
+	for rows.Next() {
+		var result domain.SearchResult
+		err := rows.Scan(&result.Kind, &result.ID, &result.Title, &result.Snippet, &result.Rank)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// DogsPageGet получает одну страницу списка собак в базе данных и общее количество подходящих собак.
+// Собаки выводятся по убыванию ID, поэтому следующая страница выбирается по ключу.
+func (r *PostgresRepo) DogsPageGet(chocolates, genders []string, archived bool, page domain.Page) ([]domain.Dog, int, error) {
+	// Подготовка условий запроса
+	query := " WHERE d.archived = $1"
+	args := []interface{}{archived}
+
+	if len(chocolates) > 0 {
+		args = append(args, pq.Array(chocolates))
+		query += fmt.Sprintf(" AND d.color = ANY($%d)", len(args))
+	}
+
+	if len(genders) > 0 {
+		args = append(args, pq.Array(genders))
+		query += fmt.Sprintf(" AND d.gender = ANY($%d)", len(args))
+	}
+
+	// Общее количество собак считается без условия страницы
+	var total int
+	err := r.pool.QueryRow(context.Background(), "SELECT count(*) FROM adult_dogs d"+query, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if page.After > 0 {
+		args = append(args, page.After)
+		query += fmt.Sprintf(" AND d.id < $%d", len(args))
+	}
+
+	query = "SELECT " + dogColumns + ", array_agg(i.url) as urls FROM adult_dogs d" +
+		" LEFT JOIN adult_dogs_img di ON d.id = di.adult_dogs_id" +
+		" LEFT JOIN img_urls i ON di.img_url_id = i.id" + query +
+		" GROUP BY d.id ORDER BY d.id DESC" + pageLimit(page)
+
+	rows, err := r.pool.Query(context.Background(), query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	dogs := make([]domain.Dog, 0)
+	for rows.Next() {
+		var dog domain.Dog
+		if err := scanDog(rows, &dog); err != nil {
+			return nil, 0, err
+		}
+		dogs = append(dogs, dog)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return dogs, total, nil
+}
+
+// PuppyFacetsGet считает щенков по окрасам и полам одним агрегирующим запросом. Запрос группирует
+// щенков по паре окрас—пол без фильтров по окрасу и полу, а эти фильтры применяются при сложении:
+// к числу по окрасу — выбранные полы, к числу по полу — выбранные окрасы.
+func (r *PostgresRepo) PuppyFacetsGet(
+	chocolates, genders, statuses []string, readyToMove, breed string, filter domain.PuppyFilter,
+) (*domain.PuppyFacets, error) {
+	conditions, args := r.puppyConditions(nil, nil, statuses, "", readyToMove, breed, filter)
+	query := "SELECT p.color, p.gender, count(*) FROM puppies p" + conditions + " GROUP BY p.color, p.gender"
+
+	rows, err := r.pool.Query(context.Background(), query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	selectedColors := make(map[string]bool, len(chocolates))
+	for _, color := range chocolates {
+		selectedColors[color] = true
+	}
+	selectedGenders := make(map[string]bool, len(genders))
+	for _, gender := range genders {
+		selectedGenders[gender] = true
+	}
+
+	facets := &domain.PuppyFacets{Colors: map[string]int{}, Sexes: map[string]int{}}
+	for rows.Next() {
+		var color, gender string
+		var count int
+		if err := rows.Scan(&color, &gender, &count); err != nil {
+			return nil, err
+		}
+		if len(genders) == 0 || selectedGenders[gender] {
+			facets.Colors[color] += count
+		}
+		if len(chocolates) == 0 || selectedColors[color] {
+			facets.Sexes[gender] += count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return facets, nil
+}
+
+// DogOffspringGet получает список щенков, у которых собака указана матерью или отцом, в базе данных
+func (r *PostgresRepo) DogOffspringGet(dogID int) ([]domain.Puppy, error) {
+	query := "SELECT " + r.puppyColumns() + ", array_agg(i.url) as urls FROM puppies p"
+	query += " LEFT JOIN puppies_img pi ON p.id = pi.puppy_id"
+	query += " LEFT JOIN img_urls i ON pi.img_url_id = i.id"
+	query += " WHERE p.mother_id = $1 OR p.father_id = $1"
+	query += " GROUP BY p.id"
+	query += " ORDER BY p.date_birth DESC NULLS LAST, p.id DESC"
+
+	rows, err := r.pool.Query(context.Background(), query, dogID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	puppies := make([]domain.Puppy, 0)
+	for rows.Next() {
+		var puppy domain.Puppy
+		if err := scanPuppy(rows, &puppy); err != nil {
+			return nil, err
+		}
+		puppies = append(puppies, puppy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return puppies, nil
+}
+
+// ArticlesGet получает одну страницу списка статей в базе данных и общее количество подходящих статей.
+// Черновики попадают в список только для админки, опубликованные статьи выводятся с последних.
+func (r *PostgresRepo) ArticlesGet(tag string, drafts bool, page domain.Page) ([]domain.Article, int, error) {
+	// Подготовка условий запроса
+	query := " WHERE 1=1"
+	args := make([]interface{}, 0)
+
+	if !drafts {
+		query += " AND a.published"
+	}
+
+	if tag != "" {
+		args = append(args, tag)
+		query += fmt.Sprintf(" AND $%d = ANY(a.tags)", len(args))
+	}
+
+	// Общее количество статей считается без условия страницы
+	var total int
+	err := r.pool.QueryRow(context.Background(), "SELECT count(*) FROM articles a"+query, args...).Scan(&total)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query = "SELECT " + articleColumns + " FROM articles a" + query +
+		" ORDER BY a.published_at DESC NULLS FIRST, a.id DESC" + pageLimit(page)
+
+	rows, err := r.pool.Query(context.Background(), query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	articles := make([]domain.Article, 0)
+	for rows.Next() {
+		var article domain.Article
+		if err := scanArticle(rows, &article); err != nil {
+			return nil, 0, err
+		}
+		articles = append(articles, article)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return articles, total, nil
+}
+
+// ArticleGet получает статью по ID в базе данных
+func (r *PostgresRepo) ArticleGet(idArticle string) (*domain.Article, error) {
+	query := "SELECT " + articleColumns + " FROM articles a WHERE a.id = $1"
+
+	article := &domain.Article{}
+	err := scanArticle(r.pool.QueryRow(context.Background(), query, idArticle), article)
+	if err != nil {
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// ArticleGetBySlug ищет статью по адресу в базе данных.
+// Если статьи с таким адресом нет, возвращает nil без ошибки.
+func (r *PostgresRepo) ArticleGetBySlug(slug string) (*domain.Article, error) {
+	query := "SELECT " + articleColumns + " FROM articles a WHERE a.slug = $1"
+
+	article := &domain.Article{}
+	err := scanArticle(r.pool.QueryRow(context.Background(), query, slug), article)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return article, nil
+}
+
+// ArticleAdd добавляет статью в базу данных
+func (r *PostgresRepo) ArticleAdd(article *domain.Article) error {
+	query := `INSERT INTO articles (title, slug, body, cover_url, tags, published, published_at)
+	          VALUES ($1, $2, $3, $4, $5, $6, CASE WHEN $6 THEN now() END)
+	          RETURNING id, COALESCE(published_at, '0001-01-01'::timestamptz), updated_at`
+	return r.pool.QueryRow(
+		context.Background(), query, article.Title, article.Slug, article.Body, article.CoverURL,
+		pq.Array(article.Tags), article.Published,
+	).Scan(&article.ID, &article.PublishedAt, &article.UpdatedAt)
+}
+
+// ArticleUpdate обновляет статью в базе данных. Дата публикации ставится только при первой публикации.
+func (r *PostgresRepo) ArticleUpdate(article *domain.Article) error {
+	query := `UPDATE articles
+	          SET title=$1, slug=$2, body=$3, cover_url=$4, tags=$5, published=$6,
+	              published_at=CASE WHEN $6 THEN COALESCE(published_at, now()) ELSE published_at END,
+	              updated_at=now()
+	          WHERE id=$7
+	          RETURNING COALESCE(published_at, '0001-01-01'::timestamptz), updated_at`
+	return r.pool.QueryRow(
+		context.Background(), query, article.Title, article.Slug, article.Body, article.CoverURL,
+		pq.Array(article.Tags), article.Published, article.ID,
+	).Scan(&article.PublishedAt, &article.UpdatedAt)
+}
+
+// SiteSettingsGet получает настройки сайта из базы данных
+func (r *PostgresRepo) SiteSettingsGet() (*domain.SiteSettings, error) {
+	query := `SELECT phone, email, address, opening_hours, telegram, whatsapp, instagram, vk,
+	                 map_lat, map_lng, footer_text, seo_title, seo_description, seo_keywords, updated_at
+	          FROM site_settings WHERE id = 1`
+
+	settings := &domain.SiteSettings{}
+	err := r.pool.QueryRow(context.Background(), query).Scan(
+		&settings.Phone, &settings.Email, &settings.Address, &settings.OpeningHours,
+		&settings.Telegram, &settings.WhatsApp, &settings.Instagram, &settings.VK,
+		&settings.MapLat, &settings.MapLng, &settings.FooterText,
+		&settings.SEOTitle, &settings.SEODescription, &settings.SEOKeywords, &settings.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// SiteSettingsUpdate сохраняет настройки сайта в базе данных
+func (r *PostgresRepo) SiteSettingsUpdate(settings *domain.SiteSettings) error {
+	query := `INSERT INTO site_settings (id, phone, email, address, opening_hours, telegram, whatsapp, instagram, vk,
+	                                     map_lat, map_lng, footer_text, seo_title, seo_description, seo_keywords)
+	          VALUES (1, $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	          ON CONFLICT (id) DO UPDATE
+	          SET phone=EXCLUDED.phone, email=EXCLUDED.email, address=EXCLUDED.address,
+	              opening_hours=EXCLUDED.opening_hours, telegram=EXCLUDED.telegram, whatsapp=EXCLUDED.whatsapp,
+	              instagram=EXCLUDED.instagram, vk=EXCLUDED.vk, map_lat=EXCLUDED.map_lat, map_lng=EXCLUDED.map_lng,
+	              footer_text=EXCLUDED.footer_text, seo_title=EXCLUDED.seo_title,
+	              seo_description=EXCLUDED.seo_description, seo_keywords=EXCLUDED.seo_keywords, updated_at=now()
+	          RETURNING updated_at`
+	return r.pool.QueryRow(
+		context.Background(), query, settings.Phone, settings.Email, settings.Address, settings.OpeningHours,
+		settings.Telegram, settings.WhatsApp, settings.Instagram, settings.VK, settings.MapLat, settings.MapLng,
+		settings.FooterText, settings.SEOTitle, settings.SEODescription, settings.SEOKeywords,
+	).Scan(&settings.UpdatedAt)
+}
+
+// apiTokenColumns перечисляет поля токена API в порядке, ожидаемом scanAPIToken.
+const apiTokenColumns = `id, name, prefix, token_hash, created_at,
+	COALESCE(last_used_at, '0001-01-01'::timestamptz), COALESCE(revoked_at, '0001-01-01'::timestamptz)`
+
+// scanAPIToken читает токен API из строки результата.
+func scanAPIToken(row scanner, token *domain.APIToken) error {
+	return row.Scan(
+		&token.ID, &token.Name, &token.Prefix, &token.Hash, &token.CreatedAt, &token.LastUsedAt, &token.RevokedAt,
+	)
+}
+
+// APITokensGet получает список токенов API из базы данных, новые первыми
+func (r *PostgresRepo) APITokensGet() ([]domain.APIToken, error) {
+	query := "SELECT " + apiTokenColumns + " FROM api_tokens ORDER BY id DESC"
+
+	rows, err := r.pool.Query(context.Background(), query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := []domain.APIToken{}
+	for rows.Next() {
+		var token domain.APIToken
+		if err := scanAPIToken(rows, &token); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// APITokenGetByHash ищет токен API по хэшу в базе данных.
+// Если токена с таким хэшем нет, возвращает nil без ошибки.
+func (r *PostgresRepo) APITokenGetByHash(hash string) (*domain.APIToken, error) {
+	query := "SELECT " + apiTokenColumns + " FROM api_tokens WHERE token_hash = $1"
+
+	token := &domain.APIToken{}
+	err := scanAPIToken(r.pool.QueryRow(context.Background(), query, hash), token)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return token, nil
+}
+
+// APITokenAdd добавляет токен API в базу данных
+func (r *PostgresRepo) APITokenAdd(token *domain.APIToken) error {
+	query := `INSERT INTO api_tokens (name, prefix, token_hash) VALUES ($1, $2, $3) RETURNING id, created_at`
+	return r.pool.QueryRow(
+		context.Background(), query, token.Name, token.Prefix, token.Hash,
+	).Scan(&token.ID, &token.CreatedAt)
+}
+
+// APITokenRevoke отзывает токен API. Дата отзыва уже отозванного токена не меняется.
+func (r *PostgresRepo) APITokenRevoke(tokenID string) error {
+	query := `UPDATE api_tokens SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL`
+	_, err := r.pool.Exec(context.Background(), query, tokenID)
+	return err
+}
+
+// APITokenUsed запоминает время последнего запроса с токеном API
+func (r *PostgresRepo) APITokenUsed(tokenID int) error {
+	query := `UPDATE api_tokens SET last_used_at = now() WHERE id = $1`
+	_, err := r.pool.Exec(context.Background(), query, tokenID)
+	return err
+}
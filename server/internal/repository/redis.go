@@ -13,10 +13,12 @@ import (
 type RedisRepository interface {
 	GetPuppies(cacheKey string) (*CachedPuppies, error)
 	SetPuppies(cacheKey string, cachedPuppies *CachedPuppies) error
+	GetPuppyFacets(cacheKey string) (*domain.PuppyFacets, error)
+	SetPuppyFacets(cacheKey string, facets *domain.PuppyFacets) error
 	GetPuppyReviews(cacheKey string) (map[int]int, error)
 	SetPuppyReviews(cacheKey string, reviews map[int]int) error
-	GetReviews(cacheKey string) ([]domain.Feedback, error)
-	SetReviews(cacheKey string, reviews []domain.Feedback) error
+	GetReviews(cacheKey string) (*CachedReviews, error)
+	SetReviews(cacheKey string, cachedReviews *CachedReviews) error
 	GetFeedback(cacheKey string) (*domain.Feedback, error)
 	SetFeedback(cacheKey string, feedback *domain.Feedback) error
 	GetPuppyNames(cacheKey string) (map[int]string, error)
@@ -25,6 +27,28 @@ type RedisRepository interface {
 	SetPuppy(cacheKey string, puppy *domain.Puppy) error
 	GetDog(cacheKey string) (*domain.Dog, error)
 	SetDog(cacheKey string, dog *domain.Dog) error
+	GetLitter(cacheKey string) (*domain.Litter, error)
+	SetLitter(cacheKey string, litter *domain.Litter) error
+	GetPedigree(cacheKey string) (*domain.PedigreeNode, error)
+	SetPedigree(cacheKey string, pedigree *domain.PedigreeNode) error
+	GetUpcomingLitters(cacheKey string) ([]domain.UpcomingLitter, error)
+	SetUpcomingLitters(cacheKey string, litters []domain.UpcomingLitter) error
+	GetHealthSummary(cacheKey string) (*domain.HealthSummary, error)
+	SetHealthSummary(cacheKey string, summary *domain.HealthSummary) error
+	GetGrowth(cacheKey string) ([]domain.GrowthSeries, error)
+	SetGrowth(cacheKey string, series []domain.GrowthSeries) error
+	GetShowResults(cacheKey string) ([]domain.ShowResult, error)
+	SetShowResults(cacheKey string, results []domain.ShowResult) error
+	GetDictionaries(cacheKey string) (*domain.Dictionaries, error)
+	SetDictionaries(cacheKey string, dictionaries *domain.Dictionaries) error
+	GetSearchResults(cacheKey string) ([]domain.SearchResult, error)
+	SetSearchResults(cacheKey string, results []domain.SearchResult) error
+	GetArticles(cacheKey string) (*CachedArticles, error)
+	SetArticles(cacheKey string, cachedArticles *CachedArticles) error
+	GetArticle(cacheKey string) (*domain.Article, error)
+	SetArticle(cacheKey string, article *domain.Article) error
+	GetSiteSettings(cacheKey string) (*domain.SiteSettings, error)
+	SetSiteSettings(cacheKey string, settings *domain.SiteSettings) error
 	FlushAll()
 }
 
@@ -70,6 +94,37 @@ func (r *RedisRepo) SetPuppies(cacheKey string, cachedPuppies *CachedPuppies) er
 	return nil
 }
 
+func (r *RedisRepo) GetPuppyFacets(cacheKey string) (*domain.PuppyFacets, error) {
+	r.logger.Info("Start get cache GetPuppyFacets")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var facets domain.PuppyFacets
+	err = json.Unmarshal([]byte(val), &facets)
+	if err != nil {
+		return nil, err
+	}
+	return &facets, nil
+}
+
+func (r *RedisRepo) SetPuppyFacets(cacheKey string, facets *domain.PuppyFacets) error {
+	r.logger.Info("Start set cache SetPuppyFacets")
+	data, err := json.Marshal(facets)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *RedisRepo) GetPuppyReviews(cacheKey string) (map[int]int, error) {
 	r.logger.Info("Start get cache GetPuppyReviews")
 	val, err := r.client.Get(context.Background(), cacheKey).Result()
@@ -101,7 +156,13 @@ func (r *RedisRepo) SetPuppyReviews(cacheKey string, reviews map[int]int) error
 	return nil
 }
 
-func (r *RedisRepo) GetReviews(cacheKey string) ([]domain.Feedback, error) {
+// CachedReviews — страница отзывов в кеше вместе с количеством страниц.
+type CachedReviews struct {
+	Reviews    []domain.Feedback
+	TotalPages int
+}
+
+func (r *RedisRepo) GetReviews(cacheKey string) (*CachedReviews, error) {
 	r.logger.Info("Start get cache GetReviews")
 	val, err := r.client.Get(context.Background(), cacheKey).Result()
 	if err == redis.Nil {
@@ -110,17 +171,17 @@ func (r *RedisRepo) GetReviews(cacheKey string) ([]domain.Feedback, error) {
 		return nil, err
 	}
 
-	var reviews []domain.Feedback
-	err = json.Unmarshal([]byte(val), &reviews)
+	var cachedReviews CachedReviews
+	err = json.Unmarshal([]byte(val), &cachedReviews)
 	if err != nil {
 		return nil, err
 	}
-	return reviews, nil
+	return &cachedReviews, nil
 }
 
-func (r *RedisRepo) SetReviews(cacheKey string, reviews []domain.Feedback) error {
+func (r *RedisRepo) SetReviews(cacheKey string, cachedReviews *CachedReviews) error {
 	r.logger.Info("Start set cache SetReviews")
-	data, err := json.Marshal(reviews)
+	data, err := json.Marshal(cachedReviews)
 	if err != nil {
 		return err
 	}
@@ -256,8 +317,355 @@ func (r *RedisRepo) SetDog(cacheKey string, dog *domain.Dog) error {
 	return nil
 }
 
+func (r *RedisRepo) GetLitter(cacheKey string) (*domain.Litter, error) {
+	r.logger.Info("Start get cache GetLitter")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var litter domain.Litter
+	err = json.Unmarshal([]byte(val), &litter)
+	if err != nil {
+		return nil, err
+	}
+	return &litter, nil
+}
+
+func (r *RedisRepo) SetLitter(cacheKey string, litter *domain.Litter) error {
+	r.logger.Info("Start set cache SetLitter")
+	data, err := json.Marshal(litter)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetPedigree(cacheKey string) (*domain.PedigreeNode, error) {
+	r.logger.Info("Start get cache GetPedigree")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var pedigree domain.PedigreeNode
+	err = json.Unmarshal([]byte(val), &pedigree)
+	if err != nil {
+		return nil, err
+	}
+	return &pedigree, nil
+}
+
+func (r *RedisRepo) SetPedigree(cacheKey string, pedigree *domain.PedigreeNode) error {
+	r.logger.Info("Start set cache SetPedigree")
+	data, err := json.Marshal(pedigree)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetUpcomingLitters(cacheKey string) ([]domain.UpcomingLitter, error) {
+	r.logger.Info("Start get cache GetUpcomingLitters")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var litters []domain.UpcomingLitter
+	err = json.Unmarshal([]byte(val), &litters)
+	if err != nil {
+		return nil, err
+	}
+	return litters, nil
+}
+
+func (r *RedisRepo) SetUpcomingLitters(cacheKey string, litters []domain.UpcomingLitter) error {
+	r.logger.Info("Start set cache SetUpcomingLitters")
+	data, err := json.Marshal(litters)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (r *RedisRepo) FlushAll() {
 	r.logger.Info("Start Flush All")
 	r.client.FlushAll(context.Background())
 	return
 }
+
+func (r *RedisRepo) GetHealthSummary(cacheKey string) (*domain.HealthSummary, error) {
+	r.logger.Info("Start get cache GetHealthSummary")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var summary domain.HealthSummary
+	err = json.Unmarshal([]byte(val), &summary)
+	if err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+func (r *RedisRepo) SetHealthSummary(cacheKey string, summary *domain.HealthSummary) error {
+	r.logger.Info("Start set cache SetHealthSummary")
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetGrowth(cacheKey string) ([]domain.GrowthSeries, error) {
+	r.logger.Info("Start get cache GetGrowth")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var series []domain.GrowthSeries
+	err = json.Unmarshal([]byte(val), &series)
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+func (r *RedisRepo) SetGrowth(cacheKey string, series []domain.GrowthSeries) error {
+	r.logger.Info("Start set cache SetGrowth")
+	data, err := json.Marshal(series)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetShowResults(cacheKey string) ([]domain.ShowResult, error) {
+	r.logger.Info("Start get cache GetShowResults")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var results []domain.ShowResult
+	err = json.Unmarshal([]byte(val), &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *RedisRepo) SetShowResults(cacheKey string, results []domain.ShowResult) error {
+	r.logger.Info("Start set cache SetShowResults")
+	data, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetDictionaries(cacheKey string) (*domain.Dictionaries, error) {
+	r.logger.Info("Start get cache GetDictionaries")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var dictionaries domain.Dictionaries
+	err = json.Unmarshal([]byte(val), &dictionaries)
+	if err != nil {
+		return nil, err
+	}
+	return &dictionaries, nil
+}
+
+func (r *RedisRepo) SetDictionaries(cacheKey string, dictionaries *domain.Dictionaries) error {
+	r.logger.Info("Start set cache SetDictionaries")
+	data, err := json.Marshal(dictionaries)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetSearchResults(cacheKey string) ([]domain.SearchResult, error) {
+	r.logger.Info("Start get cache GetSearchResults")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var results []domain.SearchResult
+	err = json.Unmarshal([]byte(val), &results)
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *RedisRepo) SetSearchResults(cacheKey string, results []domain.SearchResult) error {
+	r.logger.Info("Start set cache SetSearchResults")
+	data, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// CachedArticles — страница статей в кеше вместе с количеством страниц.
+type CachedArticles struct {
+	Articles   []domain.Article
+	TotalPages int
+}
+
+func (r *RedisRepo) GetArticles(cacheKey string) (*CachedArticles, error) {
+	r.logger.Info("Start get cache GetArticles")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var cachedArticles CachedArticles
+	err = json.Unmarshal([]byte(val), &cachedArticles)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedArticles, nil
+}
+
+func (r *RedisRepo) SetArticles(cacheKey string, cachedArticles *CachedArticles) error {
+	r.logger.Info("Start set cache SetArticles")
+	data, err := json.Marshal(cachedArticles)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetArticle(cacheKey string) (*domain.Article, error) {
+	r.logger.Info("Start get cache GetArticle")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var article domain.Article
+	err = json.Unmarshal([]byte(val), &article)
+	if err != nil {
+		return nil, err
+	}
+	return &article, nil
+}
+
+func (r *RedisRepo) SetArticle(cacheKey string, article *domain.Article) error {
+	r.logger.Info("Start set cache SetArticle")
+	data, err := json.Marshal(article)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *RedisRepo) GetSiteSettings(cacheKey string) (*domain.SiteSettings, error) {
+	r.logger.Info("Start get cache GetSiteSettings")
+	val, err := r.client.Get(context.Background(), cacheKey).Result()
+	if err == redis.Nil {
+		return nil, nil // Данных нет в кеше
+	} else if err != nil {
+		return nil, err
+	}
+
+	var settings domain.SiteSettings
+	err = json.Unmarshal([]byte(val), &settings)
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (r *RedisRepo) SetSiteSettings(cacheKey string, settings *domain.SiteSettings) error {
+	r.logger.Info("Start set cache SetSiteSettings")
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.Set(context.Background(), cacheKey, data, time.Hour).Err()
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 // S3Repository представляет интерфейс для работы с файлами в S3.
 type S3Repository interface {
 	PutInS3(id string, fileHeaders []*multipart.FileHeader, aspectRatio float64, width, height uint) ([]string, error)
+	PutDocumentInS3(id string, fileHeader *multipart.FileHeader) (string, error)
 	DeleteFromS3(url string) error
 }
 
@@ -111,6 +112,43 @@ func (r *S3Repo) PutInS3(id string, fileHeaders []*multipart.FileHeader, aspectR
 	return urls, nil
 }
 
+// PutDocumentInS3 загружает документ в S3 без обработки, как есть.
+func (r *S3Repo) PutDocumentInS3(id string, fileHeader *multipart.FileHeader) (string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	// Генерируем уникальное имя файла
+	filename := fmt.Sprintf(id+"_%d_%s", time.Now().Unix(), strings.TrimSpace(fileHeader.Filename))
+
+	contentType := fileHeader.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	// Загружаем в S3
+	_, err = r.S3Client.PutObject(
+		context.TODO(), &s3.PutObjectInput{
+			Bucket:      aws.String(r.bucket),
+			Key:         aws.String(filename),
+			Body:        file,
+			ContentType: aws.String(contentType),
+			ACL:         "public-read",
+		},
+	)
+	if err != nil {
+		log.Printf(
+			"Couldn't upload document %v to %v:%v. Here's why: %v\n",
+			filename, r.bucket, filename, err,
+		)
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s.s3.cloud.ru/%s", r.bucket, filename), nil
+}
+
 // DeleteFromS3 удаляет объект из S3 по указанному URL.
 func (r *S3Repo) DeleteFromS3(url string) error {
 	// Извлекаем имя файла из URL
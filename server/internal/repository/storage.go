@@ -15,12 +15,14 @@ type Repository struct {
 	MongosRepository
 }
 
-// NewRepository создает новый экземпляр Repository.
-func NewRepository(pool *pgxpool.Pool, mongoClient *mongo.Client, redisClient *redis.Client, logger *zap.Logger, s3Client *s3.Client, bucket string) *Repository {
+// NewRepository создает новый экземпляр Repository. readyOutDays — возраст в днях,
+// с которого щенок без помёта считается готовым к переезду.
+func NewRepository(pool *pgxpool.Pool, mongoClient *mongo.Client, redisClient *redis.Client, logger *zap.Logger, s3Client *s3.Client, bucket string, readyOutDays int) *Repository {
 	return &Repository{
 		PostgresRepository: &PostgresRepo{
-			pool:   pool,
-			logger: logger,
+			pool:         pool,
+			logger:       logger,
+			readyOutDays: readyOutDays,
 		},
 		RedisRepository: &RedisRepo{
 			client: redisClient,
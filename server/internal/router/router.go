@@ -22,6 +22,9 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 	// Создание middleware для аутентификации
 	JWTMiddleware := authMiddleware.NewMiddlewareJWT(logger, authMiddleware.SigningKey)
 
+	// Middleware для аутентификации скриптов в API админки по токену
+	APITokenMiddleware := authMiddleware.NewMiddlewareAPIToken(logger, h.Services, h.APIAuthError)
+
 	// Создание роутера
 	r := chi.NewRouter()
 
@@ -87,8 +90,8 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 						},
 					)
 					r.Post(
-						"/puppies/archived", func(w http.ResponseWriter, r *http.Request) {
-							h.ChangeArchivedPuppy(w, r)
+						"/puppies/status", func(w http.ResponseWriter, r *http.Request) {
+							h.ChangeStatusPuppy(w, r)
 						},
 					)
 					r.Post(
@@ -106,6 +109,216 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 							h.ChangeArchivedDog(w, r)
 						},
 					)
+					r.Get(
+						"/litters", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminLittersHandler(w, r)
+						},
+					)
+					r.Post(
+						"/litters/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddLitter(w, r)
+						},
+					)
+					r.Post(
+						"/litters/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateLitter(w, r)
+						},
+					)
+					r.Post(
+						"/litters/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteLitter(w, r)
+						},
+					)
+					r.Get(
+						"/mating", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminMatingHandler(w, r)
+						},
+					)
+					r.Get(
+						"/matings", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminPlannedMatingsHandler(w, r)
+						},
+					)
+					r.Post(
+						"/matings/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddPlannedMating(w, r)
+						},
+					)
+					r.Post(
+						"/matings/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdatePlannedMating(w, r)
+						},
+					)
+					r.Post(
+						"/matings/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeletePlannedMating(w, r)
+						},
+					)
+					r.Post(
+						"/matings/whelp", func(w http.ResponseWriter, r *http.Request) {
+							h.WhelpPlannedMating(w, r)
+						},
+					)
+					r.Get(
+						"/buyers", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminBuyersHandler(w, r)
+						},
+					)
+					r.Get(
+						"/buyers/{id}", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminBuyerHandler(w, r)
+						},
+					)
+					r.Post(
+						"/buyers/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddBuyer(w, r)
+						},
+					)
+					r.Post(
+						"/buyers/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateBuyer(w, r)
+						},
+					)
+					r.Post(
+						"/buyers/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteBuyer(w, r)
+						},
+					)
+					r.Get(
+						"/waitlist", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminWaitlistHandler(w, r)
+						},
+					)
+					r.Post(
+						"/waitlist/active", func(w http.ResponseWriter, r *http.Request) {
+							h.ChangeActiveWaitlistEntry(w, r)
+						},
+					)
+					r.Post(
+						"/waitlist/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteWaitlistEntry(w, r)
+						},
+					)
+					r.Post(
+						"/waitlist/notified", func(w http.ResponseWriter, r *http.Request) {
+							h.NotifiedWaitlistEntry(w, r)
+						},
+					)
+					r.Get(
+						"/puppies/{id}/waitlist", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminPuppyWaitlistHandler(w, r)
+						},
+					)
+					r.Get(
+						"/puppies/{id}/health", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminPuppyHealthHandler(w, r)
+						},
+					)
+					r.Get(
+						"/dogs/{id}/health", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminDogHealthHandler(w, r)
+						},
+					)
+					r.Post(
+						"/health/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddHealthEvent(w, r)
+						},
+					)
+					r.Post(
+						"/health/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateHealthEvent(w, r)
+						},
+					)
+					r.Post(
+						"/health/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteHealthEvent(w, r)
+						},
+					)
+					r.Get(
+						"/puppies/{id}/weights", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminPuppyWeightsHandler(w, r)
+						},
+					)
+					r.Get(
+						"/dictionaries", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminDictionariesHandler(w, r)
+						},
+					)
+					r.Post(
+						"/dictionaries/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddDictionaryEntry(w, r)
+						},
+					)
+					r.Post(
+						"/dictionaries/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateDictionaryEntry(w, r)
+						},
+					)
+					r.Post(
+						"/dictionaries/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteDictionaryEntry(w, r)
+						},
+					)
+					r.Get(
+						"/settings", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminSettingsHandler(w, r)
+						},
+					)
+					r.Post(
+						"/settings/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateSiteSettings(w, r)
+						},
+					)
+					r.Get(
+						"/tokens", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminAPITokensHandler(w, r)
+						},
+					)
+					r.Post(
+						"/tokens/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddAPIToken(w, r)
+						},
+					)
+					r.Post(
+						"/tokens/revoke", func(w http.ResponseWriter, r *http.Request) {
+							h.RevokeAPIToken(w, r)
+						},
+					)
+					r.Get(
+						"/puppies/{id}/prices", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminPuppyPricesHandler(w, r)
+						},
+					)
+					r.Post(
+						"/weights/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddWeight(w, r)
+						},
+					)
+					r.Post(
+						"/weights/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteWeight(w, r)
+						},
+					)
+					r.Get(
+						"/dogs/{id}/shows", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminDogShowsHandler(w, r)
+						},
+					)
+					r.Post(
+						"/shows/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddShowResult(w, r)
+						},
+					)
+					r.Post(
+						"/shows/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateShowResult(w, r)
+						},
+					)
+					r.Post(
+						"/shows/delete", func(w http.ResponseWriter, r *http.Request) {
+							h.DeleteShowResult(w, r)
+						},
+					)
 					r.Get(
 						"/reviews", func(w http.ResponseWriter, r *http.Request) {
 							checked := true
@@ -138,6 +351,36 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 							h.AdminNewUser(w, r)
 						},
 					)
+					r.Get(
+						"/articles", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminArticlesHandler(w, r)
+						},
+					)
+					r.Get(
+						"/articles/new", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminArticleHandler(w, r)
+						},
+					)
+					r.Get(
+						"/articles/{id}", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminArticleHandler(w, r)
+						},
+					)
+					r.Get(
+						"/articles/{id}/preview", func(w http.ResponseWriter, r *http.Request) {
+							h.AdminArticlePreviewHandler(w, r)
+						},
+					)
+					r.Post(
+						"/articles/add", func(w http.ResponseWriter, r *http.Request) {
+							h.AddArticle(w, r)
+						},
+					)
+					r.Post(
+						"/articles/update", func(w http.ResponseWriter, r *http.Request) {
+							h.UpdateArticle(w, r)
+						},
+					)
 				},
 			)
 
@@ -152,6 +395,100 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 					h.AuthView(w, r)
 				},
 			)
+			// Публичный JSON API и его описание
+			route.Get(
+				"/api/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+					h.OpenAPIHandler(w, r)
+				},
+			)
+			route.Get(
+				"/api/docs", func(w http.ResponseWriter, r *http.Request) {
+					h.APIDocsView(w, r)
+				},
+			)
+			route.Route(
+				"/api/v1", func(r chi.Router) {
+					r.Get(
+						"/puppies", func(w http.ResponseWriter, r *http.Request) {
+							h.APIPuppiesHandler(w, r)
+						},
+					)
+					r.Get(
+						"/puppies/{id}", func(w http.ResponseWriter, r *http.Request) {
+							h.APIPuppyHandler(w, r)
+						},
+					)
+					r.Get(
+						"/dogs", func(w http.ResponseWriter, r *http.Request) {
+							h.APIDogsHandler(w, r)
+						},
+					)
+					r.Get(
+						"/reviews", func(w http.ResponseWriter, r *http.Request) {
+							h.APIReviewsHandler(w, r)
+						},
+					)
+
+					// Действия админки для скриптов: те же, что у форм админки, но с ответом в JSON
+					// и входом по токену API вместо cookie jwt
+					r.Route(
+						"/admin", func(r chi.Router) {
+							r.Use(APITokenMiddleware.APITokenAuth)
+
+							r.Post("/puppies/update", h.APIAdminAction(h.UpdatePuppy))
+							r.Post("/puppies/delete", h.APIAdminAction(h.DeletePuppy))
+							r.Post("/puppies/add", h.APIAdminAction(h.AddPuppy))
+							r.Post("/puppies/status", h.APIAdminAction(h.ChangeStatusPuppy))
+							r.Post("/dogs/update", h.APIAdminAction(h.UpdateDog))
+							r.Post("/dogs/add", h.APIAdminAction(h.AddDog))
+							r.Post("/dogs/archived", h.APIAdminAction(h.ChangeArchivedDog))
+							r.Post("/litters/add", h.APIAdminAction(h.AddLitter))
+							r.Post("/litters/update", h.APIAdminAction(h.UpdateLitter))
+							r.Post("/litters/delete", h.APIAdminAction(h.DeleteLitter))
+							r.Post("/matings/add", h.APIAdminAction(h.AddPlannedMating))
+							r.Post("/matings/update", h.APIAdminAction(h.UpdatePlannedMating))
+							r.Post("/matings/delete", h.APIAdminAction(h.DeletePlannedMating))
+							r.Post("/matings/whelp", h.APIAdminAction(h.WhelpPlannedMating))
+							r.Post("/buyers/add", h.APIAdminAction(h.AddBuyer))
+							r.Post("/buyers/update", h.APIAdminAction(h.UpdateBuyer))
+							r.Post("/buyers/delete", h.APIAdminAction(h.DeleteBuyer))
+							r.Post("/waitlist/active", h.APIAdminAction(h.ChangeActiveWaitlistEntry))
+							r.Post("/waitlist/delete", h.APIAdminAction(h.DeleteWaitlistEntry))
+							r.Post("/waitlist/notified", h.APIAdminAction(h.NotifiedWaitlistEntry))
+							r.Post("/health/add", h.APIAdminAction(h.AddHealthEvent))
+							r.Post("/health/update", h.APIAdminAction(h.UpdateHealthEvent))
+							r.Post("/health/delete", h.APIAdminAction(h.DeleteHealthEvent))
+							r.Post("/weights/add", h.APIAdminAction(h.AddWeight))
+							r.Post("/weights/delete", h.APIAdminAction(h.DeleteWeight))
+							r.Post("/shows/add", h.APIAdminAction(h.AddShowResult))
+							r.Post("/shows/update", h.APIAdminAction(h.UpdateShowResult))
+							r.Post("/shows/delete", h.APIAdminAction(h.DeleteShowResult))
+							r.Post("/reviews/update", h.APIAdminAction(h.UpdateFeedback))
+							r.Post("/reviews/delete", h.APIAdminAction(h.DeleteFeedback))
+							r.Post("/reviews/checked", h.APIAdminAction(h.ChangeCheckedFeedback))
+							r.Post("/dictionaries/add", h.APIAdminAction(h.AddDictionaryEntry))
+							r.Post("/dictionaries/update", h.APIAdminAction(h.UpdateDictionaryEntry))
+							r.Post("/dictionaries/delete", h.APIAdminAction(h.DeleteDictionaryEntry))
+							r.Post("/settings/update", h.APIAdminAction(h.UpdateSiteSettings))
+							r.Post("/articles/add", h.APIAdminAction(h.AddArticle))
+							r.Post("/articles/update", h.APIAdminAction(h.UpdateArticle))
+						},
+					)
+
+					// Ошибки API отдаются в JSON, а не страницей сайта
+					r.NotFound(
+						func(w http.ResponseWriter, r *http.Request) {
+							h.APINotFound(w, r)
+						},
+					)
+					r.MethodNotAllowed(
+						func(w http.ResponseWriter, r *http.Request) {
+							h.APIMethodNotAllowed(w, r)
+						},
+					)
+				},
+			)
+
 			// Регистрация обработчиков для различных маршрутов
 			route.Post(
 				"/auth", func(w http.ResponseWriter, r *http.Request) {
@@ -161,13 +498,24 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 			route.Get(
 				"/puppies", func(w http.ResponseWriter, r *http.Request) {
 					var archived = false
-					h.PuppiesView(w, r, archived)
+					h.PuppiesView(w, r, archived, "")
 				},
 			)
 			route.Get(
 				"/archive", func(w http.ResponseWriter, r *http.Request) {
 					var archived = true
-					h.PuppiesView(w, r, archived)
+					h.PuppiesView(w, r, archived, "")
+				},
+			)
+			route.Get(
+				"/search", func(w http.ResponseWriter, r *http.Request) {
+					h.SearchView(w, r)
+				},
+			)
+			route.Get(
+				"/breeds/{slug}/puppies", func(w http.ResponseWriter, r *http.Request) {
+					var archived = false
+					h.PuppiesView(w, r, archived, chi.URLParam(r, "slug"))
 				},
 			)
 			route.Get(
@@ -175,11 +523,41 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 					h.PuppyView(w, r)
 				},
 			)
+			route.Get(
+				"/dogs", func(w http.ResponseWriter, r *http.Request) {
+					h.DogsView(w, r)
+				},
+			)
+			route.Get(
+				"/dogs/{id}", func(w http.ResponseWriter, r *http.Request) {
+					h.DogView(w, r)
+				},
+			)
+			route.Get(
+				"/litters/upcoming", func(w http.ResponseWriter, r *http.Request) {
+					h.UpcomingLittersView(w, r)
+				},
+			)
+			route.Get(
+				"/litters/{id}", func(w http.ResponseWriter, r *http.Request) {
+					h.LitterView(w, r)
+				},
+			)
 			route.Post(
 				"/users/email/add", func(w http.ResponseWriter, r *http.Request) {
 					h.AddEmail(w, r)
 				},
 			)
+			route.Get(
+				"/waitlist", func(w http.ResponseWriter, r *http.Request) {
+					h.WaitlistView(w, r)
+				},
+			)
+			route.Post(
+				"/waitlist/add", func(w http.ResponseWriter, r *http.Request) {
+					h.AddWaitlistEntry(w, r)
+				},
+			)
 			route.Get(
 				"/contacts", func(w http.ResponseWriter, r *http.Request) {
 					h.ContactsView(w, r)
@@ -200,6 +578,11 @@ func SetupRoutes(h *handlers.Handler, logger *zap.Logger) *chi.Mux {
 					h.AddFeedback(w, r)
 				},
 			)
+			route.Get(
+				"/articles", func(w http.ResponseWriter, r *http.Request) {
+					h.ArticlesView(w, r)
+				},
+			)
 			route.Get(
 				"/article/{id}", func(w http.ResponseWriter, r *http.Request) {
 					h.ArticleView(w, r)
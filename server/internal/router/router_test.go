@@ -0,0 +1,90 @@
+package routes_test
+
+import (
+	"encoding/json"
+	"github.com/egosha7/site-go/internal/handlers"
+	routes "github.com/egosha7/site-go/internal/router"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/go-chi/chi"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// apiPrefix — маршруты, которые должны быть описаны в спецификации OpenAPI.
+const apiPrefix = "/api/v1/"
+
+// TestSetupRoutes_OpenAPISpec проверяет, что спецификация OpenAPI описывает ровно те методы API,
+// которые есть в роутере: новый маршрут без описания или описание удалённого маршрута роняют тест.
+func TestSetupRoutes_OpenAPISpec(t *testing.T) {
+	handler := handlers.NewHandler(&service.Service{}, zap.NewNop())
+	router := routes.SetupRoutes(handler, zap.NewNop())
+
+	routed := []string{}
+	err := chi.Walk(
+		router, func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+			if strings.HasPrefix(route, apiPrefix) {
+				routed = append(routed, method+" "+route)
+			}
+			return nil
+		},
+	)
+	assert.NoError(t, err)
+
+	spec, err := handlers.OpenAPISpec()
+	assert.NoError(t, err)
+
+	var document struct {
+		Paths map[string]map[string]json.RawMessage `json:"paths"`
+	}
+	assert.NoError(t, json.Unmarshal(spec, &document))
+
+	described := []string{}
+	for path, operations := range document.Paths {
+		assert.True(t, strings.HasPrefix(path, apiPrefix), "path %s is outside of %s", path, apiPrefix)
+		for method := range operations {
+			described = append(described, strings.ToUpper(method)+" "+path)
+		}
+	}
+
+	sort.Strings(routed)
+	sort.Strings(described)
+	assert.NotEmpty(t, routed)
+	assert.Equal(t, routed, described, "routes in SetupRoutes and openapi.json differ")
+}
+
+// TestSetupRoutes_OpenAPIRefs проверяет, что все ссылки спецификации ведут на существующие компоненты.
+func TestSetupRoutes_OpenAPIRefs(t *testing.T) {
+	spec, err := handlers.OpenAPISpec()
+	assert.NoError(t, err)
+
+	var document map[string]interface{}
+	assert.NoError(t, json.Unmarshal(spec, &document))
+
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			for key, item := range value {
+				if ref, ok := item.(string); ok && key == "$ref" {
+					var target interface{} = document
+					for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+						object, _ := target.(map[string]interface{})
+						target = object[part]
+					}
+					assert.NotNil(t, target, "broken reference %s", ref)
+					continue
+				}
+				walk(item)
+			}
+		case []interface{}:
+			for _, item := range value {
+				walk(item)
+			}
+		}
+	}
+	walk(document)
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"strings"
+)
+
+// apiTokenBytes — длина случайной части токена API в байтах.
+const apiTokenBytes = 32
+
+// apiTokenShownChars — сколько первых символов токена сохраняется, чтобы узнать его в списке.
+const apiTokenShownChars = 12
+
+// APITokenCreate выпускает токен API. Возвращает сам токен: он показывается один раз и больше нигде не хранится.
+func (s *ServiceImpl) APITokenCreate(name string) (string, *domain.APIToken, error) {
+	random := make([]byte, apiTokenBytes)
+	if _, err := rand.Read(random); err != nil {
+		return "", nil, err
+	}
+	secret := domain.APITokenPrefix + base64.RawURLEncoding.EncodeToString(random)
+
+	token := &domain.APIToken{
+		Name:   name,
+		Prefix: secret[:apiTokenShownChars],
+		Hash:   domain.APITokenHash(secret),
+	}
+	if err := s.Repository.APITokenAdd(token); err != nil {
+		return "", nil, err
+	}
+
+	return secret, token, nil
+}
+
+// APITokensGet получает список токенов API.
+func (s *ServiceImpl) APITokensGet() ([]domain.APIToken, error) {
+	return s.Repository.APITokensGet()
+}
+
+// APITokenRevoke отзывает токен API. Отозванный токен перестаёт работать сразу.
+func (s *ServiceImpl) APITokenRevoke(tokenID string) error {
+	return s.Repository.APITokenRevoke(tokenID)
+}
+
+// APITokenCheck проверяет токен API из запроса. Токены не кешируются, чтобы отзыв действовал сразу.
+func (s *ServiceImpl) APITokenCheck(secret string) (*domain.APIToken, error) {
+	if !strings.HasPrefix(secret, domain.APITokenPrefix) {
+		return nil, domain.ErrAPITokenInvalid
+	}
+
+	token, err := s.Repository.APITokenGetByHash(domain.APITokenHash(secret))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.Revoked() {
+		return nil, domain.ErrAPITokenInvalid
+	}
+
+	// Время последнего запроса нужно только для списка в админке, поэтому ошибка лишь логируется
+	go func() {
+		if err := s.Repository.APITokenUsed(token.ID); err != nil {
+			s.Logger.Error("Ошибка сохранения времени использования токена API", zap.Error(err))
+		}
+	}()
+
+	return token, nil
+}
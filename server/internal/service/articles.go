@@ -0,0 +1,147 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/repository"
+	"go.uber.org/zap"
+	"mime/multipart"
+	"strconv"
+)
+
+// ArticlesGet получает страницу списка статей и количество страниц. Черновики выводятся только в админке.
+func (s *ServiceImpl) ArticlesGet(tag string, drafts bool, page domain.Page) ([]domain.Article, int, error) {
+	if page.Size == 0 {
+		page.Size = s.PageSizes.Articles
+	}
+	cacheKey := fmt.Sprintf("articles:%s:%t:%d", tag, drafts, page.Number)
+
+	cachedArticles, err := s.Repository.RedisRepository.GetArticles(cacheKey)
+	if err == nil && cachedArticles != nil {
+		return cachedArticles.Articles, cachedArticles.TotalPages, nil
+	}
+
+	articles, total, err := s.Repository.PostgresRepository.ArticlesGet(tag, drafts, page)
+	if err != nil {
+		return nil, 0, err
+	}
+	totalPages := page.TotalPages(total)
+
+	go func() {
+		err := s.Repository.RedisRepository.SetArticles(
+			cacheKey, &repository.CachedArticles{Articles: articles, TotalPages: totalPages},
+		)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования статей", zap.Error(err))
+		}
+	}()
+
+	return articles, totalPages, nil
+}
+
+// ArticleGet получает статью по ID.
+func (s *ServiceImpl) ArticleGet(idArticle string) (*domain.Article, error) {
+	return s.articleGet(fmt.Sprintf("article:%s", idArticle), func() (*domain.Article, error) {
+		return s.Repository.PostgresRepository.ArticleGet(idArticle)
+	})
+}
+
+// ArticleGetBySlug получает статью по адресу. Если статьи с таким адресом нет, возвращает nil без ошибки.
+func (s *ServiceImpl) ArticleGetBySlug(slug string) (*domain.Article, error) {
+	return s.articleGet(fmt.Sprintf("article:slug:%s", slug), func() (*domain.Article, error) {
+		return s.Repository.PostgresRepository.ArticleGetBySlug(slug)
+	})
+}
+
+// articleGet получает статью из кеша или, если её там нет, из базы данных.
+func (s *ServiceImpl) articleGet(cacheKey string, get func() (*domain.Article, error)) (*domain.Article, error) {
+	cachedArticle, err := s.Repository.RedisRepository.GetArticle(cacheKey)
+	if err == nil && cachedArticle != nil {
+		return cachedArticle, nil
+	}
+
+	article, err := get()
+	if err != nil || article == nil {
+		return article, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetArticle(cacheKey, article)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования статьи", zap.Error(err))
+		}
+	}()
+
+	return article, nil
+}
+
+// ArticleAdd добавляет статью и загружает обложку.
+func (s *ServiceImpl) ArticleAdd(article *domain.Article, fileHeader *multipart.FileHeader) error {
+	if err := s.checkArticleSlug(article); err != nil {
+		return err
+	}
+	if fileHeader != nil {
+		url, err := s.putArticleCover(article, fileHeader)
+		if err != nil {
+			return err
+		}
+		article.CoverURL = url
+	}
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.ArticleAdd(article)
+}
+
+// ArticleUpdate обновляет статью. Новая обложка заменяет прежнюю.
+func (s *ServiceImpl) ArticleUpdate(article *domain.Article, fileHeader *multipart.FileHeader) error {
+	if err := s.checkArticleSlug(article); err != nil {
+		return err
+	}
+	current, err := s.Repository.PostgresRepository.ArticleGet(strconv.Itoa(article.ID))
+	if err != nil {
+		return err
+	}
+	article.CoverURL = current.CoverURL
+
+	if fileHeader != nil {
+		url, err := s.putArticleCover(article, fileHeader)
+		if err != nil {
+			return err
+		}
+		article.CoverURL = url
+	}
+
+	s.Repository.RedisRepository.FlushAll()
+	err = s.Repository.PostgresRepository.ArticleUpdate(article)
+	if err != nil {
+		return err
+	}
+
+	if current.CoverURL != "" && current.CoverURL != article.CoverURL {
+		err = s.Repository.S3Repository.DeleteFromS3(current.CoverURL)
+		if err != nil {
+			return fmt.Errorf("failed to delete article cover from S3: %w", err)
+		}
+	}
+	return nil
+}
+
+// putArticleCover загружает обложку статьи в S3, обрезая её до 16:9.
+func (s *ServiceImpl) putArticleCover(article *domain.Article, fileHeader *multipart.FileHeader) (string, error) {
+	urls, err := s.Repository.PutInS3("article_"+article.Slug, []*multipart.FileHeader{fileHeader}, 16.0/9.0, 1280, 720)
+	if err != nil {
+		return "", err
+	}
+	return urls[0], nil
+}
+
+// checkArticleSlug проверяет, что адрес статьи не занят другой статьёй.
+func (s *ServiceImpl) checkArticleSlug(article *domain.Article) error {
+	owner, err := s.Repository.PostgresRepository.ArticleGetBySlug(article.Slug)
+	if err != nil {
+		return err
+	}
+	if owner != nil && owner.ID != article.ID {
+		return fmt.Errorf("slug %s already belongs to article %d", article.Slug, owner.ID)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+)
+
+// BuyersGet получает список покупателей, подходящих под строку поиска.
+func (s *ServiceImpl) BuyersGet(search string) ([]domain.Buyer, error) {
+	return s.Repository.PostgresRepository.BuyersGet(search)
+}
+
+// BuyerGet получает покупателя вместе со всеми его щенками.
+func (s *ServiceImpl) BuyerGet(idBuyer string) (*domain.Buyer, error) {
+	buyer, err := s.Repository.PostgresRepository.BuyerGet(idBuyer)
+	if err != nil {
+		return nil, err
+	}
+	buyer.Puppies, err = s.Repository.PostgresRepository.BuyerPuppiesGet(idBuyer)
+	if err != nil {
+		return nil, err
+	}
+	return buyer, nil
+}
+
+// BuyerAdd добавляет покупателя.
+func (s *ServiceImpl) BuyerAdd(buyer *domain.Buyer) error {
+	if err := s.checkBuyerPhones(buyer); err != nil {
+		return err
+	}
+	return s.Repository.PostgresRepository.BuyerAdd(buyer)
+}
+
+// BuyerUpdate обновляет покупателя.
+func (s *ServiceImpl) BuyerUpdate(buyer *domain.Buyer) error {
+	if err := s.checkBuyerPhones(buyer); err != nil {
+		return err
+	}
+	return s.Repository.PostgresRepository.BuyerUpdate(buyer)
+}
+
+// BuyerDelete удаляет покупателя, его щенки остаются без покупателя.
+func (s *ServiceImpl) BuyerDelete(buyerID string) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.BuyerDelete(buyerID)
+}
+
+// checkBuyerPhones проверяет, что телефоны покупателя не записаны за другим покупателем:
+// по телефону находится покупатель при продаже щенка и при добавлении отзыва.
+func (s *ServiceImpl) checkBuyerPhones(buyer *domain.Buyer) error {
+	for _, phone := range buyer.Phones {
+		owner, err := s.Repository.PostgresRepository.BuyerGetByPhone(phone)
+		if err != nil {
+			return err
+		}
+		if owner != nil && owner.ID != buyer.ID {
+			return fmt.Errorf("phone %s already belongs to buyer %d", phone, owner.ID)
+		}
+	}
+	return nil
+}
+
+// buyerFindOrAdd находит покупателя по первому телефону или добавляет нового.
+// Найденный покупатель заменяет переданные данные.
+func (s *ServiceImpl) buyerFindOrAdd(buyer *domain.Buyer) error {
+	found, err := s.Repository.PostgresRepository.BuyerGetByPhone(buyer.Phones[0])
+	if err != nil {
+		return err
+	}
+	if found != nil {
+		*buyer = *found
+		return nil
+	}
+	if err := s.BuyerAdd(buyer); err != nil {
+		return fmt.Errorf("unable to add buyer %q: %w", buyer.Phones[0], err)
+	}
+	return nil
+}
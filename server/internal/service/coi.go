@@ -0,0 +1,181 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"math"
+	"sort"
+)
+
+// COIGenerations — сколько поколений предков учитывается при расчёте инбридинга.
+const COIGenerations = 6
+
+// MatingCOIGet рассчитывает коэффициент инбридинга для потомства пары отца и матери.
+// Для пары, в которой отец не кобель или мать не сука, возвращает domain.ErrMatingSexes.
+func (s *ServiceImpl) MatingCOIGet(sireID, damID int) (*domain.COIResult, error) {
+	dogs, err := s.pairPedigreeGet(sireID, damID)
+	if err != nil {
+		return nil, err
+	}
+	if dogs[sireID].Gender != domain.SexMale || dogs[damID].Gender != domain.SexFemale {
+		return nil, domain.ErrMatingSexes
+	}
+
+	result := CalculateCOI(dogs, sireID, damID, COIGenerations)
+	return &result, nil
+}
+
+// PuppyCOIGet рассчитывает коэффициент инбридинга щенка по его отцу и матери.
+func (s *ServiceImpl) PuppyCOIGet(idPuppy string) (*domain.COIResult, error) {
+	puppy, err := s.Repository.PostgresRepository.PuppyGet(idPuppy)
+	if err != nil {
+		return nil, err
+	}
+
+	dogs, err := s.pairPedigreeGet(puppy.FatherID, puppy.MotherID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := CalculateCOI(dogs, puppy.FatherID, puppy.MotherID, COIGenerations)
+	return &result, nil
+}
+
+// pairPedigreeGet получает родословную отца и матери на COIGenerations поколений.
+func (s *ServiceImpl) pairPedigreeGet(sireID, damID int) (map[int]domain.Dog, error) {
+	dogs, err := s.Repository.PostgresRepository.PedigreeGet([]int{sireID, damID}, COIGenerations)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := dogs[sireID]; !ok {
+		return nil, fmt.Errorf("sire %d not found", sireID)
+	}
+	if _, ok := dogs[damID]; !ok {
+		return nil, fmt.Errorf("dam %d not found", damID)
+	}
+	return dogs, nil
+}
+
+// CalculateCOI считает коэффициент инбридинга по Райту для потомка sireID и damID:
+//
+//	F = Σ (1/2)^(n1+n2+1) · (1 + F_A),
+//
+// где сумма берётся по всем общим предкам A и всем парам путей от отца и матери к A,
+// не имеющим других общих собак, кроме A. Учитываются только собаки из dogs
+// и не далее generations поколений от родителей.
+func CalculateCOI(dogs map[int]domain.Dog, sireID, damID, generations int) domain.COIResult {
+	c := &coiCalculator{
+		dogs:        dogs,
+		generations: generations,
+		memo:        make(map[int]float64),
+		visiting:    make(map[int]bool),
+	}
+	coefficient, contributions := c.coi(sireID, damID)
+
+	result := domain.COIResult{
+		SireID:      sireID,
+		DamID:       damID,
+		Coefficient: coefficient,
+	}
+	for id, contribution := range contributions {
+		result.CommonAncestors = append(
+			result.CommonAncestors, domain.CommonAncestor{Dog: dogs[id], Contribution: contribution},
+		)
+	}
+	sort.Slice(
+		result.CommonAncestors, func(i, j int) bool {
+			a, b := result.CommonAncestors[i], result.CommonAncestors[j]
+			if a.Contribution != b.Contribution {
+				return a.Contribution > b.Contribution
+			}
+			return a.Dog.ID < b.Dog.ID
+		},
+	)
+	return result
+}
+
+// coiCalculator хранит промежуточные результаты расчёта инбридинга предков.
+type coiCalculator struct {
+	dogs        map[int]domain.Dog
+	generations int
+	memo        map[int]float64
+	visiting    map[int]bool
+}
+
+// coi возвращает коэффициент инбридинга потомка пары и вклад каждого общего предка.
+func (c *coiCalculator) coi(sireID, damID int) (float64, map[int]float64) {
+	sirePaths := c.paths(sireID, c.generations)
+	damPaths := c.paths(damID, c.generations)
+
+	contributions := make(map[int]float64)
+	for _, sp := range sirePaths {
+		ancestor := sp[len(sp)-1]
+		for _, dp := range damPaths {
+			if dp[len(dp)-1] != ancestor || !disjoint(sp[:len(sp)-1], dp[:len(dp)-1]) {
+				continue
+			}
+			n := len(sp) + len(dp) - 1
+			contributions[ancestor] += math.Pow(0.5, float64(n)) * (1 + c.ancestorCOI(ancestor))
+		}
+	}
+
+	var total float64
+	for _, contribution := range contributions {
+		total += contribution
+	}
+	return total, contributions
+}
+
+// ancestorCOI возвращает собственный коэффициент инбридинга предка.
+func (c *coiCalculator) ancestorCOI(id int) float64 {
+	if f, ok := c.memo[id]; ok {
+		return f
+	}
+	// Защита от ошибочных циклов в родословной.
+	if c.visiting[id] {
+		return 0
+	}
+	c.visiting[id] = true
+	defer delete(c.visiting, id)
+
+	dog := c.dogs[id]
+	var f float64
+	if dog.SireID != 0 && dog.DamID != 0 {
+		f, _ = c.coi(dog.SireID, dog.DamID)
+	}
+	c.memo[id] = f
+	return f
+}
+
+// paths возвращает все пути от собаки id к её предкам (включая её саму),
+// каждый путь начинается с id и заканчивается предком.
+func (c *coiCalculator) paths(id, generations int) [][]int {
+	dog, ok := c.dogs[id]
+	if !ok {
+		return nil
+	}
+	result := [][]int{{id}}
+	if generations == 0 {
+		return result
+	}
+	for _, parentID := range []int{dog.SireID, dog.DamID} {
+		for _, p := range c.paths(parentID, generations-1) {
+			result = append(result, append([]int{id}, p...))
+		}
+	}
+	return result
+}
+
+// disjoint сообщает, что у двух путей нет общих собак.
+func disjoint(a, b []int) bool {
+	seen := make(map[int]bool, len(a))
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if seen[id] {
+			return false
+		}
+	}
+	return true
+}
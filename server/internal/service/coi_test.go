@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/repository"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+)
+
+// pedigree собирает родословную из троек ID собаки, отца и матери. 0 — родитель не известен.
+func pedigree(parents ...[3]int) map[int]domain.Dog {
+	dogs := make(map[int]domain.Dog, len(parents))
+	for _, p := range parents {
+		dogs[p[0]] = domain.Dog{ID: p[0], SireID: p[1], DamID: p[2]}
+	}
+	return dogs
+}
+
+func TestCalculateCOI(t *testing.T) {
+	tests := []struct {
+		name                  string
+		dogs                  map[int]domain.Dog
+		sireID                int
+		damID                 int
+		generations           int
+		expectedCoefficient   float64
+		expectedContributions map[int]float64
+	}{
+		{
+			name:                  "Full siblings",
+			dogs:                  pedigree([3]int{1, 0, 0}, [3]int{2, 0, 0}, [3]int{3, 1, 2}, [3]int{4, 1, 2}),
+			sireID:                3,
+			damID:                 4,
+			generations:           service.COIGenerations,
+			expectedCoefficient:   0.25,
+			expectedContributions: map[int]float64{1: 0.125, 2: 0.125},
+		}, {
+			name: "Half siblings",
+			dogs: pedigree(
+				[3]int{1, 0, 0}, [3]int{2, 0, 0}, [3]int{5, 0, 0}, [3]int{3, 1, 2}, [3]int{4, 1, 5},
+			),
+			sireID:                3,
+			damID:                 4,
+			generations:           service.COIGenerations,
+			expectedCoefficient:   0.125,
+			expectedContributions: map[int]float64{1: 0.125},
+		}, {
+			name: "Father and daughter",
+			dogs: pedigree([3]int{1, 0, 0}, [3]int{5, 0, 0}, [3]int{4, 1, 5}),
+			// Отец сам общий предок: путь от него к себе имеет длину 0
+			sireID:                1,
+			damID:                 4,
+			generations:           service.COIGenerations,
+			expectedCoefficient:   0.25,
+			expectedContributions: map[int]float64{1: 0.25},
+		}, {
+			// Общий предок 1 — от вязки родных брата и сестры, его F_A = 0.25,
+			// поэтому вклад полусибсов 0.125 · (1 + 0.25). Предки 20 и 21 доходят до пары только через 1
+			// и отдельного вклада не дают.
+			name: "Inbred common ancestor",
+			dogs: pedigree(
+				[3]int{20, 0, 0}, [3]int{21, 0, 0}, [3]int{10, 20, 21}, [3]int{11, 20, 21}, [3]int{1, 10, 11},
+				[3]int{2, 0, 0}, [3]int{5, 0, 0}, [3]int{3, 1, 2}, [3]int{4, 1, 5},
+			),
+			sireID:                3,
+			damID:                 4,
+			generations:           service.COIGenerations,
+			expectedCoefficient:   0.15625,
+			expectedContributions: map[int]float64{1: 0.15625},
+		}, {
+			name: "Missing parents",
+			// Родители отца не известны, отец матери 99 не найден в родословной
+			dogs:                  pedigree([3]int{1, 0, 0}, [3]int{2, 0, 0}, [3]int{3, 0, 0}, [3]int{4, 99, 2}),
+			sireID:                3,
+			damID:                 4,
+			generations:           service.COIGenerations,
+			expectedCoefficient:   0,
+			expectedContributions: map[int]float64{},
+		}, {
+			// Двоюродные: общий предок 1 во втором поколении от обоих родителей, (1/2)^5
+			name: "Cousins within depth limit",
+			dogs: pedigree(
+				[3]int{1, 0, 0}, [3]int{6, 1, 0}, [3]int{7, 1, 0}, [3]int{3, 6, 0}, [3]int{4, 7, 0},
+			),
+			sireID:                3,
+			damID:                 4,
+			generations:           2,
+			expectedCoefficient:   0.03125,
+			expectedContributions: map[int]float64{1: 0.03125},
+		}, {
+			name: "Cousins cut by depth limit",
+			dogs: pedigree(
+				[3]int{1, 0, 0}, [3]int{6, 1, 0}, [3]int{7, 1, 0}, [3]int{3, 6, 0}, [3]int{4, 7, 0},
+			),
+			sireID:                3,
+			damID:                 4,
+			generations:           1,
+			expectedCoefficient:   0,
+			expectedContributions: map[int]float64{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				result := service.CalculateCOI(test.dogs, test.sireID, test.damID, test.generations)
+
+				assert.Equal(t, test.sireID, result.SireID)
+				assert.Equal(t, test.damID, result.DamID)
+				assert.InDelta(t, test.expectedCoefficient, result.Coefficient, 1e-9)
+
+				contributions := map[int]float64{}
+				for _, ancestor := range result.CommonAncestors {
+					contributions[ancestor.Dog.ID] = ancestor.Contribution
+				}
+				assert.Len(t, contributions, len(test.expectedContributions))
+				for id, expected := range test.expectedContributions {
+					assert.InDelta(t, expected, contributions[id], 1e-9, "ancestor %d", id)
+				}
+			},
+		)
+	}
+}
+
+// pedigreeRepo — хранилище с родословной в памяти. Остальные методы хранилища тестами не вызываются.
+type pedigreeRepo struct {
+	repository.PostgresRepository
+	dogs map[int]domain.Dog
+}
+
+func (r pedigreeRepo) PedigreeGet(dogIDs []int, generations int) (map[int]domain.Dog, error) {
+	return r.dogs, nil
+}
+
+func TestServiceImpl_MatingCOIGet(t *testing.T) {
+	dogs := map[int]domain.Dog{
+		1: {ID: 1, Gender: domain.SexMale},
+		2: {ID: 2, Gender: domain.SexFemale},
+		3: {ID: 3, Gender: domain.SexFemale},
+	}
+	s := &service.ServiceImpl{
+		Repository: &repository.Repository{PostgresRepository: pedigreeRepo{dogs: dogs}},
+		Logger:     zap.NewNop(),
+	}
+
+	tests := []struct {
+		name          string
+		sireID        int
+		damID         int
+		expectedError error
+	}{
+		{name: "Male sire and female dam", sireID: 1, damID: 2},
+		{name: "Sexes swapped", sireID: 2, damID: 1, expectedError: domain.ErrMatingSexes},
+		{name: "Two females", sireID: 3, damID: 2, expectedError: domain.ErrMatingSexes},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				result, err := s.MatingCOIGet(test.sireID, test.damID)
+				if test.expectedError != nil {
+					assert.ErrorIs(t, err, test.expectedError)
+					assert.Nil(t, result)
+					return
+				}
+				assert.NoError(t, err)
+				assert.Equal(t, test.sireID, result.SireID)
+				assert.Equal(t, test.damID, result.DamID)
+			},
+		)
+	}
+
+	_, err := s.MatingCOIGet(1, 42)
+	assert.EqualError(t, err, "dam 42 not found")
+}
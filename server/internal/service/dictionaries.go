@@ -0,0 +1,74 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+)
+
+// DictionariesGet получает справочники пород, окрасов и пола.
+func (s *ServiceImpl) DictionariesGet() (*domain.Dictionaries, error) {
+	cacheKey := "dictionaries"
+
+	cachedDictionaries, err := s.Repository.RedisRepository.GetDictionaries(cacheKey)
+	if err == nil && cachedDictionaries != nil {
+		return cachedDictionaries, nil
+	}
+
+	breeds, err := s.Repository.PostgresRepository.DictionaryGet(domain.DictionaryBreeds)
+	if err != nil {
+		return nil, err
+	}
+	colors, err := s.Repository.PostgresRepository.DictionaryGet(domain.DictionaryColors)
+	if err != nil {
+		return nil, err
+	}
+	sexes, err := s.Repository.PostgresRepository.DictionaryGet(domain.DictionarySexes)
+	if err != nil {
+		return nil, err
+	}
+	dictionaries := &domain.Dictionaries{Breeds: breeds, Colors: colors, Sexes: sexes}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetDictionaries(cacheKey, dictionaries)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования справочников", zap.Error(err))
+		}
+	}()
+
+	return dictionaries, nil
+}
+
+// DictionaryEntryAdd добавляет запись в справочник. В закрытый справочник пола записи не добавляются:
+// сайт знает только кобелей и сук, поэтому новое значение пола нигде бы не учитывалось.
+func (s *ServiceImpl) DictionaryEntryAdd(entry *domain.DictionaryEntry) error {
+	if domain.DictionaryFixed[entry.Kind] {
+		return fmt.Errorf("%s: %w", entry.Kind, domain.ErrDictionaryFixed)
+	}
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.DictionaryEntryAdd(entry)
+}
+
+// DictionaryEntryUpdate обновляет подписи и порядок записи справочника.
+func (s *ServiceImpl) DictionaryEntryUpdate(entry *domain.DictionaryEntry) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.DictionaryEntryUpdate(entry)
+}
+
+// DictionaryEntryDelete удаляет запись справочника. Значение, которое уже указано у щенка
+// или собаки, удалить нельзя: иначе их карточки перестанут проходить валидацию.
+// Записи закрытого справочника пола не удаляются.
+func (s *ServiceImpl) DictionaryEntryDelete(kind string, entryID string) error {
+	if domain.DictionaryFixed[kind] {
+		return fmt.Errorf("%s: %w", kind, domain.ErrDictionaryFixed)
+	}
+	s.Repository.RedisRepository.FlushAll()
+	deleted, err := s.Repository.PostgresRepository.DictionaryEntryDelete(kind, entryID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return fmt.Errorf("dictionary entry %s in %s is in use or does not exist", entryID, kind)
+	}
+	return nil
+}
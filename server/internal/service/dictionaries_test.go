@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/repository"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+)
+
+// dictionaryRepo — хранилище справочников, которое запоминает добавленные и удалённые записи.
+type dictionaryRepo struct {
+	repository.PostgresRepository
+	added   []domain.DictionaryEntry
+	deleted []string
+}
+
+func (r *dictionaryRepo) DictionaryEntryAdd(entry *domain.DictionaryEntry) error {
+	r.added = append(r.added, *entry)
+	return nil
+}
+
+func (r *dictionaryRepo) DictionaryEntryDelete(kind string, entryID string) (bool, error) {
+	r.deleted = append(r.deleted, kind+"/"+entryID)
+	return true, nil
+}
+
+func TestServiceImpl_DictionaryFixed(t *testing.T) {
+	tests := []struct {
+		name  string
+		kind  string
+		fixed bool
+	}{
+		{name: "Breeds", kind: domain.DictionaryBreeds},
+		{name: "Colors", kind: domain.DictionaryColors},
+		{name: "Sexes", kind: domain.DictionarySexes, fixed: true},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				repo := &dictionaryRepo{}
+				s := &service.ServiceImpl{
+					Repository: &repository.Repository{PostgresRepository: repo, RedisRepository: noCache{}},
+					Logger:     zap.NewNop(),
+				}
+
+				addErr := s.DictionaryEntryAdd(&domain.DictionaryEntry{Kind: test.kind, Value: "value", LabelRu: "Подпись"})
+				deleteErr := s.DictionaryEntryDelete(test.kind, "1")
+				if test.fixed {
+					assert.ErrorIs(t, addErr, domain.ErrDictionaryFixed)
+					assert.ErrorIs(t, deleteErr, domain.ErrDictionaryFixed)
+					assert.Empty(t, repo.added)
+					assert.Empty(t, repo.deleted)
+					return
+				}
+				assert.NoError(t, addErr)
+				assert.NoError(t, deleteErr)
+				assert.Len(t, repo.added, 1)
+				assert.Equal(t, []string{test.kind + "/1"}, repo.deleted)
+			},
+		)
+	}
+}
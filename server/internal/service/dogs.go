@@ -3,6 +3,7 @@ package service
 import (
 	"fmt"
 	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
 	"mime/multipart"
 )
 
@@ -12,6 +13,52 @@ func (s *ServiceImpl) DogsGet(chocolates, genders []string, id string, archived
 	return s.Repository.DogsGet(chocolates, genders, id, archived)
 }
 
+// DogsPageGet получает страницу списка собак и количество страниц.
+func (s *ServiceImpl) DogsPageGet(chocolates, genders []string, archived bool, page domain.Page) ([]domain.Dog, int, error) {
+	if page.Size == 0 {
+		page.Size = s.PageSizes.Dogs
+	}
+	dogs, total, err := s.Repository.PostgresRepository.DogsPageGet(chocolates, genders, archived, page)
+	if err != nil {
+		return nil, 0, err
+	}
+	return dogs, page.TotalPages(total), nil
+}
+
+// DogsAllGet получает всех собак для выбора отца и матери в родословной.
+func (s *ServiceImpl) DogsAllGet() ([]domain.Dog, error) {
+	return s.Repository.PostgresRepository.DogsAllGet()
+}
+
+// DogGet получает информацию о собаке.
+func (s *ServiceImpl) DogGet(idDog string) (*domain.Dog, error) {
+	cacheKeyDog := fmt.Sprintf("dog:%s", idDog)
+
+	cachedDog, err := s.Repository.RedisRepository.GetDog(cacheKeyDog)
+	if err == nil && cachedDog != nil {
+		return cachedDog, nil
+	}
+
+	dog, err := s.Repository.PostgresRepository.DogGet(idDog)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetDog(cacheKeyDog, dog)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования собаки", zap.Error(err))
+		}
+	}()
+
+	return dog, nil
+}
+
+// DogOffspringGet получает щенков, у которых собака указана матерью или отцом.
+func (s *ServiceImpl) DogOffspringGet(dogID int) ([]domain.Puppy, error) {
+	return s.Repository.PostgresRepository.DogOffspringGet(dogID)
+}
+
 // DogChangeArchived меняет состояние архива собаки.
 func (s *ServiceImpl) DogChangeArchived(dogID string, archived string) error {
 	s.Repository.RedisRepository.FlushAll()
@@ -32,6 +79,9 @@ func (s *ServiceImpl) DogAdd(dog *domain.Dog, fileHeaders []*multipart.FileHeade
 
 // DogUpdate обновляет информацию о собаке.
 func (s *ServiceImpl) DogUpdate(dog *domain.Dog, fileHeaders []*multipart.FileHeader) error {
+	if dog.SireID == dog.ID || dog.DamID == dog.ID {
+		return fmt.Errorf("dog %d cannot be its own parent", dog.ID)
+	}
 	s.Repository.RedisRepository.FlushAll()
 	newUrls, err := s.Repository.PutInS3(dog.Name, fileHeaders, 3.0/2.0, 1200, 800)
 	if err != nil {
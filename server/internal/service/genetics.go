@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// colorLocus описывает локус окраса и генотип, который принимается, если он не указан.
+type colorLocus struct {
+	name     string
+	fallback string
+	get      func(g domain.Genotype) string
+}
+
+// colorLoci — учитываемые локусы окраса в порядке вывода.
+var colorLoci = []colorLocus{
+	{name: "B", fallback: "BB", get: func(g domain.Genotype) string { return g.B }},
+	{name: "D", fallback: "DD", get: func(g domain.Genotype) string { return g.D }},
+	{name: "M", fallback: "mm", get: func(g domain.Genotype) string { return g.M }},
+}
+
+// ColorPredictionGet рассчитывает ожидаемое распределение окрасов щенков для пары.
+func (s *ServiceImpl) ColorPredictionGet(sireID, damID int) (*domain.ColorPrediction, error) {
+	sire, err := s.Repository.PostgresRepository.DogGet(strconv.Itoa(sireID))
+	if err != nil {
+		return nil, err
+	}
+	dam, err := s.Repository.PostgresRepository.DogGet(strconv.Itoa(damID))
+	if err != nil {
+		return nil, err
+	}
+
+	prediction := PredictColors(sire.Genotype, dam.Genotype)
+	return &prediction, nil
+}
+
+// PredictColors рассчитывает распределение окрасов потомства по локусам B, D и M.
+// Неуказанный генотип по локусу принимается доминантной гомозиготой (BB, DD)
+// или отсутствием мерле (mm), о чём добавляется предупреждение. Если генотип не распознан,
+// окрасы не рассчитываются: в прогнозе остаются только предупреждения.
+func PredictColors(sire, dam domain.Genotype) domain.ColorPrediction {
+	var prediction domain.ColorPrediction
+
+	// Распределение генотипов потомства по каждому локусу
+	perLocus := make([]map[string]float64, len(colorLoci))
+	recognized := true
+	for i, locus := range colorLoci {
+		sireAlleles, ok := locus.alleles(locus.get(sire), "отца", &prediction.Warnings)
+		recognized = recognized && ok
+		damAlleles, ok := locus.alleles(locus.get(dam), "матери", &prediction.Warnings)
+		recognized = recognized && ok
+		perLocus[i] = crossLocus(sireAlleles, damAlleles)
+	}
+	if !recognized {
+		return prediction
+	}
+
+	// Объединение локусов и группировка по окрасу
+	colors := make(map[string]float64)
+	for b, pb := range perLocus[0] {
+		for d, pd := range perLocus[1] {
+			for m, pm := range perLocus[2] {
+				colors[colorName(b, d, m)] += pb * pd * pm
+			}
+		}
+	}
+	for color, probability := range colors {
+		prediction.Outcomes = append(prediction.Outcomes, domain.ColorOutcome{Color: color, Probability: probability})
+	}
+	sort.Slice(
+		prediction.Outcomes, func(i, j int) bool {
+			a, b := prediction.Outcomes[i], prediction.Outcomes[j]
+			if a.Probability != b.Probability {
+				return a.Probability > b.Probability
+			}
+			return a.Color < b.Color
+		},
+	)
+
+	if doubleMerle := perLocus[2]["MM"]; doubleMerle > 0 {
+		prediction.DoubleMerle = true
+		prediction.Warnings = append(
+			[]string{fmt.Sprintf(
+				"Вязка мерле × мерле: %.0f%% щенков будут двойными мерле с высоким риском глухоты и слепоты",
+				doubleMerle*100,
+			)},
+			prediction.Warnings...,
+		)
+	}
+
+	return prediction
+}
+
+// alleles возвращает пару аллелей родителя parent по локусу. Вместо неуказанного генотипа берётся
+// fallback. Для неуказанного и нераспознанного генотипа в warnings добавляется предупреждение,
+// для нераспознанного второе значение — false.
+func (l colorLocus) alleles(genotype, parent string, warnings *[]string) (string, bool) {
+	if genotype == "" {
+		*warnings = append(
+			*warnings, fmt.Sprintf("Генотип %s по локусу %s не указан, принят %s", parent, l.name, l.fallback),
+		)
+		return l.fallback, true
+	}
+
+	alleles := []rune(genotype)
+	upper, lower := []rune(strings.ToUpper(l.name))[0], []rune(strings.ToLower(l.name))[0]
+	valid := len(alleles) == 2
+	for _, allele := range alleles {
+		valid = valid && (allele == upper || allele == lower)
+	}
+	if !valid {
+		*warnings = append(
+			*warnings,
+			fmt.Sprintf("Генотип %s по локусу %s не распознан: %s, окрасы не рассчитаны", parent, l.name, genotype),
+		)
+		return "", false
+	}
+	return genotype, true
+}
+
+// crossLocus возвращает распределение генотипов потомства по одному локусу.
+func crossLocus(sire, dam string) map[string]float64 {
+	result := make(map[string]float64)
+	for _, a := range sire {
+		for _, b := range dam {
+			result[normalizeAlleles(a, b)] += 0.25
+		}
+	}
+	return result
+}
+
+// normalizeAlleles записывает пару аллелей доминантной первой: "bB" -> "Bb".
+func normalizeAlleles(a, b rune) string {
+	if a > b {
+		a, b = b, a
+	}
+	return string(a) + string(b)
+}
+
+// colorName возвращает название окраса по генотипу локусов B, D и M.
+func colorName(b, d, m string) string {
+	color := "Черный"
+	if b == strings.ToLower(b) {
+		color = "Шоколадный"
+	}
+	if d == strings.ToLower(d) {
+		if color == "Шоколадный" {
+			color = "Лиловый"
+		} else {
+			color = "Голубой"
+		}
+	}
+	switch m {
+	case "Mm":
+		color += " мерле"
+	case "MM":
+		color += " (двойной мерле)"
+	}
+	return color
+}
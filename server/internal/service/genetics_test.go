@@ -0,0 +1,92 @@
+package service_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPredictColors(t *testing.T) {
+	tests := []struct {
+		name                string
+		sire                domain.Genotype
+		dam                 domain.Genotype
+		expectedOutcomes    map[string]float64
+		expectedDoubleMerle bool
+		expectedWarnings    []string
+	}{
+		{
+			name:             "Bb × Bb",
+			sire:             domain.Genotype{B: "Bb", D: "DD", M: "mm"},
+			dam:              domain.Genotype{B: "Bb", D: "DD", M: "mm"},
+			expectedOutcomes: map[string]float64{"Черный": 0.75, "Шоколадный": 0.25},
+		}, {
+			name:                "Mm × Mm",
+			sire:                domain.Genotype{B: "BB", D: "DD", M: "Mm"},
+			dam:                 domain.Genotype{B: "BB", D: "DD", M: "Mm"},
+			expectedOutcomes:    map[string]float64{"Черный мерле": 0.5, "Черный": 0.25, "Черный (двойной мерле)": 0.25},
+			expectedDoubleMerle: true,
+			expectedWarnings: []string{
+				"Вязка мерле × мерле: 25% щенков будут двойными мерле с высоким риском глухоты и слепоты",
+			},
+		}, {
+			name:             "Mm × mm",
+			sire:             domain.Genotype{B: "BB", D: "DD", M: "Mm"},
+			dam:              domain.Genotype{B: "BB", D: "DD", M: "mm"},
+			expectedOutcomes: map[string]float64{"Черный мерле": 0.5, "Черный": 0.5},
+		}, {
+			name: "Dilute carriers",
+			sire: domain.Genotype{B: "Bb", D: "Dd", M: "mm"},
+			dam:  domain.Genotype{B: "bb", D: "dd", M: "mm"},
+			expectedOutcomes: map[string]float64{
+				"Черный": 0.25, "Голубой": 0.25, "Шоколадный": 0.25, "Лиловый": 0.25,
+			},
+		}, {
+			name:             "Missing genotype",
+			sire:             domain.Genotype{B: "bb"},
+			dam:              domain.Genotype{B: "bb", D: "DD", M: "mm"},
+			expectedOutcomes: map[string]float64{"Шоколадный": 1},
+			expectedWarnings: []string{
+				"Генотип отца по локусу D не указан, принят DD",
+				"Генотип отца по локусу M не указан, принят mm",
+			},
+		}, {
+			name:             "Unknown genotype",
+			sire:             domain.Genotype{B: "Bx", D: "DD", M: "mm"},
+			dam:              domain.Genotype{B: "Bb", D: "Mm", M: "mm"},
+			expectedOutcomes: map[string]float64{},
+			expectedWarnings: []string{
+				"Генотип отца по локусу B не распознан: Bx, окрасы не рассчитаны",
+				"Генотип матери по локусу D не распознан: Mm, окрасы не рассчитаны",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				prediction := service.PredictColors(test.sire, test.dam)
+
+				outcomes := map[string]float64{}
+				var total float64
+				for i, outcome := range prediction.Outcomes {
+					outcomes[outcome.Color] = outcome.Probability
+					total += outcome.Probability
+					if i > 0 {
+						assert.GreaterOrEqual(t, prediction.Outcomes[i-1].Probability, outcome.Probability)
+					}
+				}
+				assert.Len(t, outcomes, len(test.expectedOutcomes))
+				for color, expected := range test.expectedOutcomes {
+					assert.InDelta(t, expected, outcomes[color], 1e-9, color)
+				}
+				if len(prediction.Outcomes) > 0 {
+					assert.InDelta(t, 1, total, 1e-9)
+				}
+
+				assert.Equal(t, test.expectedDoubleMerle, prediction.DoubleMerle)
+				assert.Equal(t, test.expectedWarnings, prediction.Warnings)
+			},
+		)
+	}
+}
@@ -0,0 +1,53 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"strconv"
+)
+
+// PuppyWeightsGet получает взвешивания щенка.
+func (s *ServiceImpl) PuppyWeightsGet(puppyID int) ([]domain.WeightMeasurement, error) {
+	return s.Repository.PostgresRepository.PuppyWeightsGet(puppyID)
+}
+
+// PuppyGrowthGet получает кривые роста щенка и его однопомётников для графика.
+func (s *ServiceImpl) PuppyGrowthGet(idPuppy string) ([]domain.GrowthSeries, error) {
+	cacheKey := fmt.Sprintf("growth:puppy:%s", idPuppy)
+
+	cachedGrowth, err := s.Repository.RedisRepository.GetGrowth(cacheKey)
+	if err == nil && cachedGrowth != nil {
+		return cachedGrowth, nil
+	}
+
+	puppyID, err := strconv.Atoi(idPuppy)
+	if err != nil {
+		return nil, err
+	}
+	series, err := s.Repository.PostgresRepository.LitterGrowthGet(puppyID)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetGrowth(cacheKey, series)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования графика роста", zap.Error(err))
+		}
+	}()
+
+	return series, nil
+}
+
+// WeightAdd добавляет взвешивание щенка.
+func (s *ServiceImpl) WeightAdd(measurement *domain.WeightMeasurement) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.WeightAdd(measurement)
+}
+
+// WeightDelete удаляет взвешивание щенка.
+func (s *ServiceImpl) WeightDelete(measurementID string) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.WeightDelete(measurementID)
+}
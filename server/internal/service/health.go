@@ -0,0 +1,151 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"mime/multipart"
+	"strconv"
+)
+
+// HealthEventsGet получает ветеринарную историю щенка или собаки.
+func (s *ServiceImpl) HealthEventsGet(puppyID, dogID int) ([]domain.HealthEvent, error) {
+	return s.Repository.PostgresRepository.HealthEventsGet(puppyID, dogID)
+}
+
+// PuppyHealthGet получает сводку о здоровье щенка для публичной страницы.
+func (s *ServiceImpl) PuppyHealthGet(idPuppy string) (*domain.HealthSummary, error) {
+	puppyID, err := strconv.Atoi(idPuppy)
+	if err != nil {
+		return nil, err
+	}
+	return s.healthSummaryGet(fmt.Sprintf("health:puppy:%s", idPuppy), puppyID, 0)
+}
+
+// DogHealthGet получает сводку о здоровье взрослой собаки для публичной страницы.
+func (s *ServiceImpl) DogHealthGet(dogID int) (*domain.HealthSummary, error) {
+	return s.healthSummaryGet(fmt.Sprintf("health:dog:%d", dogID), 0, dogID)
+}
+
+// healthSummaryGet получает сводку о здоровье щенка или собаки из кеша или базы данных.
+func (s *ServiceImpl) healthSummaryGet(cacheKey string, puppyID, dogID int) (*domain.HealthSummary, error) {
+	cachedSummary, err := s.Repository.RedisRepository.GetHealthSummary(cacheKey)
+	if err == nil && cachedSummary != nil {
+		return cachedSummary, nil
+	}
+
+	events, err := s.Repository.PostgresRepository.HealthEventsGet(puppyID, dogID)
+	if err != nil {
+		return nil, err
+	}
+	summary := summarizeHealth(events)
+
+	go func() {
+		err := s.Repository.RedisRepository.SetHealthSummary(cacheKey, summary)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования сводки о здоровье", zap.Error(err))
+		}
+	}()
+
+	return summary, nil
+}
+
+// HealthEventAdd добавляет событие здоровья и загружает приложенный документ.
+func (s *ServiceImpl) HealthEventAdd(event *domain.HealthEvent, fileHeader *multipart.FileHeader) error {
+	if (event.PuppyID == 0) == (event.DogID == 0) {
+		return fmt.Errorf("health event must belong to either a puppy or a dog")
+	}
+	if fileHeader != nil {
+		url, err := s.Repository.PutDocumentInS3(healthDocumentPrefix(event), fileHeader)
+		if err != nil {
+			return err
+		}
+		event.DocumentURL = url
+	}
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.HealthEventAdd(event)
+}
+
+// HealthEventUpdate обновляет событие здоровья. Новый документ заменяет прежний.
+func (s *ServiceImpl) HealthEventUpdate(event *domain.HealthEvent, fileHeader *multipart.FileHeader) error {
+	current, err := s.Repository.PostgresRepository.HealthEventGet(strconv.Itoa(event.ID))
+	if err != nil {
+		return err
+	}
+	event.PuppyID, event.DogID = current.PuppyID, current.DogID
+	event.DocumentURL = current.DocumentURL
+
+	if fileHeader != nil {
+		url, err := s.Repository.PutDocumentInS3(healthDocumentPrefix(event), fileHeader)
+		if err != nil {
+			return err
+		}
+		event.DocumentURL = url
+	}
+
+	s.Repository.RedisRepository.FlushAll()
+	err = s.Repository.PostgresRepository.HealthEventUpdate(event)
+	if err != nil {
+		return err
+	}
+
+	if current.DocumentURL != "" && current.DocumentURL != event.DocumentURL {
+		err = s.Repository.S3Repository.DeleteFromS3(current.DocumentURL)
+		if err != nil {
+			return fmt.Errorf("failed to delete document from S3: %w", err)
+		}
+	}
+	return nil
+}
+
+// HealthEventDelete удаляет событие здоровья вместе с документом.
+func (s *ServiceImpl) HealthEventDelete(eventID string) (*domain.HealthEvent, error) {
+	event, err := s.Repository.PostgresRepository.HealthEventGet(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Repository.RedisRepository.FlushAll()
+	err = s.Repository.PostgresRepository.HealthEventDelete(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.DocumentURL != "" {
+		err = s.Repository.S3Repository.DeleteFromS3(event.DocumentURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete document from S3: %w", err)
+		}
+	}
+	return event, nil
+}
+
+// healthDocumentPrefix возвращает префикс имени файла документа в S3.
+func healthDocumentPrefix(event *domain.HealthEvent) string {
+	if event.PuppyID != 0 {
+		return fmt.Sprintf("health_puppy_%d", event.PuppyID)
+	}
+	return fmt.Sprintf("health_dog_%d", event.DogID)
+}
+
+// summarizeHealth собирает сводку по событиям, отсортированным по дате:
+// для чипа, обработки и осмотра берётся последнее событие.
+func summarizeHealth(events []domain.HealthEvent) *domain.HealthSummary {
+	summary := &domain.HealthSummary{}
+	for i := range events {
+		event := events[i]
+		switch event.Type {
+		case domain.HealthEventChip:
+			summary.Chip = &event
+		case domain.HealthEventVaccination:
+			summary.Vaccinations = append(summary.Vaccinations, event)
+		case domain.HealthEventDeworming:
+			summary.LastDeworming = &event
+		case domain.HealthEventVetCheck:
+			summary.LastVetCheck = &event
+		case domain.HealthEventGeneticTest:
+			summary.GeneticTests = append(summary.GeneticTests, event)
+		}
+	}
+	return summary
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"strconv"
+)
+
+// LittersGet получает список помётов.
+func (s *ServiceImpl) LittersGet() ([]domain.Litter, error) {
+	return s.Repository.PostgresRepository.LittersGet()
+}
+
+// LitterGet получает информацию о помёте, его щенках и родителях.
+func (s *ServiceImpl) LitterGet(idLitter string) (*domain.Litter, *domain.Dog, *domain.Dog, error) {
+	cacheKeyLitter := fmt.Sprintf("litter:%s", idLitter)
+	cacheKeyMother := fmt.Sprintf("dog:litter:mother:%s", idLitter)
+	cacheKeyFather := fmt.Sprintf("dog:litter:father:%s", idLitter)
+
+	cachedLitter, err := s.Repository.RedisRepository.GetLitter(cacheKeyLitter)
+	if err == nil && cachedLitter != nil {
+		cachedMother, err := s.Repository.RedisRepository.GetDog(cacheKeyMother)
+		if err == nil && cachedMother != nil {
+			cachedFather, err := s.Repository.RedisRepository.GetDog(cacheKeyFather)
+			if err == nil && cachedFather != nil {
+				return cachedLitter, cachedMother, cachedFather, nil
+			}
+		}
+	}
+
+	litter, err := s.Repository.PostgresRepository.LitterGet(idLitter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	litter.Puppies, err = s.Repository.PostgresRepository.LitterPuppiesGet(idLitter)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	mother, err := s.Repository.PostgresRepository.DogGet(strconv.Itoa(litter.MotherID))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	father, err := s.Repository.PostgresRepository.DogGet(strconv.Itoa(litter.FatherID))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetLitter(cacheKeyLitter, litter)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования помёта", zap.Error(err))
+		}
+
+		err = s.Repository.RedisRepository.SetDog(cacheKeyMother, mother)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования матери помёта", zap.Error(err))
+		}
+
+		err = s.Repository.RedisRepository.SetDog(cacheKeyFather, father)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования отца помёта", zap.Error(err))
+		}
+	}()
+
+	return litter, mother, father, nil
+}
+
+// LitterAdd добавляет помёт.
+func (s *ServiceImpl) LitterAdd(litter *domain.Litter) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.LitterAdd(litter)
+}
+
+// LitterUpdate обновляет помёт.
+func (s *ServiceImpl) LitterUpdate(litter *domain.Litter) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.LitterUpdate(litter)
+}
+
+// LitterDelete удаляет помёт.
+func (s *ServiceImpl) LitterDelete(litterID string) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.LitterDelete(litterID)
+}
+
+// applyLitter переносит родителей и дату рождения помёта на щенка.
+func (s *ServiceImpl) applyLitter(puppy *domain.Puppy) error {
+	if puppy.LitterID == 0 {
+		return nil
+	}
+	litter, err := s.Repository.PostgresRepository.LitterGet(strconv.Itoa(puppy.LitterID))
+	if err != nil {
+		return fmt.Errorf("unable to get litter %d: %w", puppy.LitterID, err)
+	}
+	puppy.MotherID = litter.MotherID
+	puppy.FatherID = litter.FatherID
+	puppy.DateBirth = litter.DateBirth
+	return nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+// upcomingMatingStatuses — статусы вязок, которые анонсируются покупателям как будущие помёты.
+var upcomingMatingStatuses = []string{
+	domain.MatingStatusPlanned,
+	domain.MatingStatusConfirmed,
+	domain.MatingStatusPregnant,
+}
+
+// PlannedMatingsGet получает список всех запланированных вязок.
+func (s *ServiceImpl) PlannedMatingsGet() ([]domain.PlannedMating, error) {
+	return s.Repository.PostgresRepository.PlannedMatingsGet([]string{})
+}
+
+// UpcomingLittersGet получает анонсы будущих помётов вместе с родителями.
+func (s *ServiceImpl) UpcomingLittersGet() ([]domain.UpcomingLitter, error) {
+	cacheKey := "matings:upcoming"
+
+	cachedLitters, err := s.Repository.RedisRepository.GetUpcomingLitters(cacheKey)
+	if err == nil && cachedLitters != nil {
+		return cachedLitters, nil
+	}
+
+	matings, err := s.Repository.PostgresRepository.PlannedMatingsGet(upcomingMatingStatuses)
+	if err != nil {
+		return nil, err
+	}
+
+	litters := make([]domain.UpcomingLitter, 0, len(matings))
+	for _, mating := range matings {
+		mother, err := s.Repository.PostgresRepository.DogGet(strconv.Itoa(mating.MotherID))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get mother %d: %w", mating.MotherID, err)
+		}
+		father, err := s.Repository.PostgresRepository.DogGet(strconv.Itoa(mating.FatherID))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get father %d: %w", mating.FatherID, err)
+		}
+		litters = append(litters, domain.UpcomingLitter{Mating: mating, Mother: *mother, Father: *father})
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetUpcomingLitters(cacheKey, litters)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования будущих помётов", zap.Error(err))
+		}
+	}()
+
+	return litters, nil
+}
+
+// PlannedMatingAdd добавляет запланированную вязку.
+func (s *ServiceImpl) PlannedMatingAdd(mating *domain.PlannedMating) error {
+	if mating.Status == domain.MatingStatusWhelped {
+		return fmt.Errorf("planned mating can become whelped only through a litter")
+	}
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.PlannedMatingAdd(mating)
+}
+
+// PlannedMatingUpdate обновляет запланированную вязку.
+// Статус "щенки родились" выставляется только при создании помёта и после этого не меняется.
+func (s *ServiceImpl) PlannedMatingUpdate(mating *domain.PlannedMating) error {
+	current, err := s.Repository.PostgresRepository.PlannedMatingGet(strconv.Itoa(mating.ID))
+	if err != nil {
+		return err
+	}
+	if (current.Status == domain.MatingStatusWhelped) != (mating.Status == domain.MatingStatusWhelped) {
+		return fmt.Errorf("planned mating %d can become whelped only through a litter", mating.ID)
+	}
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.PlannedMatingUpdate(mating)
+}
+
+// PlannedMatingDelete удаляет запланированную вязку.
+func (s *ServiceImpl) PlannedMatingDelete(matingID string) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.PlannedMatingDelete(matingID)
+}
+
+// PlannedMatingWhelp создаёт помёт по запланированной вязке. Родители и описание вязки
+// переходят в помёт, а через него — на щенков, добавленных в этот помёт.
+func (s *ServiceImpl) PlannedMatingWhelp(matingID string, dateBirth, readyOutDate time.Time) (*domain.Litter, error) {
+	mating, err := s.Repository.PostgresRepository.PlannedMatingGet(matingID)
+	if err != nil {
+		return nil, err
+	}
+	if mating.Status == domain.MatingStatusWhelped || mating.Status == domain.MatingStatusCancelled {
+		return nil, fmt.Errorf("planned mating %d is %s", mating.ID, mating.Status)
+	}
+
+	litter := &domain.Litter{
+		MotherID:     mating.MotherID,
+		FatherID:     mating.FatherID,
+		DateBirth:    dateBirth,
+		ReadyOutDate: readyOutDate,
+		Description:  mating.Description,
+	}
+	s.Repository.RedisRepository.FlushAll()
+	err = s.Repository.PostgresRepository.PlannedMatingWhelp(mating.ID, litter)
+	if err != nil {
+		return nil, err
+	}
+	return litter, nil
+}
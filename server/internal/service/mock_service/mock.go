@@ -7,6 +7,7 @@ package mock_service
 import (
 	multipart "mime/multipart"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/egosha7/site-go/internal/domain"
 	gomock "github.com/golang/mock/gomock"
@@ -49,6 +50,224 @@ func (mr *MockServicesMockRecorder) AddEmail(email interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddEmail", reflect.TypeOf((*MockServices)(nil).AddEmail), email)
 }
 
+// ArticleAdd mocks base method.
+func (m *MockServices) ArticleAdd(article *domain.Article, fileHeader *multipart.FileHeader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticleAdd", article, fileHeader)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArticleAdd indicates an expected call of ArticleAdd.
+func (mr *MockServicesMockRecorder) ArticleAdd(article, fileHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleAdd", reflect.TypeOf((*MockServices)(nil).ArticleAdd), article, fileHeader)
+}
+
+// ArticleGet mocks base method.
+func (m *MockServices) ArticleGet(idArticle string) (*domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticleGet", idArticle)
+	ret0, _ := ret[0].(*domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArticleGet indicates an expected call of ArticleGet.
+func (mr *MockServicesMockRecorder) ArticleGet(idArticle interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleGet", reflect.TypeOf((*MockServices)(nil).ArticleGet), idArticle)
+}
+
+// ArticleGetBySlug mocks base method.
+func (m *MockServices) ArticleGetBySlug(slug string) (*domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticleGetBySlug", slug)
+	ret0, _ := ret[0].(*domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArticleGetBySlug indicates an expected call of ArticleGetBySlug.
+func (mr *MockServicesMockRecorder) ArticleGetBySlug(slug interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleGetBySlug", reflect.TypeOf((*MockServices)(nil).ArticleGetBySlug), slug)
+}
+
+// ArticleUpdate mocks base method.
+func (m *MockServices) ArticleUpdate(article *domain.Article, fileHeader *multipart.FileHeader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticleUpdate", article, fileHeader)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ArticleUpdate indicates an expected call of ArticleUpdate.
+func (mr *MockServicesMockRecorder) ArticleUpdate(article, fileHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticleUpdate", reflect.TypeOf((*MockServices)(nil).ArticleUpdate), article, fileHeader)
+}
+
+// ArticlesGet mocks base method.
+func (m *MockServices) ArticlesGet(tag string, drafts bool, page domain.Page) ([]domain.Article, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArticlesGet", tag, drafts, page)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ArticlesGet indicates an expected call of ArticlesGet.
+func (mr *MockServicesMockRecorder) ArticlesGet(tag, drafts, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArticlesGet", reflect.TypeOf((*MockServices)(nil).ArticlesGet), tag, drafts, page)
+}
+
+// BuyerAdd mocks base method.
+func (m *MockServices) BuyerAdd(buyer *domain.Buyer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyerAdd", buyer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuyerAdd indicates an expected call of BuyerAdd.
+func (mr *MockServicesMockRecorder) BuyerAdd(buyer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyerAdd", reflect.TypeOf((*MockServices)(nil).BuyerAdd), buyer)
+}
+
+// BuyerDelete mocks base method.
+func (m *MockServices) BuyerDelete(buyerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyerDelete", buyerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuyerDelete indicates an expected call of BuyerDelete.
+func (mr *MockServicesMockRecorder) BuyerDelete(buyerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyerDelete", reflect.TypeOf((*MockServices)(nil).BuyerDelete), buyerID)
+}
+
+// BuyerGet mocks base method.
+func (m *MockServices) BuyerGet(idBuyer string) (*domain.Buyer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyerGet", idBuyer)
+	ret0, _ := ret[0].(*domain.Buyer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyerGet indicates an expected call of BuyerGet.
+func (mr *MockServicesMockRecorder) BuyerGet(idBuyer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyerGet", reflect.TypeOf((*MockServices)(nil).BuyerGet), idBuyer)
+}
+
+// BuyerUpdate mocks base method.
+func (m *MockServices) BuyerUpdate(buyer *domain.Buyer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyerUpdate", buyer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BuyerUpdate indicates an expected call of BuyerUpdate.
+func (mr *MockServicesMockRecorder) BuyerUpdate(buyer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyerUpdate", reflect.TypeOf((*MockServices)(nil).BuyerUpdate), buyer)
+}
+
+// BuyersGet mocks base method.
+func (m *MockServices) BuyersGet(search string) ([]domain.Buyer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuyersGet", search)
+	ret0, _ := ret[0].([]domain.Buyer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuyersGet indicates an expected call of BuyersGet.
+func (mr *MockServicesMockRecorder) BuyersGet(search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuyersGet", reflect.TypeOf((*MockServices)(nil).BuyersGet), search)
+}
+
+// ColorPredictionGet mocks base method.
+func (m *MockServices) ColorPredictionGet(sireID, damID int) (*domain.ColorPrediction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ColorPredictionGet", sireID, damID)
+	ret0, _ := ret[0].(*domain.ColorPrediction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ColorPredictionGet indicates an expected call of ColorPredictionGet.
+func (mr *MockServicesMockRecorder) ColorPredictionGet(sireID, damID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ColorPredictionGet", reflect.TypeOf((*MockServices)(nil).ColorPredictionGet), sireID, damID)
+}
+
+// DictionariesGet mocks base method.
+func (m *MockServices) DictionariesGet() (*domain.Dictionaries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DictionariesGet")
+	ret0, _ := ret[0].(*domain.Dictionaries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DictionariesGet indicates an expected call of DictionariesGet.
+func (mr *MockServicesMockRecorder) DictionariesGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DictionariesGet", reflect.TypeOf((*MockServices)(nil).DictionariesGet))
+}
+
+// DictionaryEntryAdd mocks base method.
+func (m *MockServices) DictionaryEntryAdd(entry *domain.DictionaryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DictionaryEntryAdd", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DictionaryEntryAdd indicates an expected call of DictionaryEntryAdd.
+func (mr *MockServicesMockRecorder) DictionaryEntryAdd(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DictionaryEntryAdd", reflect.TypeOf((*MockServices)(nil).DictionaryEntryAdd), entry)
+}
+
+// DictionaryEntryDelete mocks base method.
+func (m *MockServices) DictionaryEntryDelete(kind, entryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DictionaryEntryDelete", kind, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DictionaryEntryDelete indicates an expected call of DictionaryEntryDelete.
+func (mr *MockServicesMockRecorder) DictionaryEntryDelete(kind, entryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DictionaryEntryDelete", reflect.TypeOf((*MockServices)(nil).DictionaryEntryDelete), kind, entryID)
+}
+
+// DictionaryEntryUpdate mocks base method.
+func (m *MockServices) DictionaryEntryUpdate(entry *domain.DictionaryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DictionaryEntryUpdate", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DictionaryEntryUpdate indicates an expected call of DictionaryEntryUpdate.
+func (mr *MockServicesMockRecorder) DictionaryEntryUpdate(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DictionaryEntryUpdate", reflect.TypeOf((*MockServices)(nil).DictionaryEntryUpdate), entry)
+}
+
 // DogAdd mocks base method.
 func (m *MockServices) DogAdd(puppy *domain.Dog, fileHeaders []*multipart.FileHeader) error {
 	m.ctrl.T.Helper()
@@ -77,6 +296,66 @@ func (mr *MockServicesMockRecorder) DogChangeArchived(puppyID, archived interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogChangeArchived", reflect.TypeOf((*MockServices)(nil).DogChangeArchived), puppyID, archived)
 }
 
+// DogGet mocks base method.
+func (m *MockServices) DogGet(idDog string) (*domain.Dog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DogGet", idDog)
+	ret0, _ := ret[0].(*domain.Dog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DogGet indicates an expected call of DogGet.
+func (mr *MockServicesMockRecorder) DogGet(idDog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogGet", reflect.TypeOf((*MockServices)(nil).DogGet), idDog)
+}
+
+// DogHealthGet mocks base method.
+func (m *MockServices) DogHealthGet(dogID int) (*domain.HealthSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DogHealthGet", dogID)
+	ret0, _ := ret[0].(*domain.HealthSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DogHealthGet indicates an expected call of DogHealthGet.
+func (mr *MockServicesMockRecorder) DogHealthGet(dogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogHealthGet", reflect.TypeOf((*MockServices)(nil).DogHealthGet), dogID)
+}
+
+// DogOffspringGet mocks base method.
+func (m *MockServices) DogOffspringGet(dogID int) ([]domain.Puppy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DogOffspringGet", dogID)
+	ret0, _ := ret[0].([]domain.Puppy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DogOffspringGet indicates an expected call of DogOffspringGet.
+func (mr *MockServicesMockRecorder) DogOffspringGet(dogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogOffspringGet", reflect.TypeOf((*MockServices)(nil).DogOffspringGet), dogID)
+}
+
+// DogPedigreeGet mocks base method.
+func (m *MockServices) DogPedigreeGet(idDog string) (*domain.PedigreeNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DogPedigreeGet", idDog)
+	ret0, _ := ret[0].(*domain.PedigreeNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DogPedigreeGet indicates an expected call of DogPedigreeGet.
+func (mr *MockServicesMockRecorder) DogPedigreeGet(idDog interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogPedigreeGet", reflect.TypeOf((*MockServices)(nil).DogPedigreeGet), idDog)
+}
+
 // DogUpdate mocks base method.
 func (m *MockServices) DogUpdate(dog *domain.Dog, fileHeaders []*multipart.FileHeader) error {
 	m.ctrl.T.Helper()
@@ -91,6 +370,21 @@ func (mr *MockServicesMockRecorder) DogUpdate(dog, fileHeaders interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogUpdate", reflect.TypeOf((*MockServices)(nil).DogUpdate), dog, fileHeaders)
 }
 
+// DogsAllGet mocks base method.
+func (m *MockServices) DogsAllGet() ([]domain.Dog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DogsAllGet")
+	ret0, _ := ret[0].([]domain.Dog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DogsAllGet indicates an expected call of DogsAllGet.
+func (mr *MockServicesMockRecorder) DogsAllGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogsAllGet", reflect.TypeOf((*MockServices)(nil).DogsAllGet))
+}
+
 // DogsGet mocks base method.
 func (m *MockServices) DogsGet(chocolates, genders []string, id string, archived bool) ([]domain.Dog, error) {
 	m.ctrl.T.Helper()
@@ -106,6 +400,22 @@ func (mr *MockServicesMockRecorder) DogsGet(chocolates, genders, id, archived in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogsGet", reflect.TypeOf((*MockServices)(nil).DogsGet), chocolates, genders, id, archived)
 }
 
+// DogsPageGet mocks base method.
+func (m *MockServices) DogsPageGet(chocolates, genders []string, archived bool, page domain.Page) ([]domain.Dog, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DogsPageGet", chocolates, genders, archived, page)
+	ret0, _ := ret[0].([]domain.Dog)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DogsPageGet indicates an expected call of DogsPageGet.
+func (mr *MockServicesMockRecorder) DogsPageGet(chocolates, genders, archived, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DogsPageGet", reflect.TypeOf((*MockServices)(nil).DogsPageGet), chocolates, genders, archived, page)
+}
+
 // FeedbackAdd mocks base method.
 func (m *MockServices) FeedbackAdd(feedback *domain.Feedback, fileHeaders []*multipart.FileHeader) error {
 	m.ctrl.T.Helper()
@@ -177,149 +487,750 @@ func (mr *MockServicesMockRecorder) FeedbackUpdate(feedback, fileHeaders interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedbackUpdate", reflect.TypeOf((*MockServices)(nil).FeedbackUpdate), feedback, fileHeaders)
 }
 
-// GetPagedPuppies mocks base method.
-func (m *MockServices) GetPagedPuppies(puppies []domain.Puppy, currentPage, perPage int) ([]domain.Puppy, int, error) {
+// HealthEventAdd mocks base method.
+func (m *MockServices) HealthEventAdd(event *domain.HealthEvent, fileHeader *multipart.FileHeader) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPagedPuppies", puppies, currentPage, perPage)
-	ret0, _ := ret[0].([]domain.Puppy)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "HealthEventAdd", event, fileHeader)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetPagedPuppies indicates an expected call of GetPagedPuppies.
-func (mr *MockServicesMockRecorder) GetPagedPuppies(puppies, currentPage, perPage interface{}) *gomock.Call {
+// HealthEventAdd indicates an expected call of HealthEventAdd.
+func (mr *MockServicesMockRecorder) HealthEventAdd(event, fileHeader interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPagedPuppies", reflect.TypeOf((*MockServices)(nil).GetPagedPuppies), puppies, currentPage, perPage)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthEventAdd", reflect.TypeOf((*MockServices)(nil).HealthEventAdd), event, fileHeader)
 }
 
-// PuppiesGet mocks base method.
-func (m *MockServices) PuppiesGet(chocolates, genders []string, idPuppy, readyToMove string, page int, archived bool) ([]domain.Puppy, map[int]int, int, error) {
+// HealthEventDelete mocks base method.
+func (m *MockServices) HealthEventDelete(eventID string) (*domain.HealthEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PuppiesGet", chocolates, genders, idPuppy, readyToMove, page, archived)
-	ret0, _ := ret[0].([]domain.Puppy)
-	ret1, _ := ret[1].(map[int]int)
-	ret2, _ := ret[2].(int)
-	ret3, _ := ret[3].(error)
-	return ret0, ret1, ret2, ret3
+	ret := m.ctrl.Call(m, "HealthEventDelete", eventID)
+	ret0, _ := ret[0].(*domain.HealthEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// PuppiesGet indicates an expected call of PuppiesGet.
-func (mr *MockServicesMockRecorder) PuppiesGet(chocolates, genders, idPuppy, readyToMove, page, archived interface{}) *gomock.Call {
+// HealthEventDelete indicates an expected call of HealthEventDelete.
+func (mr *MockServicesMockRecorder) HealthEventDelete(eventID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppiesGet", reflect.TypeOf((*MockServices)(nil).PuppiesGet), chocolates, genders, idPuppy, readyToMove, page, archived)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthEventDelete", reflect.TypeOf((*MockServices)(nil).HealthEventDelete), eventID)
 }
 
-// PuppyAdd mocks base method.
-func (m *MockServices) PuppyAdd(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
+// HealthEventUpdate mocks base method.
+func (m *MockServices) HealthEventUpdate(event *domain.HealthEvent, fileHeader *multipart.FileHeader) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PuppyAdd", puppy, fileHeaders)
+	ret := m.ctrl.Call(m, "HealthEventUpdate", event, fileHeader)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PuppyAdd indicates an expected call of PuppyAdd.
-func (mr *MockServicesMockRecorder) PuppyAdd(puppy, fileHeaders interface{}) *gomock.Call {
+// HealthEventUpdate indicates an expected call of HealthEventUpdate.
+func (mr *MockServicesMockRecorder) HealthEventUpdate(event, fileHeader interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyAdd", reflect.TypeOf((*MockServices)(nil).PuppyAdd), puppy, fileHeaders)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthEventUpdate", reflect.TypeOf((*MockServices)(nil).HealthEventUpdate), event, fileHeader)
+}
+
+// HealthEventsGet mocks base method.
+func (m *MockServices) HealthEventsGet(puppyID, dogID int) ([]domain.HealthEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthEventsGet", puppyID, dogID)
+	ret0, _ := ret[0].([]domain.HealthEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthEventsGet indicates an expected call of HealthEventsGet.
+func (mr *MockServicesMockRecorder) HealthEventsGet(puppyID, dogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthEventsGet", reflect.TypeOf((*MockServices)(nil).HealthEventsGet), puppyID, dogID)
 }
 
-// PuppyChangeArchived mocks base method.
-func (m *MockServices) PuppyChangeArchived(puppyID, archived, city, phone string) error {
+// LitterAdd mocks base method.
+func (m *MockServices) LitterAdd(litter *domain.Litter) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PuppyChangeArchived", puppyID, archived, city, phone)
+	ret := m.ctrl.Call(m, "LitterAdd", litter)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PuppyChangeArchived indicates an expected call of PuppyChangeArchived.
-func (mr *MockServicesMockRecorder) PuppyChangeArchived(puppyID, archived, city, phone interface{}) *gomock.Call {
+// LitterAdd indicates an expected call of LitterAdd.
+func (mr *MockServicesMockRecorder) LitterAdd(litter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyChangeArchived", reflect.TypeOf((*MockServices)(nil).PuppyChangeArchived), puppyID, archived, city, phone)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LitterAdd", reflect.TypeOf((*MockServices)(nil).LitterAdd), litter)
 }
 
-// PuppyDelete mocks base method.
-func (m *MockServices) PuppyDelete(puppyID string) error {
+// LitterDelete mocks base method.
+func (m *MockServices) LitterDelete(litterID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PuppyDelete", puppyID)
+	ret := m.ctrl.Call(m, "LitterDelete", litterID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PuppyDelete indicates an expected call of PuppyDelete.
-func (mr *MockServicesMockRecorder) PuppyDelete(puppyID interface{}) *gomock.Call {
+// LitterDelete indicates an expected call of LitterDelete.
+func (mr *MockServicesMockRecorder) LitterDelete(litterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyDelete", reflect.TypeOf((*MockServices)(nil).PuppyDelete), puppyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LitterDelete", reflect.TypeOf((*MockServices)(nil).LitterDelete), litterID)
 }
 
-// PuppyGet mocks base method.
-func (m *MockServices) PuppyGet(idPuppy string) (*domain.Puppy, *domain.Dog, *domain.Dog, error) {
+// LitterGet mocks base method.
+func (m *MockServices) LitterGet(idLitter string) (*domain.Litter, *domain.Dog, *domain.Dog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PuppyGet", idPuppy)
-	ret0, _ := ret[0].(*domain.Puppy)
+	ret := m.ctrl.Call(m, "LitterGet", idLitter)
+	ret0, _ := ret[0].(*domain.Litter)
 	ret1, _ := ret[1].(*domain.Dog)
 	ret2, _ := ret[2].(*domain.Dog)
 	ret3, _ := ret[3].(error)
 	return ret0, ret1, ret2, ret3
 }
 
-// PuppyGet indicates an expected call of PuppyGet.
-func (mr *MockServicesMockRecorder) PuppyGet(idPuppy interface{}) *gomock.Call {
+// LitterGet indicates an expected call of LitterGet.
+func (mr *MockServicesMockRecorder) LitterGet(idLitter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyGet", reflect.TypeOf((*MockServices)(nil).PuppyGet), idPuppy)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LitterGet", reflect.TypeOf((*MockServices)(nil).LitterGet), idLitter)
 }
 
-// PuppyUpdate mocks base method.
-func (m *MockServices) PuppyUpdate(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
+// LitterUpdate mocks base method.
+func (m *MockServices) LitterUpdate(litter *domain.Litter) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PuppyUpdate", puppy, fileHeaders)
+	ret := m.ctrl.Call(m, "LitterUpdate", litter)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// PuppyUpdate indicates an expected call of PuppyUpdate.
-func (mr *MockServicesMockRecorder) PuppyUpdate(puppy, fileHeaders interface{}) *gomock.Call {
+// LitterUpdate indicates an expected call of LitterUpdate.
+func (mr *MockServicesMockRecorder) LitterUpdate(litter interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyUpdate", reflect.TypeOf((*MockServices)(nil).PuppyUpdate), puppy, fileHeaders)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LitterUpdate", reflect.TypeOf((*MockServices)(nil).LitterUpdate), litter)
 }
 
-// ReviewsGet mocks base method.
-func (m *MockServices) ReviewsGet(idReview string, checked bool) ([]domain.Feedback, map[int]string, error) {
+// LittersGet mocks base method.
+func (m *MockServices) LittersGet() ([]domain.Litter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReviewsGet", idReview, checked)
-	ret0, _ := ret[0].([]domain.Feedback)
-	ret1, _ := ret[1].(map[int]string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "LittersGet")
+	ret0, _ := ret[0].([]domain.Litter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// ReviewsGet indicates an expected call of ReviewsGet.
-func (mr *MockServicesMockRecorder) ReviewsGet(idReview, checked interface{}) *gomock.Call {
+// LittersGet indicates an expected call of LittersGet.
+func (mr *MockServicesMockRecorder) LittersGet() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewsGet", reflect.TypeOf((*MockServices)(nil).ReviewsGet), idReview, checked)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LittersGet", reflect.TypeOf((*MockServices)(nil).LittersGet))
 }
 
-// MockAuthorizationServices is a mock of AuthorizationServices interface.
-type MockAuthorizationServices struct {
-	ctrl     *gomock.Controller
-	recorder *MockAuthorizationServicesMockRecorder
+// MatingCOIGet mocks base method.
+func (m *MockServices) MatingCOIGet(sireID, damID int) (*domain.COIResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatingCOIGet", sireID, damID)
+	ret0, _ := ret[0].(*domain.COIResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockAuthorizationServicesMockRecorder is the mock recorder for MockAuthorizationServices.
-type MockAuthorizationServicesMockRecorder struct {
-	mock *MockAuthorizationServices
+// MatingCOIGet indicates an expected call of MatingCOIGet.
+func (mr *MockServicesMockRecorder) MatingCOIGet(sireID, damID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatingCOIGet", reflect.TypeOf((*MockServices)(nil).MatingCOIGet), sireID, damID)
 }
 
-// NewMockAuthorizationServices creates a new mock instance.
-func NewMockAuthorizationServices(ctrl *gomock.Controller) *MockAuthorizationServices {
-	mock := &MockAuthorizationServices{ctrl: ctrl}
-	mock.recorder = &MockAuthorizationServicesMockRecorder{mock}
-	return mock
+// PlannedMatingAdd mocks base method.
+func (m *MockServices) PlannedMatingAdd(mating *domain.PlannedMating) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlannedMatingAdd", mating)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAuthorizationServices) EXPECT() *MockAuthorizationServicesMockRecorder {
-	return m.recorder
+// PlannedMatingAdd indicates an expected call of PlannedMatingAdd.
+func (mr *MockServicesMockRecorder) PlannedMatingAdd(mating interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlannedMatingAdd", reflect.TypeOf((*MockServices)(nil).PlannedMatingAdd), mating)
+}
+
+// PlannedMatingDelete mocks base method.
+func (m *MockServices) PlannedMatingDelete(matingID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlannedMatingDelete", matingID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlannedMatingDelete indicates an expected call of PlannedMatingDelete.
+func (mr *MockServicesMockRecorder) PlannedMatingDelete(matingID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlannedMatingDelete", reflect.TypeOf((*MockServices)(nil).PlannedMatingDelete), matingID)
+}
+
+// PlannedMatingUpdate mocks base method.
+func (m *MockServices) PlannedMatingUpdate(mating *domain.PlannedMating) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlannedMatingUpdate", mating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlannedMatingUpdate indicates an expected call of PlannedMatingUpdate.
+func (mr *MockServicesMockRecorder) PlannedMatingUpdate(mating interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlannedMatingUpdate", reflect.TypeOf((*MockServices)(nil).PlannedMatingUpdate), mating)
+}
+
+// PlannedMatingWhelp mocks base method.
+func (m *MockServices) PlannedMatingWhelp(matingID string, dateBirth, readyOutDate time.Time) (*domain.Litter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlannedMatingWhelp", matingID, dateBirth, readyOutDate)
+	ret0, _ := ret[0].(*domain.Litter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlannedMatingWhelp indicates an expected call of PlannedMatingWhelp.
+func (mr *MockServicesMockRecorder) PlannedMatingWhelp(matingID, dateBirth, readyOutDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlannedMatingWhelp", reflect.TypeOf((*MockServices)(nil).PlannedMatingWhelp), matingID, dateBirth, readyOutDate)
+}
+
+// PlannedMatingsGet mocks base method.
+func (m *MockServices) PlannedMatingsGet() ([]domain.PlannedMating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlannedMatingsGet")
+	ret0, _ := ret[0].([]domain.PlannedMating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlannedMatingsGet indicates an expected call of PlannedMatingsGet.
+func (mr *MockServicesMockRecorder) PlannedMatingsGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlannedMatingsGet", reflect.TypeOf((*MockServices)(nil).PlannedMatingsGet))
+}
+
+// PriceHistoryGet mocks base method.
+func (m *MockServices) PriceHistoryGet(puppyID int) ([]domain.PriceChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceHistoryGet", puppyID)
+	ret0, _ := ret[0].([]domain.PriceChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PriceHistoryGet indicates an expected call of PriceHistoryGet.
+func (mr *MockServicesMockRecorder) PriceHistoryGet(puppyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceHistoryGet", reflect.TypeOf((*MockServices)(nil).PriceHistoryGet), puppyID)
+}
+
+// PuppiesGet mocks base method.
+func (m *MockServices) PuppiesGet(chocolates, genders, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, page domain.Page) ([]domain.Puppy, map[int]int, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppiesGet", chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, page)
+	ret0, _ := ret[0].([]domain.Puppy)
+	ret1, _ := ret[1].(map[int]int)
+	ret2, _ := ret[2].(int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// PuppiesGet indicates an expected call of PuppiesGet.
+func (mr *MockServicesMockRecorder) PuppiesGet(chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppiesGet", reflect.TypeOf((*MockServices)(nil).PuppiesGet), chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, page)
+}
+
+// PuppyAdd mocks base method.
+func (m *MockServices) PuppyAdd(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyAdd", puppy, fileHeaders)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PuppyAdd indicates an expected call of PuppyAdd.
+func (mr *MockServicesMockRecorder) PuppyAdd(puppy, fileHeaders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyAdd", reflect.TypeOf((*MockServices)(nil).PuppyAdd), puppy, fileHeaders)
+}
+
+// PuppyCOIGet mocks base method.
+func (m *MockServices) PuppyCOIGet(idPuppy string) (*domain.COIResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyCOIGet", idPuppy)
+	ret0, _ := ret[0].(*domain.COIResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PuppyCOIGet indicates an expected call of PuppyCOIGet.
+func (mr *MockServicesMockRecorder) PuppyCOIGet(idPuppy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyCOIGet", reflect.TypeOf((*MockServices)(nil).PuppyCOIGet), idPuppy)
+}
+
+// PuppyChangeStatus mocks base method.
+func (m *MockServices) PuppyChangeStatus(puppyID, status string, reservedUntil time.Time, city string, buyer *domain.Buyer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyChangeStatus", puppyID, status, reservedUntil, city, buyer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PuppyChangeStatus indicates an expected call of PuppyChangeStatus.
+func (mr *MockServicesMockRecorder) PuppyChangeStatus(puppyID, status, reservedUntil, city, buyer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyChangeStatus", reflect.TypeOf((*MockServices)(nil).PuppyChangeStatus), puppyID, status, reservedUntil, city, buyer)
+}
+
+// PuppyDelete mocks base method.
+func (m *MockServices) PuppyDelete(puppyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyDelete", puppyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PuppyDelete indicates an expected call of PuppyDelete.
+func (mr *MockServicesMockRecorder) PuppyDelete(puppyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyDelete", reflect.TypeOf((*MockServices)(nil).PuppyDelete), puppyID)
+}
+
+// PuppyFacetsGet mocks base method.
+func (m *MockServices) PuppyFacetsGet(chocolates, genders, statuses []string, readyToMove, breed string, filter domain.PuppyFilter) (*domain.PuppyFacets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyFacetsGet", chocolates, genders, statuses, readyToMove, breed, filter)
+	ret0, _ := ret[0].(*domain.PuppyFacets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PuppyFacetsGet indicates an expected call of PuppyFacetsGet.
+func (mr *MockServicesMockRecorder) PuppyFacetsGet(chocolates, genders, statuses, readyToMove, breed, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyFacetsGet", reflect.TypeOf((*MockServices)(nil).PuppyFacetsGet), chocolates, genders, statuses, readyToMove, breed, filter)
+}
+
+// PuppyGet mocks base method.
+func (m *MockServices) PuppyGet(idPuppy string) (*domain.Puppy, *domain.Dog, *domain.Dog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyGet", idPuppy)
+	ret0, _ := ret[0].(*domain.Puppy)
+	ret1, _ := ret[1].(*domain.Dog)
+	ret2, _ := ret[2].(*domain.Dog)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// PuppyGet indicates an expected call of PuppyGet.
+func (mr *MockServicesMockRecorder) PuppyGet(idPuppy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyGet", reflect.TypeOf((*MockServices)(nil).PuppyGet), idPuppy)
+}
+
+// PuppyGrowthGet mocks base method.
+func (m *MockServices) PuppyGrowthGet(idPuppy string) ([]domain.GrowthSeries, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyGrowthGet", idPuppy)
+	ret0, _ := ret[0].([]domain.GrowthSeries)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PuppyGrowthGet indicates an expected call of PuppyGrowthGet.
+func (mr *MockServicesMockRecorder) PuppyGrowthGet(idPuppy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyGrowthGet", reflect.TypeOf((*MockServices)(nil).PuppyGrowthGet), idPuppy)
+}
+
+// PuppyHealthGet mocks base method.
+func (m *MockServices) PuppyHealthGet(idPuppy string) (*domain.HealthSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyHealthGet", idPuppy)
+	ret0, _ := ret[0].(*domain.HealthSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PuppyHealthGet indicates an expected call of PuppyHealthGet.
+func (mr *MockServicesMockRecorder) PuppyHealthGet(idPuppy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyHealthGet", reflect.TypeOf((*MockServices)(nil).PuppyHealthGet), idPuppy)
+}
+
+// PuppyPedigreeGet mocks base method.
+func (m *MockServices) PuppyPedigreeGet(puppy *domain.Puppy) (*domain.PedigreeNode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyPedigreeGet", puppy)
+	ret0, _ := ret[0].(*domain.PedigreeNode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PuppyPedigreeGet indicates an expected call of PuppyPedigreeGet.
+func (mr *MockServicesMockRecorder) PuppyPedigreeGet(puppy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyPedigreeGet", reflect.TypeOf((*MockServices)(nil).PuppyPedigreeGet), puppy)
+}
+
+// PuppyUpdate mocks base method.
+func (m *MockServices) PuppyUpdate(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyUpdate", puppy, fileHeaders)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PuppyUpdate indicates an expected call of PuppyUpdate.
+func (mr *MockServicesMockRecorder) PuppyUpdate(puppy, fileHeaders interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyUpdate", reflect.TypeOf((*MockServices)(nil).PuppyUpdate), puppy, fileHeaders)
+}
+
+// PuppyWeightsGet mocks base method.
+func (m *MockServices) PuppyWeightsGet(puppyID int) ([]domain.WeightMeasurement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PuppyWeightsGet", puppyID)
+	ret0, _ := ret[0].([]domain.WeightMeasurement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PuppyWeightsGet indicates an expected call of PuppyWeightsGet.
+func (mr *MockServicesMockRecorder) PuppyWeightsGet(puppyID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PuppyWeightsGet", reflect.TypeOf((*MockServices)(nil).PuppyWeightsGet), puppyID)
+}
+
+// ReviewsGet mocks base method.
+func (m *MockServices) ReviewsGet(idReview string, checked bool, page domain.Page) ([]domain.Feedback, map[int]string, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewsGet", idReview, checked, page)
+	ret0, _ := ret[0].([]domain.Feedback)
+	ret1, _ := ret[1].(map[int]string)
+	ret2, _ := ret[2].(int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ReviewsGet indicates an expected call of ReviewsGet.
+func (mr *MockServicesMockRecorder) ReviewsGet(idReview, checked, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewsGet", reflect.TypeOf((*MockServices)(nil).ReviewsGet), idReview, checked, page)
+}
+
+// Search mocks base method.
+func (m *MockServices) Search(query string) ([]domain.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", query)
+	ret0, _ := ret[0].([]domain.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockServicesMockRecorder) Search(query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockServices)(nil).Search), query)
+}
+
+// ShowResultAdd mocks base method.
+func (m *MockServices) ShowResultAdd(result *domain.ShowResult, fileHeader *multipart.FileHeader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowResultAdd", result, fileHeader)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShowResultAdd indicates an expected call of ShowResultAdd.
+func (mr *MockServicesMockRecorder) ShowResultAdd(result, fileHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResultAdd", reflect.TypeOf((*MockServices)(nil).ShowResultAdd), result, fileHeader)
+}
+
+// ShowResultDelete mocks base method.
+func (m *MockServices) ShowResultDelete(resultID string) (*domain.ShowResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowResultDelete", resultID)
+	ret0, _ := ret[0].(*domain.ShowResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowResultDelete indicates an expected call of ShowResultDelete.
+func (mr *MockServicesMockRecorder) ShowResultDelete(resultID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResultDelete", reflect.TypeOf((*MockServices)(nil).ShowResultDelete), resultID)
+}
+
+// ShowResultUpdate mocks base method.
+func (m *MockServices) ShowResultUpdate(result *domain.ShowResult, fileHeader *multipart.FileHeader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowResultUpdate", result, fileHeader)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShowResultUpdate indicates an expected call of ShowResultUpdate.
+func (mr *MockServicesMockRecorder) ShowResultUpdate(result, fileHeader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResultUpdate", reflect.TypeOf((*MockServices)(nil).ShowResultUpdate), result, fileHeader)
+}
+
+// ShowResultsGet mocks base method.
+func (m *MockServices) ShowResultsGet(dogID int) ([]domain.ShowResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowResultsGet", dogID)
+	ret0, _ := ret[0].([]domain.ShowResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowResultsGet indicates an expected call of ShowResultsGet.
+func (mr *MockServicesMockRecorder) ShowResultsGet(dogID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowResultsGet", reflect.TypeOf((*MockServices)(nil).ShowResultsGet), dogID)
+}
+
+// SiteSettingsGet mocks base method.
+func (m *MockServices) SiteSettingsGet() (*domain.SiteSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SiteSettingsGet")
+	ret0, _ := ret[0].(*domain.SiteSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SiteSettingsGet indicates an expected call of SiteSettingsGet.
+func (mr *MockServicesMockRecorder) SiteSettingsGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SiteSettingsGet", reflect.TypeOf((*MockServices)(nil).SiteSettingsGet))
+}
+
+// SiteSettingsUpdate mocks base method.
+func (m *MockServices) SiteSettingsUpdate(settings *domain.SiteSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SiteSettingsUpdate", settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SiteSettingsUpdate indicates an expected call of SiteSettingsUpdate.
+func (mr *MockServicesMockRecorder) SiteSettingsUpdate(settings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SiteSettingsUpdate", reflect.TypeOf((*MockServices)(nil).SiteSettingsUpdate), settings)
+}
+
+// UpcomingLittersGet mocks base method.
+func (m *MockServices) UpcomingLittersGet() ([]domain.UpcomingLitter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpcomingLittersGet")
+	ret0, _ := ret[0].([]domain.UpcomingLitter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpcomingLittersGet indicates an expected call of UpcomingLittersGet.
+func (mr *MockServicesMockRecorder) UpcomingLittersGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpcomingLittersGet", reflect.TypeOf((*MockServices)(nil).UpcomingLittersGet))
+}
+
+// WaitlistCandidatesGet mocks base method.
+func (m *MockServices) WaitlistCandidatesGet(idPuppy string) (*domain.Puppy, []domain.WaitlistCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistCandidatesGet", idPuppy)
+	ret0, _ := ret[0].(*domain.Puppy)
+	ret1, _ := ret[1].([]domain.WaitlistCandidate)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WaitlistCandidatesGet indicates an expected call of WaitlistCandidatesGet.
+func (mr *MockServicesMockRecorder) WaitlistCandidatesGet(idPuppy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistCandidatesGet", reflect.TypeOf((*MockServices)(nil).WaitlistCandidatesGet), idPuppy)
+}
+
+// WaitlistEntriesGet mocks base method.
+func (m *MockServices) WaitlistEntriesGet() ([]domain.WaitlistEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistEntriesGet")
+	ret0, _ := ret[0].([]domain.WaitlistEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitlistEntriesGet indicates an expected call of WaitlistEntriesGet.
+func (mr *MockServicesMockRecorder) WaitlistEntriesGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistEntriesGet", reflect.TypeOf((*MockServices)(nil).WaitlistEntriesGet))
+}
+
+// WaitlistEntryAdd mocks base method.
+func (m *MockServices) WaitlistEntryAdd(entry *domain.WaitlistEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistEntryAdd", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitlistEntryAdd indicates an expected call of WaitlistEntryAdd.
+func (mr *MockServicesMockRecorder) WaitlistEntryAdd(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistEntryAdd", reflect.TypeOf((*MockServices)(nil).WaitlistEntryAdd), entry)
+}
+
+// WaitlistEntryChangeActive mocks base method.
+func (m *MockServices) WaitlistEntryChangeActive(entryID string, active bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistEntryChangeActive", entryID, active)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitlistEntryChangeActive indicates an expected call of WaitlistEntryChangeActive.
+func (mr *MockServicesMockRecorder) WaitlistEntryChangeActive(entryID, active interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistEntryChangeActive", reflect.TypeOf((*MockServices)(nil).WaitlistEntryChangeActive), entryID, active)
+}
+
+// WaitlistEntryDelete mocks base method.
+func (m *MockServices) WaitlistEntryDelete(entryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistEntryDelete", entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitlistEntryDelete indicates an expected call of WaitlistEntryDelete.
+func (mr *MockServicesMockRecorder) WaitlistEntryDelete(entryID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistEntryDelete", reflect.TypeOf((*MockServices)(nil).WaitlistEntryDelete), entryID)
+}
+
+// WaitlistNotificationSent mocks base method.
+func (m *MockServices) WaitlistNotificationSent(notificationID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitlistNotificationSent", notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitlistNotificationSent indicates an expected call of WaitlistNotificationSent.
+func (mr *MockServicesMockRecorder) WaitlistNotificationSent(notificationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitlistNotificationSent", reflect.TypeOf((*MockServices)(nil).WaitlistNotificationSent), notificationID)
+}
+
+// WeightAdd mocks base method.
+func (m *MockServices) WeightAdd(measurement *domain.WeightMeasurement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WeightAdd", measurement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WeightAdd indicates an expected call of WeightAdd.
+func (mr *MockServicesMockRecorder) WeightAdd(measurement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WeightAdd", reflect.TypeOf((*MockServices)(nil).WeightAdd), measurement)
+}
+
+// WeightDelete mocks base method.
+func (m *MockServices) WeightDelete(measurementID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WeightDelete", measurementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WeightDelete indicates an expected call of WeightDelete.
+func (mr *MockServicesMockRecorder) WeightDelete(measurementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WeightDelete", reflect.TypeOf((*MockServices)(nil).WeightDelete), measurementID)
+}
+
+// MockAuthorizationServices is a mock of AuthorizationServices interface.
+type MockAuthorizationServices struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationServicesMockRecorder
+}
+
+// MockAuthorizationServicesMockRecorder is the mock recorder for MockAuthorizationServices.
+type MockAuthorizationServicesMockRecorder struct {
+	mock *MockAuthorizationServices
+}
+
+// NewMockAuthorizationServices creates a new mock instance.
+func NewMockAuthorizationServices(ctrl *gomock.Controller) *MockAuthorizationServices {
+	mock := &MockAuthorizationServices{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationServicesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationServices) EXPECT() *MockAuthorizationServicesMockRecorder {
+	return m.recorder
+}
+
+// APITokenCheck mocks base method.
+func (m *MockAuthorizationServices) APITokenCheck(secret string) (*domain.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APITokenCheck", secret)
+	ret0, _ := ret[0].(*domain.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APITokenCheck indicates an expected call of APITokenCheck.
+func (mr *MockAuthorizationServicesMockRecorder) APITokenCheck(secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokenCheck", reflect.TypeOf((*MockAuthorizationServices)(nil).APITokenCheck), secret)
+}
+
+// APITokenCreate mocks base method.
+func (m *MockAuthorizationServices) APITokenCreate(name string) (string, *domain.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APITokenCreate", name)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*domain.APIToken)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// APITokenCreate indicates an expected call of APITokenCreate.
+func (mr *MockAuthorizationServicesMockRecorder) APITokenCreate(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokenCreate", reflect.TypeOf((*MockAuthorizationServices)(nil).APITokenCreate), name)
+}
+
+// APITokenRevoke mocks base method.
+func (m *MockAuthorizationServices) APITokenRevoke(tokenID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APITokenRevoke", tokenID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// APITokenRevoke indicates an expected call of APITokenRevoke.
+func (mr *MockAuthorizationServicesMockRecorder) APITokenRevoke(tokenID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokenRevoke", reflect.TypeOf((*MockAuthorizationServices)(nil).APITokenRevoke), tokenID)
+}
+
+// APITokensGet mocks base method.
+func (m *MockAuthorizationServices) APITokensGet() ([]domain.APIToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APITokensGet")
+	ret0, _ := ret[0].([]domain.APIToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// APITokensGet indicates an expected call of APITokensGet.
+func (mr *MockAuthorizationServicesMockRecorder) APITokensGet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APITokensGet", reflect.TypeOf((*MockAuthorizationServices)(nil).APITokensGet))
 }
 
 // CheckPasswordHash mocks base method.
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"strconv"
+)
+
+// PedigreeGenerations — сколько поколений предков показывать в родословной.
+const PedigreeGenerations = 4
+
+// DogPedigreeGet получает родословную взрослой собаки.
+func (s *ServiceImpl) DogPedigreeGet(idDog string) (*domain.PedigreeNode, error) {
+	cacheKeyPedigree := fmt.Sprintf("pedigree:dog:%s", idDog)
+
+	cachedPedigree, err := s.Repository.RedisRepository.GetPedigree(cacheKeyPedigree)
+	if err == nil && cachedPedigree != nil {
+		return cachedPedigree, nil
+	}
+
+	dogID, err := strconv.Atoi(idDog)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dog ID %q: %w", idDog, err)
+	}
+
+	dogs, err := s.Repository.PostgresRepository.PedigreeGet([]int{dogID}, PedigreeGenerations)
+	if err != nil {
+		return nil, err
+	}
+
+	pedigree := buildPedigree(dogs, dogID, PedigreeGenerations)
+	if pedigree == nil {
+		return nil, fmt.Errorf("dog %d not found", dogID)
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetPedigree(cacheKeyPedigree, pedigree)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования родословной собаки", zap.Error(err))
+		}
+	}()
+
+	return pedigree, nil
+}
+
+// PuppyPedigreeGet получает родословную щенка. Сам щенок — корень дерева,
+// его родители и их предки берутся из таблицы взрослых собак.
+func (s *ServiceImpl) PuppyPedigreeGet(puppy *domain.Puppy) (*domain.PedigreeNode, error) {
+	cacheKeyPedigree := fmt.Sprintf("pedigree:puppy:%d", puppy.ID)
+
+	cachedPedigree, err := s.Repository.RedisRepository.GetPedigree(cacheKeyPedigree)
+	if err == nil && cachedPedigree != nil {
+		return cachedPedigree, nil
+	}
+
+	dogs, err := s.Repository.PostgresRepository.PedigreeGet(
+		[]int{puppy.FatherID, puppy.MotherID}, PedigreeGenerations-1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pedigree := &domain.PedigreeNode{
+		Dog: domain.Dog{
+			ID:     puppy.ID,
+			Name:   puppy.Name,
+			Gender: puppy.Sex,
+			Color:  puppy.Color,
+			SireID: puppy.FatherID,
+			DamID:  puppy.MotherID,
+		},
+		Sire: buildPedigree(dogs, puppy.FatherID, PedigreeGenerations-1),
+		Dam:  buildPedigree(dogs, puppy.MotherID, PedigreeGenerations-1),
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetPedigree(cacheKeyPedigree, pedigree)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования родословной щенка", zap.Error(err))
+		}
+	}()
+
+	return pedigree, nil
+}
+
+// buildPedigree собирает дерево предков собаки id из плоского списка dogs.
+// Глубина ограничена generations, поэтому ошибочные циклы в данных не зациклят обход.
+func buildPedigree(dogs map[int]domain.Dog, id, generations int) *domain.PedigreeNode {
+	dog, ok := dogs[id]
+	if !ok {
+		return nil
+	}
+	node := &domain.PedigreeNode{Dog: dog}
+	if generations > 0 {
+		node.Sire = buildPedigree(dogs, dog.SireID, generations-1)
+		node.Dam = buildPedigree(dogs, dog.DamID, generations-1)
+	}
+	return node
+}
@@ -5,16 +5,21 @@ import (
 	"github.com/egosha7/site-go/internal/domain"
 	"github.com/egosha7/site-go/internal/repository"
 	"go.uber.org/zap"
-	"log"
 	"mime/multipart"
 	"strconv"
+	"time"
 )
 
 // PuppiesGet получает информацию о щенках.
-func (s *ServiceImpl) PuppiesGet(chocolates, genders []string, idPuppy, readyToMove string, page int, archived bool) ([]domain.Puppy, map[int]int, int, error) {
+func (s *ServiceImpl) PuppiesGet(
+	chocolates, genders, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter, page domain.Page,
+) ([]domain.Puppy, map[int]int, int, error) {
+	if page.Size == 0 {
+		page.Size = s.PageSizes.Puppies
+	}
 	cacheKeyPuppies := fmt.Sprintf(
-		"puppies:chocolates:%v:genders:%v:idPuppy:%s:readyToMove:%s:archived:%t:page:%d", chocolates, genders, idPuppy,
-		readyToMove, archived, page,
+		"puppies:chocolates:%v:genders:%v:statuses:%v:idPuppy:%s:readyToMove:%s:breed:%s:filter:%+v:page:%+v",
+		chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, page,
 	)
 	cacheKeyReviews := "puppyReviews"
 
@@ -26,7 +31,9 @@ func (s *ServiceImpl) PuppiesGet(chocolates, genders []string, idPuppy, readyToM
 		}
 	}
 
-	puppies, err := s.Repository.PostgresRepository.PuppiesGet(chocolates, genders, idPuppy, readyToMove, archived)
+	pagedPuppies, total, err := s.Repository.PostgresRepository.PuppiesGet(
+		chocolates, genders, statuses, idPuppy, readyToMove, breed, filter, page,
+	)
 	if err != nil {
 		s.Logger.Error("Ошибка получения данных щенков", zap.Error(err))
 		return nil, nil, 0, err
@@ -38,10 +45,7 @@ func (s *ServiceImpl) PuppiesGet(chocolates, genders []string, idPuppy, readyToM
 		return nil, nil, 0, err
 	}
 
-	pagedPuppies, totalPages, err := s.GetPagedPuppies(puppies, page, 2)
-	if err != nil {
-		return nil, nil, 0, err
-	}
+	totalPages := page.TotalPages(total)
 
 	go func() {
 		err := s.Repository.RedisRepository.SetPuppies(
@@ -63,6 +67,36 @@ func (s *ServiceImpl) PuppiesGet(chocolates, genders []string, idPuppy, readyToM
 	return pagedPuppies, reviews, totalPages, nil
 }
 
+// PuppyFacetsGet получает количество щенков по окрасам и полам с учётом остальных выбранных фильтров.
+func (s *ServiceImpl) PuppyFacetsGet(
+	chocolates, genders, statuses []string, readyToMove, breed string, filter domain.PuppyFilter,
+) (*domain.PuppyFacets, error) {
+	cacheKeyFacets := fmt.Sprintf(
+		"puppies:facets:chocolates:%v:genders:%v:statuses:%v:readyToMove:%s:breed:%s:filter:%+v",
+		chocolates, genders, statuses, readyToMove, breed, filter,
+	)
+
+	cachedFacets, err := s.Repository.RedisRepository.GetPuppyFacets(cacheKeyFacets)
+	if err == nil && cachedFacets != nil {
+		return cachedFacets, nil
+	}
+
+	facets, err := s.Repository.PostgresRepository.PuppyFacetsGet(chocolates, genders, statuses, readyToMove, breed, filter)
+	if err != nil {
+		s.Logger.Error("Ошибка получения количества щенков по фильтрам", zap.Error(err))
+		return nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetPuppyFacets(cacheKeyFacets, facets)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования количества щенков по фильтрам", zap.Error(err))
+		}
+	}()
+
+	return facets, nil
+}
+
 // PuppyGet получает информацию о щенке.
 func (s *ServiceImpl) PuppyGet(idPuppy string) (*domain.Puppy, *domain.Dog, *domain.Dog, error) {
 	cacheKeyPuppy := fmt.Sprintf("puppy:%s", idPuppy)
@@ -130,27 +164,103 @@ func (s *ServiceImpl) PuppyDelete(puppyID string) error {
 	return err
 }
 
-// PuppyChangeArchived меняет состояние архива щенка.
-func (s *ServiceImpl) PuppyChangeArchived(puppyID, archived, city, phone string) error {
+// puppyStatusTransitions — допустимые переходы между статусами щенка.
+var puppyStatusTransitions = map[string][]string{
+	domain.PuppyStatusAvailable: {domain.PuppyStatusReserved, domain.PuppyStatusSold},
+	domain.PuppyStatusReserved:  {domain.PuppyStatusAvailable, domain.PuppyStatusReserved, domain.PuppyStatusSold},
+	domain.PuppyStatusSold:      {domain.PuppyStatusReturned},
+	domain.PuppyStatusReturned:  {domain.PuppyStatusAvailable, domain.PuppyStatusReserved, domain.PuppyStatusSold},
+}
+
+// PuppyChangeStatus проверяет и выполняет смену статуса щенка, фиксируя время перехода.
+// Для брони обязательна дата её окончания в будущем; повторная бронь продлевает её.
+// Бронь и продажа закрепляются за покупателем: он ищется по телефону или создаётся,
+// а без нового телефона остаётся прежний покупатель брони.
+func (s *ServiceImpl) PuppyChangeStatus(puppyID, status string, reservedUntil time.Time, city string, buyer *domain.Buyer) error {
+	puppy, err := s.Repository.PostgresRepository.PuppyGet(puppyID)
+	if err != nil {
+		return err
+	}
+
+	allowed := false
+	for _, next := range puppyStatusTransitions[puppy.Status] {
+		if next == status {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return fmt.Errorf("puppy %d cannot change status from %s to %s", puppy.ID, puppy.Status, status)
+	}
+
+	now := time.Now()
+	if status == domain.PuppyStatusReserved {
+		if !reservedUntil.After(now) {
+			return fmt.Errorf("puppy %d reservation must end in the future", puppy.ID)
+		}
+	} else {
+		reservedUntil = time.Time{}
+	}
+
+	change := &domain.PuppyStatusChange{
+		PuppyID:       puppy.ID,
+		From:          puppy.Status,
+		To:            status,
+		ReservedUntil: reservedUntil,
+		City:          city,
+		ChangedAt:     now,
+	}
+	if status == domain.PuppyStatusReserved || status == domain.PuppyStatusSold {
+		change.BuyerID = puppy.BuyerID
+		if buyer != nil && len(buyer.Phones) > 0 {
+			if buyer.City == "" {
+				buyer.City = city
+			}
+			if err := s.buyerFindOrAdd(buyer); err != nil {
+				return err
+			}
+			change.BuyerID = buyer.ID
+			change.Phone = buyer.Phones[0]
+		}
+		if status == domain.PuppyStatusSold && change.BuyerID == 0 {
+			return fmt.Errorf("puppy %d cannot be sold without a buyer", puppy.ID)
+		}
+	}
+
 	s.Repository.RedisRepository.FlushAll()
-	return s.Repository.PuppyChangeArchived(puppyID, archived, city, phone)
+	return s.Repository.PostgresRepository.PuppyChangeStatus(change)
 }
 
 // PuppyAdd добавляет информацию о щенке.
 func (s *ServiceImpl) PuppyAdd(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
 	s.Repository.RedisRepository.FlushAll()
+	puppy.Status = domain.PuppyStatusAvailable
+	if err := s.applyLitter(puppy); err != nil {
+		return err
+	}
 	urls, err := s.Repository.PutInS3(puppy.Name, fileHeaders, 3.0/2.0, 1200, 800)
 	if err != nil {
 		return err
 	}
 	puppy.Urls = urls
 	println(puppy.Urls)
-	return s.Repository.PuppyAdd(puppy)
+	if err := s.Repository.PuppyAdd(puppy); err != nil {
+		return err
+	}
+
+	// Щенок уже добавлен, поэтому сбой листа ожидания только логируется
+	if err := s.queueWaitlist(puppy); err != nil {
+		s.Logger.Error("Ошибка постановки листа ожидания в очередь", zap.Int("puppyID", puppy.ID), zap.Error(err))
+	}
+	return nil
 }
 
 // PuppyUpdate обновляет информацию о щенке.
 func (s *ServiceImpl) PuppyUpdate(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error {
 	s.Repository.RedisRepository.FlushAll()
+	if err := s.applyLitter(puppy); err != nil {
+		return err
+	}
 	newUrls, err := s.Repository.PutInS3(puppy.Name, fileHeaders, 3.0/2.0, 1200, 800)
 	if err != nil {
 		return err
@@ -177,40 +287,7 @@ func (s *ServiceImpl) PuppyUpdate(puppy *domain.Puppy, fileHeaders []*multipart.
 	return err
 }
 
-func (s *ServiceImpl) GetPagedPuppies(puppies []domain.Puppy, currentPage, perPage int) ([]domain.Puppy, int, error) {
-	// Общее количество щенков
-	puppiesCount := len(puppies)
-
-	// Рассчитываем общее количество страниц
-	totalPages := puppiesCount / perPage
-	if puppiesCount%perPage != 0 {
-		totalPages++
-	}
-
-	// Проверка текущей страницы на выход за пределы
-	if currentPage > totalPages {
-		currentPage = totalPages
-	}
-	if currentPage < 1 {
-		currentPage = 1
-	}
-
-	// Вычисляем начальный и конечный индекс для текущей страницы
-	startIndex := (currentPage - 1) * perPage
-	endIndex := startIndex + perPage
-
-	// Проверка на выход за границы
-	if startIndex > puppiesCount {
-		startIndex = puppiesCount
-	}
-	if endIndex > puppiesCount {
-		endIndex = puppiesCount
-	}
-
-	log.Println(startIndex, "->", endIndex)
-
-	// Получаем щенков для текущей страницы
-	pagedPuppies := puppies[startIndex:endIndex]
-
-	return pagedPuppies, totalPages, nil
+// PriceHistoryGet получает историю изменения цены щенка.
+func (s *ServiceImpl) PriceHistoryGet(puppyID int) ([]domain.PriceChange, error) {
+	return s.Repository.PostgresRepository.PriceHistoryGet(puppyID)
 }
@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/repository"
+	"github.com/egosha7/site-go/internal/service"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+// puppyStatusRepo — хранилище с одним щенком, которое запоминает смену его статуса.
+type puppyStatusRepo struct {
+	repository.PostgresRepository
+	puppy  domain.Puppy
+	change *domain.PuppyStatusChange
+}
+
+func (r *puppyStatusRepo) PuppyGet(idPuppy string) (*domain.Puppy, error) {
+	puppy := r.puppy
+	return &puppy, nil
+}
+
+func (r *puppyStatusRepo) PuppyChangeStatus(change *domain.PuppyStatusChange) error {
+	r.change = change
+	return nil
+}
+
+// noCache — кеш, который нечего сбрасывать.
+type noCache struct {
+	repository.RedisRepository
+}
+
+func (noCache) FlushAll() {}
+
+func TestServiceImpl_PuppyChangeStatus(t *testing.T) {
+	future := time.Now().AddDate(0, 0, 14)
+	past := time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name          string
+		puppy         domain.Puppy
+		status        string
+		reservedUntil time.Time
+		allowed       bool
+	}{
+		{
+			name:          "Available to reserved",
+			puppy:         domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable},
+			status:        domain.PuppyStatusReserved,
+			reservedUntil: future,
+			allowed:       true,
+		}, {
+			name:    "Available to sold",
+			puppy:   domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable, BuyerID: 3},
+			status:  domain.PuppyStatusSold,
+			allowed: true,
+		}, {
+			name:   "Available to returned",
+			puppy:  domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable},
+			status: domain.PuppyStatusReturned,
+		}, {
+			name:   "Available to available",
+			puppy:  domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable},
+			status: domain.PuppyStatusAvailable,
+		}, {
+			name:    "Reserved to available",
+			puppy:   domain.Puppy{ID: 7, Status: domain.PuppyStatusReserved, ReservedUntil: future, BuyerID: 3},
+			status:  domain.PuppyStatusAvailable,
+			allowed: true,
+		}, {
+			name:          "Reservation extended",
+			puppy:         domain.Puppy{ID: 7, Status: domain.PuppyStatusReserved, ReservedUntil: future, BuyerID: 3},
+			status:        domain.PuppyStatusReserved,
+			reservedUntil: future.AddDate(0, 0, 7),
+			allowed:       true,
+		}, {
+			name:    "Reserved to sold",
+			puppy:   domain.Puppy{ID: 7, Status: domain.PuppyStatusReserved, ReservedUntil: future, BuyerID: 3},
+			status:  domain.PuppyStatusSold,
+			allowed: true,
+		}, {
+			name:   "Reserved to returned",
+			puppy:  domain.Puppy{ID: 7, Status: domain.PuppyStatusReserved, ReservedUntil: future, BuyerID: 3},
+			status: domain.PuppyStatusReturned,
+		}, {
+			name:    "Sold to returned",
+			puppy:   domain.Puppy{ID: 7, Status: domain.PuppyStatusSold, BuyerID: 3},
+			status:  domain.PuppyStatusReturned,
+			allowed: true,
+		}, {
+			name:   "Sold to available",
+			puppy:  domain.Puppy{ID: 7, Status: domain.PuppyStatusSold, BuyerID: 3},
+			status: domain.PuppyStatusAvailable,
+		}, {
+			name:          "Sold to reserved",
+			puppy:         domain.Puppy{ID: 7, Status: domain.PuppyStatusSold, BuyerID: 3},
+			status:        domain.PuppyStatusReserved,
+			reservedUntil: future,
+		}, {
+			name:    "Returned to available",
+			puppy:   domain.Puppy{ID: 7, Status: domain.PuppyStatusReturned, BuyerID: 3},
+			status:  domain.PuppyStatusAvailable,
+			allowed: true,
+		}, {
+			// Истёкшую бронь хранилище отдаёт как свободного щенка, поэтому его можно забронировать снова
+			name:          "Expired reservation shown as available reserved again",
+			puppy:         domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable, BuyerID: 3},
+			status:        domain.PuppyStatusReserved,
+			reservedUntil: future,
+			allowed:       true,
+		}, {
+			name:          "Reservation in the past",
+			puppy:         domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable},
+			status:        domain.PuppyStatusReserved,
+			reservedUntil: past,
+		}, {
+			name:   "Sold without buyer",
+			puppy:  domain.Puppy{ID: 7, Status: domain.PuppyStatusAvailable},
+			status: domain.PuppyStatusSold,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				repo := &puppyStatusRepo{puppy: test.puppy}
+				s := &service.ServiceImpl{
+					Repository: &repository.Repository{PostgresRepository: repo, RedisRepository: noCache{}},
+					Logger:     zap.NewNop(),
+				}
+
+				err := s.PuppyChangeStatus("7", test.status, test.reservedUntil, "", nil)
+				if !test.allowed {
+					assert.Error(t, err)
+					assert.Nil(t, repo.change)
+					return
+				}
+
+				assert.NoError(t, err)
+				if assert.NotNil(t, repo.change) {
+					assert.Equal(t, test.puppy.Status, repo.change.From)
+					assert.Equal(t, test.status, repo.change.To)
+					assert.False(t, repo.change.ChangedAt.IsZero())
+					if test.status == domain.PuppyStatusReserved {
+						assert.Equal(t, test.reservedUntil, repo.change.ReservedUntil)
+					} else {
+						assert.True(t, repo.change.ReservedUntil.IsZero())
+					}
+				}
+			},
+		)
+	}
+}
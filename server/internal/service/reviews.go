@@ -3,36 +3,46 @@ package service
 import (
 	"fmt"
 	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/repository"
 	"go.uber.org/zap"
 	"mime/multipart"
 )
 
-// ReviewsGet получает отзывы.
-func (s *ServiceImpl) ReviewsGet(idReview string, checked bool) ([]domain.Feedback, map[int]string, error) {
-	cacheKeyReviews := fmt.Sprintf("reviews:%s:%t", idReview, checked)
+// ReviewsGet получает страницу отзывов, имена щенков из отзывов и количество страниц.
+func (s *ServiceImpl) ReviewsGet(idReview string, checked bool, page domain.Page) ([]domain.Feedback, map[int]string, int, error) {
+	if page.Size == 0 {
+		page.Size = s.PageSizes.Reviews
+	}
+	cacheKeyReviews := fmt.Sprintf("reviews:%s:%t:page:%d:after:%d", idReview, checked, page.Number, page.After)
 	cacheKeyPuppyNames := "puppyNames"
 
 	cachedReviews, err := s.Repository.RedisRepository.GetReviews(cacheKeyReviews)
 	if err == nil && cachedReviews != nil {
 		cachedPuppyNames, err := s.Repository.RedisRepository.GetPuppyNames(cacheKeyPuppyNames)
 		if err == nil && cachedPuppyNames != nil {
-			return cachedReviews, cachedPuppyNames, nil
+			return cachedReviews.Reviews, cachedPuppyNames, cachedReviews.TotalPages, nil
 		}
 	}
 
-	reviews, err := s.Repository.PostgresRepository.ReviewsGet(idReview, checked)
+	reviews, total, err := s.Repository.PostgresRepository.ReviewsGet(idReview, checked, page)
 	if err != nil {
 		s.Logger.Error("Ошибка сервиса", zap.Error(err))
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
+	totalPages := page.TotalPages(total)
 
 	puppyNames, err := s.Repository.PostgresRepository.ReviewsPuppyNameGet()
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, 0, err
 	}
 
 	go func() {
-		err := s.Repository.RedisRepository.SetReviews(cacheKeyReviews, reviews)
+		err := s.Repository.RedisRepository.SetReviews(
+			cacheKeyReviews, &repository.CachedReviews{
+				Reviews:    reviews,
+				TotalPages: totalPages,
+			},
+		)
 		if err != nil {
 			s.Logger.Error("Ошибка кеширования отзывов", zap.Error(err))
 		}
@@ -43,7 +53,7 @@ func (s *ServiceImpl) ReviewsGet(idReview string, checked bool) ([]domain.Feedba
 		}
 	}()
 
-	return reviews, puppyNames, nil
+	return reviews, puppyNames, totalPages, nil
 }
 
 // FeedbackChangeChecked меняет состояние архива отзыва.
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+)
+
+// searchLimit — сколько результатов поиска показывается на странице.
+const searchLimit = 30
+
+// Search ищет щенков, собак, отзывы и статьи по запросу посетителя.
+func (s *ServiceImpl) Search(query string) ([]domain.SearchResult, error) {
+	cacheKey := "search:" + query
+
+	cachedResults, err := s.Repository.RedisRepository.GetSearchResults(cacheKey)
+	if err == nil && cachedResults != nil {
+		return cachedResults, nil
+	}
+
+	results, err := s.Repository.PostgresRepository.Search(query, searchLimit)
+	if err != nil {
+		s.Logger.Error("Ошибка поиска", zap.Error(err))
+		return nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetSearchResults(cacheKey, results)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования результатов поиска", zap.Error(err))
+		}
+	}()
+
+	return results, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/egosha7/site-go/internal/repository"
 	"go.uber.org/zap"
 	"mime/multipart"
+	"time"
 )
 
 // Services представляет сервис для работы с пользователями.
@@ -12,18 +13,81 @@ import (
 //go:generate mockgen -source=service.go -destination=mock_service/mock.go
 type Services interface {
 	AddEmail(email string) error
-	PuppiesGet(chocolates, genders []string, idPuppy, readyToMove string, page int, archived bool) ([]domain.Puppy, map[int]int, int, error)
+	PuppiesGet(
+		chocolates, genders, statuses []string, idPuppy, readyToMove, breed string, filter domain.PuppyFilter,
+		page domain.Page,
+	) ([]domain.Puppy, map[int]int, int, error)
+	PuppyFacetsGet(
+		chocolates, genders, statuses []string, readyToMove, breed string, filter domain.PuppyFilter,
+	) (*domain.PuppyFacets, error)
 	PuppyGet(idPuppy string) (*domain.Puppy, *domain.Dog, *domain.Dog, error)
 	DogsGet(chocolates, genders []string, id string, archived bool) ([]domain.Dog, error)
+	DogsPageGet(chocolates, genders []string, archived bool, page domain.Page) ([]domain.Dog, int, error)
+	DogGet(idDog string) (*domain.Dog, error)
+	DogsAllGet() ([]domain.Dog, error)
+	DogPedigreeGet(idDog string) (*domain.PedigreeNode, error)
+	DogOffspringGet(dogID int) ([]domain.Puppy, error)
+	PuppyPedigreeGet(puppy *domain.Puppy) (*domain.PedigreeNode, error)
+	MatingCOIGet(sireID, damID int) (*domain.COIResult, error)
+	PuppyCOIGet(idPuppy string) (*domain.COIResult, error)
+	ColorPredictionGet(sireID, damID int) (*domain.ColorPrediction, error)
 	PuppyUpdate(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error
 	PuppyAdd(puppy *domain.Puppy, fileHeaders []*multipart.FileHeader) error
+	PriceHistoryGet(puppyID int) ([]domain.PriceChange, error)
+	DictionariesGet() (*domain.Dictionaries, error)
+	DictionaryEntryAdd(entry *domain.DictionaryEntry) error
+	DictionaryEntryUpdate(entry *domain.DictionaryEntry) error
+	DictionaryEntryDelete(kind string, entryID string) error
+	Search(query string) ([]domain.SearchResult, error)
+	ArticlesGet(tag string, drafts bool, page domain.Page) ([]domain.Article, int, error)
+	ArticleGet(idArticle string) (*domain.Article, error)
+	ArticleGetBySlug(slug string) (*domain.Article, error)
+	ArticleAdd(article *domain.Article, fileHeader *multipart.FileHeader) error
+	ArticleUpdate(article *domain.Article, fileHeader *multipart.FileHeader) error
+	SiteSettingsGet() (*domain.SiteSettings, error)
+	SiteSettingsUpdate(settings *domain.SiteSettings) error
 	PuppyDelete(puppyID string) error
-	PuppyChangeArchived(puppyID, archived, city, phone string) error
-	GetPagedPuppies(puppies []domain.Puppy, currentPage, perPage int) ([]domain.Puppy, int, error)
+	PuppyChangeStatus(puppyID, status string, reservedUntil time.Time, city string, buyer *domain.Buyer) error
+	LittersGet() ([]domain.Litter, error)
+	LitterGet(idLitter string) (*domain.Litter, *domain.Dog, *domain.Dog, error)
+	LitterAdd(litter *domain.Litter) error
+	LitterUpdate(litter *domain.Litter) error
+	LitterDelete(litterID string) error
+	PlannedMatingsGet() ([]domain.PlannedMating, error)
+	UpcomingLittersGet() ([]domain.UpcomingLitter, error)
+	PlannedMatingAdd(mating *domain.PlannedMating) error
+	PlannedMatingUpdate(mating *domain.PlannedMating) error
+	PlannedMatingDelete(matingID string) error
+	PlannedMatingWhelp(matingID string, dateBirth, readyOutDate time.Time) (*domain.Litter, error)
+	BuyersGet(search string) ([]domain.Buyer, error)
+	BuyerGet(idBuyer string) (*domain.Buyer, error)
+	BuyerAdd(buyer *domain.Buyer) error
+	BuyerUpdate(buyer *domain.Buyer) error
+	BuyerDelete(buyerID string) error
+	WaitlistEntriesGet() ([]domain.WaitlistEntry, error)
+	WaitlistEntryAdd(entry *domain.WaitlistEntry) error
+	WaitlistEntryChangeActive(entryID string, active bool) error
+	WaitlistEntryDelete(entryID string) error
+	WaitlistNotificationSent(notificationID string) error
+	WaitlistCandidatesGet(idPuppy string) (*domain.Puppy, []domain.WaitlistCandidate, error)
+	HealthEventsGet(puppyID, dogID int) ([]domain.HealthEvent, error)
+	PuppyHealthGet(idPuppy string) (*domain.HealthSummary, error)
+	DogHealthGet(dogID int) (*domain.HealthSummary, error)
+	HealthEventAdd(event *domain.HealthEvent, fileHeader *multipart.FileHeader) error
+	HealthEventUpdate(event *domain.HealthEvent, fileHeader *multipart.FileHeader) error
+	HealthEventDelete(eventID string) (*domain.HealthEvent, error)
+	PuppyWeightsGet(puppyID int) ([]domain.WeightMeasurement, error)
+	PuppyGrowthGet(idPuppy string) ([]domain.GrowthSeries, error)
+	WeightAdd(measurement *domain.WeightMeasurement) error
+	WeightDelete(measurementID string) error
+	ShowResultsGet(dogID int) ([]domain.ShowResult, error)
+	ShowResultAdd(result *domain.ShowResult, fileHeader *multipart.FileHeader) error
+	ShowResultUpdate(result *domain.ShowResult, fileHeader *multipart.FileHeader) error
+	ShowResultDelete(resultID string) (*domain.ShowResult, error)
 	DogChangeArchived(puppyID string, archived string) error
 	DogAdd(puppy *domain.Dog, fileHeaders []*multipart.FileHeader) error
 	DogUpdate(dog *domain.Dog, fileHeaders []*multipart.FileHeader) error
-	ReviewsGet(idReview string, checked bool) ([]domain.Feedback, map[int]string, error)
+	ReviewsGet(idReview string, checked bool, page domain.Page) ([]domain.Feedback, map[int]string, int, error)
 	FeedbackGet(idPuppy, verified string) (*domain.Feedback, error)
 	FeedbackAdd(feedback *domain.Feedback, fileHeaders []*multipart.FileHeader) error
 	FeedbackUpdate(feedback *domain.Feedback, fileHeaders []*multipart.FileHeader) error
@@ -36,6 +100,10 @@ type AuthorizationServices interface {
 	CreateUser(login, password string) error
 	HashPassword(password string) (string, error)
 	CheckPasswordHash(password, hash string) bool
+	APITokenCreate(name string) (string, *domain.APIToken, error)
+	APITokensGet() ([]domain.APIToken, error)
+	APITokenRevoke(tokenID string) error
+	APITokenCheck(secret string) (*domain.APIToken, error)
 }
 
 type Service struct {
@@ -47,14 +115,17 @@ type Service struct {
 type ServiceImpl struct {
 	Repository *repository.Repository
 	Logger     *zap.Logger
+	PageSizes  domain.PageSizes
 }
 
-// NewUserService создает новый экземпляр UserService.
-func NewUserService(repository *repository.Repository, logger *zap.Logger) *Service {
+// NewUserService создает новый экземпляр UserService. pageSizes — количество записей
+// на странице в списках сайта.
+func NewUserService(repository *repository.Repository, logger *zap.Logger, pageSizes domain.PageSizes) *Service {
 	return &Service{
 		Services: &ServiceImpl{
 			Repository: repository,
 			Logger:     logger,
+			PageSizes:  pageSizes,
 		},
 		AuthorizationServices: &ServiceImpl{
 			Repository: repository,
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+)
+
+// SiteSettingsGet получает настройки сайта.
+func (s *ServiceImpl) SiteSettingsGet() (*domain.SiteSettings, error) {
+	cacheKey := "site_settings"
+
+	cachedSettings, err := s.Repository.RedisRepository.GetSiteSettings(cacheKey)
+	if err == nil && cachedSettings != nil {
+		return cachedSettings, nil
+	}
+
+	settings, err := s.Repository.PostgresRepository.SiteSettingsGet()
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetSiteSettings(cacheKey, settings)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования настроек сайта", zap.Error(err))
+		}
+	}()
+
+	return settings, nil
+}
+
+// SiteSettingsUpdate сохраняет настройки сайта.
+func (s *ServiceImpl) SiteSettingsUpdate(settings *domain.SiteSettings) error {
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.SiteSettingsUpdate(settings)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"mime/multipart"
+	"strconv"
+)
+
+// ShowResultsGet получает результаты выставок собаки.
+func (s *ServiceImpl) ShowResultsGet(dogID int) ([]domain.ShowResult, error) {
+	cacheKey := fmt.Sprintf("shows:dog:%d", dogID)
+
+	cachedResults, err := s.Repository.RedisRepository.GetShowResults(cacheKey)
+	if err == nil && cachedResults != nil {
+		return cachedResults, nil
+	}
+
+	results, err := s.Repository.PostgresRepository.ShowResultsGet(dogID)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		err := s.Repository.RedisRepository.SetShowResults(cacheKey, results)
+		if err != nil {
+			s.Logger.Error("Ошибка кеширования результатов выставок", zap.Error(err))
+		}
+	}()
+
+	return results, nil
+}
+
+// ShowResultAdd добавляет результат выставки и загружает скан сертификата.
+func (s *ServiceImpl) ShowResultAdd(result *domain.ShowResult, fileHeader *multipart.FileHeader) error {
+	if fileHeader != nil {
+		url, err := s.Repository.PutDocumentInS3(fmt.Sprintf("show_dog_%d", result.DogID), fileHeader)
+		if err != nil {
+			return err
+		}
+		result.CertificateURL = url
+	}
+	s.Repository.RedisRepository.FlushAll()
+	return s.Repository.PostgresRepository.ShowResultAdd(result)
+}
+
+// ShowResultUpdate обновляет результат выставки. Новый скан заменяет прежний.
+func (s *ServiceImpl) ShowResultUpdate(result *domain.ShowResult, fileHeader *multipart.FileHeader) error {
+	current, err := s.Repository.PostgresRepository.ShowResultGet(strconv.Itoa(result.ID))
+	if err != nil {
+		return err
+	}
+	result.DogID = current.DogID
+	result.CertificateURL = current.CertificateURL
+
+	if fileHeader != nil {
+		url, err := s.Repository.PutDocumentInS3(fmt.Sprintf("show_dog_%d", result.DogID), fileHeader)
+		if err != nil {
+			return err
+		}
+		result.CertificateURL = url
+	}
+
+	s.Repository.RedisRepository.FlushAll()
+	err = s.Repository.PostgresRepository.ShowResultUpdate(result)
+	if err != nil {
+		return err
+	}
+
+	if current.CertificateURL != "" && current.CertificateURL != result.CertificateURL {
+		err = s.Repository.S3Repository.DeleteFromS3(current.CertificateURL)
+		if err != nil {
+			return fmt.Errorf("failed to delete certificate from S3: %w", err)
+		}
+	}
+	return nil
+}
+
+// ShowResultDelete удаляет результат выставки вместе со сканом сертификата.
+func (s *ServiceImpl) ShowResultDelete(resultID string) (*domain.ShowResult, error) {
+	result, err := s.Repository.PostgresRepository.ShowResultGet(resultID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Repository.RedisRepository.FlushAll()
+	err = s.Repository.PostgresRepository.ShowResultDelete(resultID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.CertificateURL != "" {
+		err = s.Repository.S3Repository.DeleteFromS3(result.CertificateURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete certificate from S3: %w", err)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"fmt"
+	"github.com/egosha7/site-go/internal/domain"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+// WaitlistEntriesGet получает все заявки в лист ожидания в порядке очереди.
+func (s *ServiceImpl) WaitlistEntriesGet() ([]domain.WaitlistEntry, error) {
+	return s.Repository.PostgresRepository.WaitlistEntriesGet(false)
+}
+
+// WaitlistEntryAdd добавляет заявку в лист ожидания.
+func (s *ServiceImpl) WaitlistEntryAdd(entry *domain.WaitlistEntry) error {
+	if !entry.WantedFrom.IsZero() && !entry.WantedUntil.IsZero() && entry.WantedUntil.Before(entry.WantedFrom) {
+		return fmt.Errorf("waitlist timeframe ends before it starts")
+	}
+	entry.Active = true
+	return s.Repository.PostgresRepository.WaitlistEntryAdd(entry)
+}
+
+// WaitlistEntryChangeActive включает заявку в очередь или снимает её, например когда щенок уже найден.
+func (s *ServiceImpl) WaitlistEntryChangeActive(entryID string, active bool) error {
+	return s.Repository.PostgresRepository.WaitlistEntryChangeActive(entryID, active)
+}
+
+// WaitlistEntryDelete удаляет заявку из листа ожидания.
+func (s *ServiceImpl) WaitlistEntryDelete(entryID string) error {
+	return s.Repository.PostgresRepository.WaitlistEntryDelete(entryID)
+}
+
+// WaitlistNotificationSent отмечает, что щенка предложили человеку из листа ожидания.
+func (s *ServiceImpl) WaitlistNotificationSent(notificationID string) error {
+	return s.Repository.PostgresRepository.WaitlistNotificationSent(notificationID)
+}
+
+// WaitlistCandidatesGet получает щенка и очередь из листа ожидания, которым он подходит.
+func (s *ServiceImpl) WaitlistCandidatesGet(idPuppy string) (*domain.Puppy, []domain.WaitlistCandidate, error) {
+	puppy, err := s.Repository.PostgresRepository.PuppyGet(idPuppy)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, err := s.Repository.PostgresRepository.WaitlistCandidatesGet(idPuppy)
+	if err != nil {
+		return nil, nil, err
+	}
+	return puppy, candidates, nil
+}
+
+// queueWaitlist ставит в очередь уведомления для активных заявок, которым подходит новый щенок.
+func (s *ServiceImpl) queueWaitlist(puppy *domain.Puppy) error {
+	entries, err := s.Repository.PostgresRepository.WaitlistEntriesGet(true)
+	if err != nil {
+		return err
+	}
+
+	// Готовность к переезду вычисляется в базе, поэтому щенок перечитывается после сохранения
+	saved, err := s.Repository.PostgresRepository.PuppyGet(strconv.Itoa(puppy.ID))
+	if err != nil {
+		return fmt.Errorf("unable to get puppy %d: %w", puppy.ID, err)
+	}
+	moveDate := puppyMoveDate(saved)
+
+	entryIDs := []int{}
+	for _, entry := range entries {
+		if waitlistMatches(entry, puppy, moveDate) {
+			entryIDs = append(entryIDs, entry.ID)
+		}
+	}
+	if len(entryIDs) == 0 {
+		return nil
+	}
+
+	s.Logger.Info("Щенок подходит заявкам из листа ожидания", zap.Int("puppyID", puppy.ID), zap.Ints("entryIDs", entryIDs))
+	return s.Repository.PostgresRepository.WaitlistNotificationsAdd(puppy.ID, entryIDs)
+}
+
+// puppyMoveDate определяет, когда щенок сможет переехать: сразу, если он уже готов,
+// иначе к вычисленной дате готовности. Нулевая дата — срок не известен.
+func puppyMoveDate(puppy *domain.Puppy) time.Time {
+	if puppy.ReadyOut {
+		return time.Now()
+	}
+	return puppy.ReadyOutDate
+}
+
+// waitlistMatches проверяет, подходит ли щенок пожеланиям из заявки. Щенок другой породы
+// не подходит, даже если окрас называется так же.
+func waitlistMatches(entry domain.WaitlistEntry, puppy *domain.Puppy, moveDate time.Time) bool {
+	if entry.Breed != puppy.Breed {
+		return false
+	}
+	if len(entry.Colors) > 0 {
+		colorMatches := false
+		for _, color := range entry.Colors {
+			if color == puppy.Color {
+				colorMatches = true
+				break
+			}
+		}
+		if !colorMatches {
+			return false
+		}
+	}
+	if entry.Gender != "" && entry.Gender != puppy.Sex {
+		return false
+	}
+	// Бюджет указывается в рублях, поэтому с ним сравниваются только рублёвые цены
+	if entry.Budget > 0 && puppy.Price.Currency == domain.CurrencyRUB && puppy.Price.Amount > int64(entry.Budget)*100 {
+		return false
+	}
+	if !moveDate.IsZero() {
+		if !entry.WantedFrom.IsZero() && moveDate.Before(entry.WantedFrom) {
+			return false
+		}
+		// Последний желаемый день входит в срок целиком
+		if !entry.WantedUntil.IsZero() && !moveDate.Before(entry.WantedUntil.AddDate(0, 0, 1)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"github.com/egosha7/site-go/internal/domain"
+	"github.com/egosha7/site-go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"testing"
+	"time"
+)
+
+func TestWaitlistMatches(t *testing.T) {
+	date := func(day int) time.Time { return time.Date(2024, time.June, day, 0, 0, 0, 0, time.UTC) }
+	const yorkie = "yorkshire-terrier"
+	puppy := &domain.Puppy{
+		ID:    7,
+		Breed: yorkie,
+		Sex:   "Сука",
+		Color: "Шоколадный",
+		Price: domain.Money{Amount: 12000000, Currency: domain.CurrencyRUB},
+	}
+
+	tests := []struct {
+		name     string
+		entry    domain.WaitlistEntry
+		puppy    *domain.Puppy
+		moveDate time.Time
+		expected bool
+	}{
+		{name: "No wishes", entry: domain.WaitlistEntry{}, puppy: puppy, moveDate: date(10), expected: true},
+		{
+			name:     "Other breed with any colour",
+			entry:    domain.WaitlistEntry{Breed: "cane-corso"},
+			puppy:    puppy,
+			moveDate: date(10),
+		}, {
+			name:     "Other breed with same colour name",
+			entry:    domain.WaitlistEntry{Breed: "cane-corso", Colors: []string{"Шоколадный"}},
+			puppy:    puppy,
+			moveDate: date(10),
+		}, {
+			name:     "Empty colour list matches any colour",
+			entry:    domain.WaitlistEntry{Colors: []string{}, Gender: "Сука"},
+			puppy:    puppy,
+			moveDate: date(10),
+			expected: true,
+		}, {
+			name:     "Colour in list",
+			entry:    domain.WaitlistEntry{Colors: []string{"Черный", "Шоколадный"}},
+			puppy:    puppy,
+			moveDate: date(10),
+			expected: true,
+		}, {
+			name:     "Colour not in list",
+			entry:    domain.WaitlistEntry{Colors: []string{"Черный"}},
+			puppy:    puppy,
+			moveDate: date(10),
+		}, {
+			name:     "Other gender",
+			entry:    domain.WaitlistEntry{Gender: "Кобель"},
+			puppy:    puppy,
+			moveDate: date(10),
+		}, {
+			// Бюджет в рублях, цена в копейках
+			name:     "Budget equals price",
+			entry:    domain.WaitlistEntry{Budget: 120000},
+			puppy:    puppy,
+			moveDate: date(10),
+			expected: true,
+		}, {
+			name:     "Budget one rouble short",
+			entry:    domain.WaitlistEntry{Budget: 119999},
+			puppy:    puppy,
+			moveDate: date(10),
+		}, {
+			name:  "Budget ignored for euro price",
+			entry: domain.WaitlistEntry{Budget: 1000},
+			puppy: &domain.Puppy{
+				Breed: yorkie, Sex: "Сука", Color: "Шоколадный",
+				Price: domain.Money{Amount: 300000, Currency: domain.CurrencyEUR},
+			},
+			moveDate: date(10),
+			expected: true,
+		}, {
+			name:  "Budget ignored for price on request",
+			entry: domain.WaitlistEntry{Budget: 1000},
+			puppy: &domain.Puppy{
+				Breed: yorkie, Sex: "Сука", Color: "Шоколадный", Price: domain.Money{Currency: domain.CurrencyRUB},
+			},
+			moveDate: date(10),
+			expected: true,
+		}, {
+			name:     "Move before wanted period",
+			entry:    domain.WaitlistEntry{WantedFrom: date(11), WantedUntil: date(20)},
+			puppy:    puppy,
+			moveDate: date(10),
+		}, {
+			name:     "Move on first wanted day",
+			entry:    domain.WaitlistEntry{WantedFrom: date(10), WantedUntil: date(20)},
+			puppy:    puppy,
+			moveDate: date(10),
+			expected: true,
+		}, {
+			name:     "Move late on last wanted day",
+			entry:    domain.WaitlistEntry{WantedFrom: date(1), WantedUntil: date(20)},
+			puppy:    puppy,
+			moveDate: date(20).Add(23*time.Hour + 59*time.Minute),
+			expected: true,
+		}, {
+			name:     "Move on day after wanted period",
+			entry:    domain.WaitlistEntry{WantedFrom: date(1), WantedUntil: date(20)},
+			puppy:    puppy,
+			moveDate: date(21),
+		}, {
+			name:     "Unknown move date ignores period",
+			entry:    domain.WaitlistEntry{WantedFrom: date(1), WantedUntil: date(20)},
+			puppy:    puppy,
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				// Заявки в таблице без породы ждут щенка породы из фикстуры
+				entry := test.entry
+				if entry.Breed == "" {
+					entry.Breed = yorkie
+				}
+				assert.Equal(t, test.expected, waitlistMatches(entry, test.puppy, test.moveDate))
+			},
+		)
+	}
+}
+
+// waitlistRepo — хранилище с листом ожидания в памяти, которое запоминает поставленные уведомления.
+type waitlistRepo struct {
+	repository.PostgresRepository
+	entries  []domain.WaitlistEntry
+	saved    domain.Puppy
+	queued   []int
+	queuedTo int
+}
+
+func (r *waitlistRepo) WaitlistEntriesGet(activeOnly bool) ([]domain.WaitlistEntry, error) {
+	return r.entries, nil
+}
+
+func (r *waitlistRepo) PuppyGet(idPuppy string) (*domain.Puppy, error) {
+	puppy := r.saved
+	return &puppy, nil
+}
+
+func (r *waitlistRepo) WaitlistNotificationsAdd(puppyID int, entryIDs []int) error {
+	r.queuedTo, r.queued = puppyID, entryIDs
+	return nil
+}
+
+func TestServiceImpl_queueWaitlist(t *testing.T) {
+	puppy := &domain.Puppy{ID: 7, Breed: "yorkshire-terrier", Sex: "Сука", Color: "Шоколадный"}
+	readyOutDate := time.Now().AddDate(0, 2, 0)
+
+	tests := []struct {
+		name     string
+		entries  []domain.WaitlistEntry
+		saved    domain.Puppy
+		expected []int
+	}{
+		{
+			name: "Matching entries queued in order",
+			entries: []domain.WaitlistEntry{
+				{ID: 1, Breed: "yorkshire-terrier", Colors: []string{"Шоколадный"}},
+				{ID: 2, Breed: "yorkshire-terrier", Gender: "Кобель"},
+				{ID: 3, Breed: "yorkshire-terrier"},
+				{ID: 4, Breed: "cane-corso"},
+			},
+			saved:    domain.Puppy{ID: 7, ReadyOutDate: readyOutDate},
+			expected: []int{1, 3},
+		}, {
+			// Дата переезда берётся из сохранённого щенка, а не из формы
+			name: "Move date from saved puppy",
+			entries: []domain.WaitlistEntry{
+				{ID: 1, Breed: "yorkshire-terrier", WantedUntil: time.Now().AddDate(0, 1, 0)},
+				{ID: 2, Breed: "yorkshire-terrier", WantedFrom: time.Now().AddDate(0, 1, 0)},
+			},
+			saved:    domain.Puppy{ID: 7, ReadyOutDate: readyOutDate},
+			expected: []int{2},
+		}, {
+			name:     "Ready puppy moves now",
+			entries:  []domain.WaitlistEntry{{ID: 1, Breed: "yorkshire-terrier", WantedUntil: time.Now().AddDate(0, 1, 0)}},
+			saved:    domain.Puppy{ID: 7, ReadyOut: true, ReadyOutDate: readyOutDate},
+			expected: []int{1},
+		}, {
+			name:    "Nothing matches",
+			entries: []domain.WaitlistEntry{{ID: 1, Breed: "yorkshire-terrier", Gender: "Кобель"}},
+			saved:   domain.Puppy{ID: 7},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				repo := &waitlistRepo{entries: test.entries, saved: test.saved}
+				s := &ServiceImpl{Repository: &repository.Repository{PostgresRepository: repo}, Logger: zap.NewNop()}
+
+				assert.NoError(t, s.queueWaitlist(puppy))
+				assert.Equal(t, test.expected, repo.queued)
+				if test.expected != nil {
+					assert.Equal(t, puppy.ID, repo.queuedTo)
+				}
+			},
+		)
+	}
+}
@@ -0,0 +1,14 @@
+-- Помёты: щенки, рожденные от одной пары в один день.
+CREATE TABLE IF NOT EXISTS litters (
+    id             SERIAL PRIMARY KEY,
+    mother_id      INTEGER NOT NULL REFERENCES adult_dogs (id),
+    father_id      INTEGER NOT NULL REFERENCES adult_dogs (id),
+    date_birth     DATE    NOT NULL,
+    ready_out_date DATE    NOT NULL,
+    description    TEXT    NOT NULL DEFAULT ''
+);
+
+ALTER TABLE puppies
+    ADD COLUMN IF NOT EXISTS litter_id INTEGER REFERENCES litters (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS puppies_litter_id_idx ON puppies (litter_id);
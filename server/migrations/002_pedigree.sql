@@ -0,0 +1,7 @@
+-- Родословная: ссылки на отца и мать взрослой собаки.
+-- Сторонние собаки (external) из других питомников хранятся в той же таблице.
+ALTER TABLE adult_dogs
+    ADD COLUMN IF NOT EXISTS sire_id  INTEGER REFERENCES adult_dogs (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS dam_id   INTEGER REFERENCES adult_dogs (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS external BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS kennel   TEXT    NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- Генотип взрослой собаки по локусам окраса: B (коричневый), D (разбавление), M (мерле).
+-- Значения — пары аллелей ("BB", "Bb", "bb"); пустая строка — генотип не известен.
+ALTER TABLE adult_dogs
+    ADD COLUMN IF NOT EXISTS genotype_b TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS genotype_d TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS genotype_m TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,13 @@
+-- Запланированные вязки и анонсы будущих помётов.
+CREATE TABLE IF NOT EXISTS planned_matings (
+    id            SERIAL PRIMARY KEY,
+    mother_id     INTEGER NOT NULL REFERENCES adult_dogs (id),
+    father_id     INTEGER NOT NULL REFERENCES adult_dogs (id),
+    expected_date DATE    NOT NULL,
+    status        TEXT    NOT NULL DEFAULT 'planned'
+        CHECK (status IN ('planned', 'confirmed', 'pregnant', 'whelped', 'cancelled')),
+    description   TEXT    NOT NULL DEFAULT '',
+    litter_id     INTEGER REFERENCES litters (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS planned_matings_status_idx ON planned_matings (status, expected_date);
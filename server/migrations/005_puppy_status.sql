@@ -0,0 +1,31 @@
+-- Статус щенка вместо флага archived: свободен, забронирован, продан, возвращён.
+ALTER TABLE puppies
+    ADD COLUMN IF NOT EXISTS status            TEXT        NOT NULL DEFAULT 'available'
+        CHECK (status IN ('available', 'reserved', 'sold', 'returned')),
+    ADD COLUMN IF NOT EXISTS reserved_until    DATE,
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'puppies' AND column_name = 'archived') THEN
+        UPDATE puppies SET status = 'sold' WHERE archived;
+        ALTER TABLE puppies DROP COLUMN archived;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS puppies_status_idx ON puppies (status);
+
+-- История смены статусов щенка.
+CREATE TABLE IF NOT EXISTS puppy_status_history (
+    id             SERIAL PRIMARY KEY,
+    puppy_id       INTEGER     NOT NULL REFERENCES puppies (id) ON DELETE CASCADE,
+    from_status    TEXT        NOT NULL,
+    to_status      TEXT        NOT NULL,
+    reserved_until DATE,
+    city           TEXT        NOT NULL DEFAULT '',
+    phone          TEXT        NOT NULL DEFAULT '',
+    changed_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS puppy_status_history_puppy_id_idx ON puppy_status_history (puppy_id, changed_at);
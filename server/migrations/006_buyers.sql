@@ -0,0 +1,39 @@
+-- Покупатели вместо голого номера телефона в puppies_member.
+CREATE TABLE IF NOT EXISTS buyers (
+    id     SERIAL PRIMARY KEY,
+    name   TEXT   NOT NULL DEFAULT '',
+    phones TEXT[] NOT NULL DEFAULT '{}',
+    email  TEXT   NOT NULL DEFAULT '',
+    city   TEXT   NOT NULL DEFAULT '',
+    notes  TEXT   NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS buyers_phones_idx ON buyers USING GIN (phones);
+
+ALTER TABLE puppies
+    ADD COLUMN IF NOT EXISTS buyer_id INTEGER REFERENCES buyers (id) ON DELETE SET NULL;
+
+ALTER TABLE puppy_status_history
+    ADD COLUMN IF NOT EXISTS buyer_id INTEGER REFERENCES buyers (id) ON DELETE SET NULL;
+
+-- Каждый номер из puppies_member становится покупателем со щенками, проданными на этот номер.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'puppies_member') THEN
+        INSERT INTO buyers (phones)
+        SELECT DISTINCT ARRAY[number] FROM puppies_member WHERE number <> '';
+
+        UPDATE puppies p SET buyer_id = b.id
+        FROM puppies_member m
+        JOIN buyers b ON b.phones = ARRAY[m.number]
+        WHERE m.puppy_id = p.id;
+
+        UPDATE buyers b SET city = p.city
+        FROM puppies p
+        WHERE p.buyer_id = b.id AND b.city = '';
+
+        DROP TABLE puppies_member;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS puppies_buyer_id_idx ON puppies (buyer_id);
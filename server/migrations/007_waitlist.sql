@@ -0,0 +1,32 @@
+-- Лист ожидания щенков с пожеланиями по окрасу, полу, бюджету и срокам.
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id           SERIAL PRIMARY KEY,
+    name         TEXT        NOT NULL,
+    phone        TEXT        NOT NULL,
+    email        TEXT        NOT NULL DEFAULT '',
+    colors       TEXT[]      NOT NULL DEFAULT '{}',
+    gender       TEXT        NOT NULL DEFAULT '',
+    budget       INTEGER     NOT NULL DEFAULT 0 CHECK (budget >= 0),
+    wanted_from  DATE,
+    wanted_until DATE,
+    comment      TEXT        NOT NULL DEFAULT '',
+    active       BOOLEAN     NOT NULL DEFAULT TRUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Пол проверяется по справочнику при сохранении заявки, поэтому прежнее ограничение на два значения снимается.
+ALTER TABLE waitlist_entries DROP CONSTRAINT IF EXISTS waitlist_entries_gender_check;
+
+CREATE INDEX IF NOT EXISTS waitlist_entries_active_idx ON waitlist_entries (active, created_at);
+
+-- Очередь уведомлений: по одной записи на пару заявка — щенок.
+CREATE TABLE IF NOT EXISTS waitlist_notifications (
+    id         SERIAL PRIMARY KEY,
+    entry_id   INTEGER     NOT NULL REFERENCES waitlist_entries (id) ON DELETE CASCADE,
+    puppy_id   INTEGER     NOT NULL REFERENCES puppies (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_at    TIMESTAMPTZ,
+    UNIQUE (entry_id, puppy_id)
+);
+
+CREATE INDEX IF NOT EXISTS waitlist_notifications_pending_idx ON waitlist_notifications (puppy_id) WHERE sent_at IS NULL;
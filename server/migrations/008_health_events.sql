@@ -0,0 +1,16 @@
+-- Ветеринарная история: прививки, обработки, осмотры, чипы и генетические тесты.
+CREATE TABLE IF NOT EXISTS health_events (
+    id           SERIAL PRIMARY KEY,
+    puppy_id     INTEGER REFERENCES puppies (id) ON DELETE CASCADE,
+    dog_id       INTEGER REFERENCES adult_dogs (id) ON DELETE CASCADE,
+    type         TEXT NOT NULL
+        CHECK (type IN ('vaccination', 'deworming', 'vet_check', 'chip', 'genetic_test')),
+    date         DATE NOT NULL,
+    title        TEXT NOT NULL DEFAULT '',
+    result       TEXT NOT NULL DEFAULT '',
+    document_url TEXT NOT NULL DEFAULT '',
+    CHECK ((puppy_id IS NULL) <> (dog_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS health_events_puppy_id_idx ON health_events (puppy_id, date) WHERE puppy_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS health_events_dog_id_idx ON health_events (dog_id, date) WHERE dog_id IS NOT NULL;
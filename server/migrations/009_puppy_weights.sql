@@ -0,0 +1,8 @@
+-- Взвешивания щенков для графиков роста.
+CREATE TABLE IF NOT EXISTS puppy_weights (
+    id       SERIAL PRIMARY KEY,
+    puppy_id INTEGER NOT NULL REFERENCES puppies (id) ON DELETE CASCADE,
+    date     DATE    NOT NULL,
+    grams    INTEGER NOT NULL CHECK (grams > 0),
+    UNIQUE (puppy_id, date)
+);
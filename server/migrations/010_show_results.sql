@@ -0,0 +1,13 @@
+-- Результаты выставок и рабочих испытаний взрослых собак.
+CREATE TABLE IF NOT EXISTS show_results (
+    id              SERIAL PRIMARY KEY,
+    dog_id          INTEGER NOT NULL REFERENCES adult_dogs (id) ON DELETE CASCADE,
+    event           TEXT    NOT NULL,
+    date            DATE    NOT NULL,
+    judge           TEXT    NOT NULL DEFAULT '',
+    grade           TEXT    NOT NULL DEFAULT '',
+    titles          TEXT[]  NOT NULL DEFAULT '{}',
+    certificate_url TEXT    NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS show_results_dog_id_idx ON show_results (dog_id, date);
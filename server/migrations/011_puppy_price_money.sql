@@ -0,0 +1,37 @@
+-- Цена щенка в минимальных единицах валюты вместо произвольной строки.
+ALTER TABLE puppies
+    ADD COLUMN IF NOT EXISTS price_amount   BIGINT NOT NULL DEFAULT 0 CHECK (price_amount >= 0),
+    ADD COLUMN IF NOT EXISTS price_currency TEXT   NOT NULL DEFAULT 'RUB'
+        CHECK (price_currency IN ('RUB', 'EUR', 'USD'));
+
+-- Строки вида "120 000", "120 000 ₽" или "35000,50": всё, кроме цифр, разделителей и пробелов, отбрасывается,
+-- одна-две цифры после последней точки или запятой считаются копейками.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'puppies' AND column_name = 'price') THEN
+        UPDATE puppies p
+        SET price_amount =
+                COALESCE(NULLIF(regexp_replace(regexp_replace(s.cleaned, '[.,][0-9]{1,2}$', ''), '[^0-9]', '', 'g'),
+                                '')::BIGINT, 0) * 100
+                + COALESCE(rpad(substring(s.cleaned FROM '[.,]([0-9]{1,2})$'), 2, '0')::BIGINT, 0)
+        FROM (SELECT id, btrim(regexp_replace(price, '[^0-9.,]', '', 'g'), '.,') AS cleaned FROM puppies) s
+        WHERE s.id = p.id;
+        ALTER TABLE puppies DROP COLUMN price;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS puppies_price_idx ON puppies (price_currency, price_amount);
+
+-- История изменения цены щенка.
+CREATE TABLE IF NOT EXISTS puppy_price_history (
+    id           SERIAL PRIMARY KEY,
+    puppy_id     INTEGER     NOT NULL REFERENCES puppies (id) ON DELETE CASCADE,
+    old_amount   BIGINT      NOT NULL,
+    old_currency TEXT        NOT NULL,
+    new_amount   BIGINT      NOT NULL,
+    new_currency TEXT        NOT NULL,
+    changed_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS puppy_price_history_puppy_id_idx ON puppy_price_history (puppy_id, changed_at);
@@ -0,0 +1,25 @@
+-- Дата рождения щенка хранится датой вместо строки "ДД.ММ.ГГГГ"; нераспознанные строки становятся NULL.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'puppies' AND column_name = 'date_birth' AND data_type <> 'date') THEN
+        ALTER TABLE puppies ALTER COLUMN date_birth DROP NOT NULL;
+        ALTER TABLE puppies ALTER COLUMN date_birth TYPE DATE
+            USING CASE WHEN date_birth ~ '^\d{1,2}\.\d{1,2}\.\d{4}$' THEN to_date(date_birth, 'DD.MM.YYYY') END;
+    END IF;
+END $$;
+
+-- Готовность к переезду вычисляется по дате помёта или возрасту щенка. Вместо флага ready_out
+-- остаётся ручная отметка админа: NULL — считать автоматически. Отметка сохраняется только
+-- у щенков, для которых дату готовности вычислить не из чего.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_name = 'puppies' AND column_name = 'ready_out') THEN
+        ALTER TABLE puppies ADD COLUMN IF NOT EXISTS ready_out_override BOOLEAN;
+        UPDATE puppies SET ready_out_override = ready_out WHERE date_birth IS NULL AND litter_id IS NULL;
+        ALTER TABLE puppies DROP COLUMN ready_out;
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS puppies_date_birth_idx ON puppies (date_birth);
@@ -0,0 +1,46 @@
+-- Справочники пород, окрасов и пола. value хранится у щенков и собак как есть,
+-- поэтому окрасы и пол заполняются прежними русскими значениями. Справочник пола закрыт:
+-- сайт различает только эти два значения, админ меняет у них подписи и порядок.
+CREATE TABLE IF NOT EXISTS breeds (
+    id         SERIAL PRIMARY KEY,
+    value      TEXT    NOT NULL UNIQUE,
+    label_ru   TEXT    NOT NULL,
+    label_en   TEXT    NOT NULL DEFAULT '',
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS colors (
+    id         SERIAL PRIMARY KEY,
+    value      TEXT    NOT NULL UNIQUE,
+    label_ru   TEXT    NOT NULL,
+    label_en   TEXT    NOT NULL DEFAULT '',
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS sexes (
+    id         SERIAL PRIMARY KEY,
+    value      TEXT    NOT NULL UNIQUE,
+    label_ru   TEXT    NOT NULL,
+    label_en   TEXT    NOT NULL DEFAULT '',
+    sort_order INTEGER NOT NULL DEFAULT 0
+);
+
+INSERT INTO breeds (value, label_ru, label_en, sort_order) VALUES
+    ('yorkshire-terrier', 'Йоркширский терьер', 'Yorkshire Terrier', 10)
+ON CONFLICT (value) DO NOTHING;
+
+INSERT INTO colors (value, label_ru, label_en, sort_order) VALUES
+    ('Классический', 'Классический', 'Classic', 10),
+    ('Шоколадный', 'Шоколадный', 'Chocolate', 20),
+    ('Черный', 'Черный', 'Black', 30),
+    ('Биро', 'Биро', 'Biro', 40),
+    ('Бивер', 'Бивер', 'Biewer', 50),
+    ('Голддаст', 'Голддаст', 'Golddust', 60),
+    ('Черный мерле', 'Черный мерле', 'Black merle', 70),
+    ('Шоколадный мерле', 'Шоколадный мерле', 'Chocolate merle', 80)
+ON CONFLICT (value) DO NOTHING;
+
+INSERT INTO sexes (value, label_ru, label_en, sort_order) VALUES
+    ('Кобель', 'Кобель (мальчик)', 'Male', 10),
+    ('Сука', 'Сука (девочка)', 'Female', 20)
+ON CONFLICT (value) DO NOTHING;
@@ -0,0 +1,25 @@
+-- Порода щенка, собаки и помёта. Все существующие записи относятся к йоркширскому терьеру.
+-- Порода хранится значением из справочника breeds, как окрас и пол.
+ALTER TABLE adult_dogs
+    ADD COLUMN IF NOT EXISTS breed TEXT NOT NULL DEFAULT 'yorkshire-terrier' REFERENCES breeds (value);
+
+ALTER TABLE litters
+    ADD COLUMN IF NOT EXISTS breed TEXT NOT NULL DEFAULT 'yorkshire-terrier' REFERENCES breeds (value);
+
+ALTER TABLE puppies
+    ADD COLUMN IF NOT EXISTS breed TEXT NOT NULL DEFAULT 'yorkshire-terrier' REFERENCES breeds (value);
+
+CREATE INDEX IF NOT EXISTS puppies_breed_idx ON puppies (breed);
+
+-- Заявка в лист ожидания ждёт щенка определённой породы: окрасы в ней — окрасы этой породы.
+ALTER TABLE waitlist_entries
+    ADD COLUMN IF NOT EXISTS breed TEXT NOT NULL DEFAULT 'yorkshire-terrier' REFERENCES breeds (value);
+
+-- Окрасы заводятся для каждой породы отдельно: одно и то же значение окраса может быть
+-- у нескольких пород, но внутри породы оно уникально.
+ALTER TABLE colors
+    ADD COLUMN IF NOT EXISTS breed TEXT NOT NULL DEFAULT 'yorkshire-terrier' REFERENCES breeds (value);
+
+ALTER TABLE colors DROP CONSTRAINT IF EXISTS colors_value_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS colors_breed_value_idx ON colors (breed, value);
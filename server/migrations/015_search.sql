@@ -0,0 +1,30 @@
+-- Полнотекстовый поиск по сайту: клички и описания щенков и собак, тексты отзывов.
+-- Поисковые векторы строятся по русской конфигурации, опечатки ловятся триграммами.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE puppies
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(title, '')), 'B')
+    ) STORED;
+
+ALTER TABLE adult_dogs
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(title, '')), 'B')
+    ) STORED;
+
+ALTER TABLE reviews
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        to_tsvector('russian', coalesce(title, ''))
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS puppies_search_idx ON puppies USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS adult_dogs_search_idx ON adult_dogs USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS reviews_search_idx ON reviews USING gin (search_vector);
+
+CREATE INDEX IF NOT EXISTS puppies_name_trgm_idx ON puppies USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS puppies_title_trgm_idx ON puppies USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS adult_dogs_name_trgm_idx ON adult_dogs USING gin (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS adult_dogs_title_trgm_idx ON adult_dogs USING gin (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS reviews_title_trgm_idx ON reviews USING gin (title gin_trgm_ops);
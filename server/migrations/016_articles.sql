@@ -0,0 +1,26 @@
+-- Статьи базы знаний: текст в Markdown, обложка в S3, теги и признак публикации.
+-- published_at ставится при первой публикации и не меняется, если статью снять и опубликовать снова.
+CREATE TABLE IF NOT EXISTS articles (
+    id           SERIAL PRIMARY KEY,
+    title        TEXT        NOT NULL,
+    slug         TEXT        NOT NULL UNIQUE,
+    body         TEXT        NOT NULL DEFAULT '',
+    cover_url    TEXT        NOT NULL DEFAULT '',
+    tags         TEXT[]      NOT NULL DEFAULT '{}',
+    published    BOOLEAN     NOT NULL DEFAULT false,
+    published_at TIMESTAMPTZ,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS articles_published_idx ON articles (published, published_at DESC);
+CREATE INDEX IF NOT EXISTS articles_tags_idx ON articles USING GIN (tags);
+
+-- Статьи участвуют в поиске по сайту наравне со щенками, собаками и отзывами.
+ALTER TABLE articles
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('russian', coalesce(body, '')), 'B')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS articles_search_idx ON articles USING gin (search_vector);
+CREATE INDEX IF NOT EXISTS articles_title_trgm_idx ON articles USING gin (title gin_trgm_ops);
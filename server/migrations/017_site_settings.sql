@@ -0,0 +1,26 @@
+-- Настройки сайта: контакты, соцсети, карта, футер и SEO по умолчанию.
+-- Таблица всегда содержит ровно одну строку с id = 1.
+CREATE TABLE IF NOT EXISTS site_settings (
+    id              INTEGER          PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+    phone           TEXT             NOT NULL DEFAULT '',
+    email           TEXT             NOT NULL DEFAULT '',
+    address         TEXT             NOT NULL DEFAULT '',
+    opening_hours   TEXT             NOT NULL DEFAULT '',
+    telegram        TEXT             NOT NULL DEFAULT '',
+    whatsapp        TEXT             NOT NULL DEFAULT '',
+    instagram       TEXT             NOT NULL DEFAULT '',
+    vk              TEXT             NOT NULL DEFAULT '',
+    map_lat         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    map_lng         DOUBLE PRECISION NOT NULL DEFAULT 0,
+    footer_text     TEXT             NOT NULL DEFAULT '',
+    seo_title       TEXT             NOT NULL DEFAULT '',
+    seo_description TEXT             NOT NULL DEFAULT '',
+    seo_keywords    TEXT             NOT NULL DEFAULT '',
+    updated_at      TIMESTAMPTZ      NOT NULL DEFAULT now()
+);
+
+-- Значения, которые раньше были прописаны в шаблонах
+INSERT INTO site_settings (id, phone, address, telegram, whatsapp, footer_text, seo_title) VALUES
+    (1, '+7 961 367 85-83', 'Россия, пгт. Иглино (г.Уфа)', 'https://t.me/+79613678583',
+     'https://wa.me/+79613678583', '© 2024 Elza Breeder', 'Elza Breeder')
+ON CONFLICT (id) DO NOTHING;
@@ -0,0 +1,11 @@
+-- Токены API админки. Сам токен не хранится: только SHA-256 и первые символы для списка в админке.
+-- Отозванный токен остаётся в таблице с датой отзыва.
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id           SERIAL PRIMARY KEY,
+    name         TEXT        NOT NULL,
+    prefix       TEXT        NOT NULL,
+    token_hash   TEXT        NOT NULL UNIQUE,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at   TIMESTAMPTZ
+);